@@ -16,11 +16,17 @@ import (
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
+	"github.com/aa1ex/paas-provider/internal/catalog"
+	"github.com/aa1ex/paas-provider/internal/drift"
+	"github.com/aa1ex/paas-provider/internal/longrunning"
+	"github.com/aa1ex/paas-provider/internal/provisioner"
 	"github.com/aa1ex/paas-provider/internal/server/k8s"
+	"github.com/aa1ex/paas-provider/internal/server/operations"
 	"github.com/aa1ex/paas-provider/internal/server/template"
 	"github.com/aa1ex/paas-provider/internal/server/vm"
 	"github.com/aa1ex/paas-provider/internal/tmplproc"
 	"github.com/aa1ex/paas-provider/pkg/api/grpc/kubernetes_cluster/v1/kubernetes_clusterv1connect"
+	"github.com/aa1ex/paas-provider/pkg/api/grpc/operations/v1/operationsv1connect"
 	"github.com/aa1ex/paas-provider/pkg/api/grpc/template/v1/templatev1connect"
 	"github.com/aa1ex/paas-provider/pkg/api/grpc/virtual_machine/v1/virtual_machinev1connect"
 
@@ -31,25 +37,88 @@ func main() {
 	// Initialize viper
 	initConfig()
 
-	store := storage.NewStorage()
+	store, err := storage.Open(viper.GetString("storage.driver"), viper.GetString("storage.path"))
+	if err != nil {
+		log.Fatalf("Error opening storage: %v", err)
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			log.Printf("Error closing storage: %v", err)
+		}
+	}()
+	log.Printf("Using %s storage", viper.GetString("storage.driver"))
+
+	tmplProc := tmplproc.NewTemplateProcessor(store, tmplproc.Limits{
+		Timeout:            viper.GetDuration("render.timeout"),
+		MaxOutputBytes:     viper.GetInt("render.max_output_bytes"),
+		MaxDepth:           viper.GetInt("render.max_depth"),
+		MaxCachedTemplates: viper.GetInt("render.max_cached_templates"),
+	})
+
+	// Load templates from files and reload them when they change
+	reloader := catalog.NewReloader(viper.GetString("templates.dir"), store, tmplProc)
+	if err := reloader.Reload(); err != nil {
+		log.Printf("Warning: Could not load templates: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if viper.GetBool("templates.watch") {
+		go func() {
+			if err := reloader.Watch(ctx); err != nil {
+				log.Printf("Warning: Templates will not be reloaded: %v", err)
+			}
+		}()
+	}
+
+	// Check the resources for drift from their templates in the background
+	if interval := viper.GetDuration("drift.interval"); interval > 0 {
+		go drift.NewChecker(store, tmplProc).Run(ctx, interval)
+	}
+
+	// Create the provisioner drivers and sync the status of the resources
+	// from them in the background
+	var provisionerConfig provisioner.Config
+	if err := viper.UnmarshalKey("provisioners", &provisionerConfig); err != nil {
+		log.Fatalf("Error reading provisioner config: %v", err)
+	}
+	provisioners, err := provisioner.NewRegistry(provisionerConfig)
+	if err != nil {
+		log.Fatalf("Error creating provisioners: %v", err)
+	}
+	if interval := viper.GetDuration("provisioners.sync_interval"); interval > 0 {
+		go provisioner.NewSyncer(store, provisioners).Run(ctx, interval)
+	}
 
-	// Load templates from files
-	loadTemplates(store)
+	// Run the changes to resources as operations on a pool of workers. The
+	// running operations get a grace period to finish before the storage is
+	// closed, and are cancelled after it.
+	runner := longrunning.NewRunner(ctx, store, viper.GetInt("operations.workers"))
+	defer runner.Close(viper.GetDuration("operations.shutdown_grace"))
+	if retention := viper.GetDuration("operations.retention"); retention > 0 {
+		go runner.Prune(ctx, retention, min(retention, time.Hour))
+	}
 
 	// Run the server with the port from config
-	runServer(store)
+	runServer(store, tmplProc, reloader, provisioners, runner)
 }
 
 // initConfig initializes the configuration using viper
 func initConfig() {
 	// Set default values
 	viper.SetDefault("server.port", 8080)
-	viper.SetDefault("templates.vm.id", "vm-template-1")
-	viper.SetDefault("templates.vm.name", "Basic VM Template")
-	viper.SetDefault("templates.vm.file", "templates/vm-template.tmpl")
-	viper.SetDefault("templates.kubernetes.id", "k8s-template-1")
-	viper.SetDefault("templates.kubernetes.name", "Basic Kubernetes Template")
-	viper.SetDefault("templates.kubernetes.file", "templates/kubernetes-template.tmpl")
+	viper.SetDefault("storage.driver", storage.DriverMemory)
+	viper.SetDefault("storage.path", "data/paas-provider.db")
+	viper.SetDefault("templates.dir", "templates")
+	viper.SetDefault("templates.watch", true)
+	viper.SetDefault("drift.interval", 10*time.Minute)
+	viper.SetDefault("provisioners.sync_interval", time.Minute)
+	viper.SetDefault("operations.workers", 4)
+	viper.SetDefault("operations.retention", 24*time.Hour)
+	viper.SetDefault("operations.shutdown_grace", 30*time.Second)
+	viper.SetDefault("render.timeout", tmplproc.DefaultLimits.Timeout)
+	viper.SetDefault("render.max_output_bytes", tmplproc.DefaultLimits.MaxOutputBytes)
+	viper.SetDefault("render.max_depth", tmplproc.DefaultLimits.MaxDepth)
+	viper.SetDefault("render.max_cached_templates", tmplproc.DefaultLimits.MaxCachedTemplates)
 
 	viper.SetConfigName("config")
 	viper.SetConfigType("yaml")
@@ -62,63 +131,25 @@ func initConfig() {
 	}
 }
 
-// loadTemplates loads templates from files specified in the config
-func loadTemplates(store *storage.Storage) {
-	// Default template content
-	defaultVMTemplate := `Name: {{ .Name }}
-CPU: {{ .CPU }} cores
-Memory: {{ .Memory }} MB
-OS: {{ .OS }}`
-
-	defaultK8sTemplate := `Name: {{ .Name }}
-Region: {{ .Region }}
-Node Count: {{ .NodeCount }}
-Kubernetes Version: {{ .Version }}`
-
-	// Load VM template
-	vmTemplateFile := viper.GetString("templates.vm.file")
-	vmTemplateContent, err := os.ReadFile(vmTemplateFile)
-	if err != nil {
-		log.Printf("Warning: Could not read VM template file: %v. Using default template.", err)
-		vmTemplateContent = []byte(defaultVMTemplate)
-	}
+func runServer(s storage.Storage, tmplProc *tmplproc.TemplateProcessor, reloader *catalog.Reloader, provisioners *provisioner.Registry, runner *longrunning.Runner) {
+	mux := http.NewServeMux()
 
-	vmTemplate := storage.Template{
-		ID:          viper.GetString("templates.vm.id"),
-		Name:        viper.GetString("templates.vm.name"),
-		Type:        "vm",
-		RawTemplate: string(vmTemplateContent),
-	}
-	store.CreateTemplate(vmTemplate)
-	log.Printf("Loaded VM template: %s", vmTemplate.Name)
+	vmService := vm.NewService(s, tmplProc, provisioners, runner)
+	k8sService := k8s.NewService(s, tmplProc, provisioners, runner)
 
-	// Load Kubernetes template
-	k8sTemplateFile := viper.GetString("templates.kubernetes.file")
-	k8sTemplateContent, err := os.ReadFile(k8sTemplateFile)
-	if err != nil {
-		log.Printf("Warning: Could not read Kubernetes template file: %v. Using default template.", err)
-		k8sTemplateContent = []byte(defaultK8sTemplate)
+	// Resume the operations interrupted by the last stop of the server, now
+	// that the services know how to
+	if err := runner.Recover(); err != nil {
+		log.Printf("Warning: Could not recover interrupted operations: %v", err)
 	}
 
-	k8sTemplate := storage.Template{
-		ID:          viper.GetString("templates.kubernetes.id"),
-		Name:        viper.GetString("templates.kubernetes.name"),
-		Type:        "kubernetes",
-		RawTemplate: string(k8sTemplateContent),
-	}
-	store.CreateTemplate(k8sTemplate)
-	log.Printf("Loaded Kubernetes template: %s", k8sTemplate.Name)
-}
-
-func runServer(s *storage.Storage) {
-	mux := http.NewServeMux()
-	tmplProc := tmplproc.NewTemplateProcessor(s)
-
-	path, handler := templatev1connect.NewTemplateServiceHandler(template.NewService(s, tmplProc))
+	path, handler := templatev1connect.NewTemplateServiceHandler(template.NewService(s, tmplProc, reloader, vmService, k8sService))
+	mux.Handle(path, handler)
+	path, handler = virtual_machinev1connect.NewVirtualMachineServiceHandler(vmService)
 	mux.Handle(path, handler)
-	path, handler = virtual_machinev1connect.NewVirtualMachineServiceHandler(vm.NewService(s, tmplProc))
+	path, handler = kubernetes_clusterv1connect.NewKubernetesClusterServiceHandler(k8sService)
 	mux.Handle(path, handler)
-	path, handler = kubernetes_clusterv1connect.NewKubernetesClusterServiceHandler(k8s.NewService(s, tmplProc))
+	path, handler = operationsv1connect.NewOperationServiceHandler(operations.NewService(s, runner))
 	mux.Handle(path, handler)
 
 	port := viper.GetInt("server.port")
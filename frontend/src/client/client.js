@@ -3,6 +3,7 @@ import { createClient } from '@connectrpc/connect'
 import {TemplateService} from "../gen/template/v1/template_pb";
 import {VirtualMachineService} from "../gen/virtual_machine/v1/virtual_machine_pb";
 import {KubernetesClusterService} from "../gen/kubernetes_cluster/v1/kubernetes_cluster_pb";
+import {OperationService} from "../gen/operations/v1/operations_pb";
 
 export const transport = createConnectTransport({
     baseUrl: 'http://localhost:8080',
@@ -11,5 +12,6 @@ export const transport = createConnectTransport({
 export default {
     templates: createClient(TemplateService, transport),
     virtualMachines: createClient(VirtualMachineService, transport),
-    kubernetesClusters: createClient(KubernetesClusterService, transport)
+    kubernetesClusters: createClient(KubernetesClusterService, transport),
+    operations: createClient(OperationService, transport)
 }
\ No newline at end of file
@@ -13,7 +13,7 @@ import {
   FormLabel
 } from '@mui/material';
 
-const DynamicForm = ({ fields, onSubmit, buttonText = "Применить", initialValues = {} }) => {
+const DynamicForm = ({ fields, dynamicFields, onSubmit, buttonText = "Применить", initialValues = {} }) => {
   const [formData, setFormData] = useState(initialValues);
   const [errors, setErrors] = useState({});
   const prevInitialValuesRef = useRef();
@@ -38,11 +38,14 @@ const DynamicForm = ({ fields, onSubmit, buttonText = "Применить", init
     });
   };
 
+  // Fields that depend on the entered values, e.g. the parameters of the selected template
+  const allFields = dynamicFields ? [...fields, ...dynamicFields(formData)] : fields;
+
   const validateForm = () => {
     let valid = true;
     const newErrors = {};
 
-    fields.forEach(field => {
+    allFields.forEach(field => {
       if (field.required && !formData[field.name]) {
         newErrors[field.name] = 'Это поле обязательно для заполнения';
         valid = false;
@@ -56,14 +59,16 @@ const DynamicForm = ({ fields, onSubmit, buttonText = "Применить", init
   const handleSubmit = (e) => {
     e.preventDefault();
     if (validateForm()) {
-      onSubmit(formData);
+      // A submit button named "preview" asks for a dry run instead of saving
+      const preview = e.nativeEvent?.submitter?.name === 'preview';
+      onSubmit(formData, { preview });
     }
   };
 
   return (
     <Box component="form" id="resource-form" onSubmit={handleSubmit} noValidate sx={{ width: '100%' }}>
       <Stack spacing={3}>
-        {fields.map((field) => {
+        {allFields.map((field) => {
           const error = !!errors[field.name];
           const helperText = errors[field.name];
 
@@ -13,9 +13,10 @@ import {
   Divider,
   Chip
 } from '@mui/material';
-import { Close as CloseIcon } from '@mui/icons-material';
+import { Close as CloseIcon, CloudDownload as DownloadIcon } from '@mui/icons-material';
+import { artifactNames } from './artifacts';
 
-const ResourceDetail = ({ resource, fields, onClose, title }) => {
+const ResourceDetail = ({ resource, fields, onClose, onDownload, actions = [], title }) => {
   if (!resource) return null;
 
   return (
@@ -74,15 +75,15 @@ const ResourceDetail = ({ resource, fields, onClose, title }) => {
           ))}
         </Grid>
 
-        {resource.renderedTemplate && (
-          <Box sx={{ mt: 3 }}>
+        {artifactNames(resource.renderedArtifacts).map((name, index, names) => (
+          <Box sx={{ mt: 3 }} key={name}>
             <Divider sx={{ mb: 2 }} />
             <Typography
               variant="subtitle1"
               gutterBottom
               sx={{ fontWeight: 500 }}
             >
-              Результат
+              {names.length > 1 ? `Результат: ${name}` : 'Результат'}
             </Typography>
             <Paper
               variant="outlined"
@@ -96,13 +97,23 @@ const ResourceDetail = ({ resource, fields, onClose, title }) => {
                 borderRadius: 1
               }}
             >
-              {resource.renderedTemplate}
+              {resource.renderedArtifacts[name]}
             </Paper>
           </Box>
-        )}
+        ))}
       </DialogContent>
 
       <DialogActions sx={{ p: 2 }}>
+        {actions.map(action => (
+          <Button key={action.label} onClick={() => action.onClick(resource)}>
+            {action.label}
+          </Button>
+        ))}
+        {onDownload && artifactNames(resource.renderedArtifacts).length > 0 && (
+          <Button onClick={() => onDownload(resource)} startIcon={<DownloadIcon />}>
+            Скачать архив
+          </Button>
+        )}
         <Button onClick={onClose} variant="contained" color="primary">
           Закрыть
         </Button>
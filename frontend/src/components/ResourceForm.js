@@ -11,16 +11,20 @@ import {
 } from '@mui/material';
 import { Close as CloseIcon } from '@mui/icons-material';
 import DynamicForm from './DynamicForm';
+import { parameterFormValues } from './parameters';
 
 const ResourceForm = ({ 
   resource, 
   fields, 
+  dynamicFields,
   onSubmit, 
   onCancel, 
   title, 
-  submitButtonText = "Сохранить" 
+  submitButtonText = "Сохранить",
+  previewable = false
 }) => {
   const [initialValues, setInitialValues] = useState({});
+  const [preview, setPreview] = useState(null);
 
   useEffect(() => {
     if (resource) {
@@ -31,11 +35,15 @@ const ResourceForm = ({
           values[field.name] = resource[field.name];
         }
       });
+      // Parameter values are edited as separate fields
+      if (dynamicFields && resource.parameters) {
+        Object.assign(values, parameterFormValues(resource.parameters));
+      }
       setInitialValues(values);
     }
-  }, [resource, fields]);
+  }, [resource, fields, dynamicFields]);
 
-  const handleSubmit = (formData) => {
+  const handleSubmit = async (formData, options = {}) => {
     // If editing an existing resource, preserve the ID
     if (resource && resource.id) {
       formData.id = resource.id;
@@ -46,7 +54,12 @@ const ResourceForm = ({
       formData.templateId = resource.templateId;
     }
 
-    onSubmit(formData);
+    const result = await onSubmit(formData, options);
+
+    // Show the rendered template of a dry run
+    if (options.preview) {
+      setPreview(result ?? null);
+    }
   };
 
   return (
@@ -87,16 +100,53 @@ const ResourceForm = ({
       <DialogContent dividers sx={{ p: 3 }}>
         <DynamicForm 
           fields={fields} 
+          dynamicFields={dynamicFields}
           onSubmit={handleSubmit} 
           buttonText={submitButtonText}
           initialValues={initialValues}
         />
+
+        {preview !== null && (
+          <Box sx={{ mt: 3 }}>
+            <Typography variant="subtitle2" gutterBottom>
+              Результат шаблона
+            </Typography>
+            <Box
+              component="pre"
+              sx={{
+                m: 0,
+                p: 2,
+                backgroundColor: 'background.default',
+                borderRadius: 1,
+                fontFamily: '"Roboto Mono", monospace',
+                fontSize: '0.875rem',
+                whiteSpace: 'pre-wrap',
+                overflowX: 'auto'
+              }}
+            >
+              {preview}
+            </Box>
+          </Box>
+        )}
       </DialogContent>
 
       <DialogActions sx={{ p: 2, justifyContent: 'space-between' }}>
         <Button onClick={onCancel} variant="outlined" color="inherit">
           Отмена
         </Button>
+        <Box sx={{ flexGrow: 1 }} />
+        {previewable && (
+          <Button
+            type="submit"
+            name="preview"
+            variant="outlined"
+            color="primary"
+            form="resource-form"
+            sx={{ mr: 1 }}
+          >
+            Предпросмотр
+          </Button>
+        )}
         <Button 
           type="submit" 
           variant="contained" 
@@ -31,6 +31,8 @@ const ResourceList = ({
   onView, 
   onEdit, 
   onDelete, 
+  hasMore = false,
+  onLoadMore,
   emptyMessage = "Нет доступных ресурсов" 
 }) => {
   const [confirmDelete, setConfirmDelete] = useState(null);
@@ -147,6 +149,14 @@ const ResourceList = ({
         </Table>
       </TableContainer>
 
+      {hasMore && onLoadMore && (
+        <Box sx={{ display: 'flex', justifyContent: 'center', mb: 3 }}>
+          <Button onClick={onLoadMore} color="primary" variant="outlined">
+            Загрузить ещё
+          </Button>
+        </Box>
+      )}
+
       {/* Delete confirmation dialog */}
       <Dialog
         open={Boolean(confirmDelete)}
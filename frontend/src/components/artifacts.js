@@ -0,0 +1,71 @@
+// Helpers for templates with several files and the artifacts rendered from them
+
+// Name of the artifact rendered from the template body
+export const MAIN_ARTIFACT = 'main';
+
+// Output formats as numbered in templatev1.OutputFormat
+export const OUTPUT_FORMATS = { 1: 'plain', 2: 'yaml', 3: 'json', 4: 'toml', 5: 'ini', 6: 'cloud-config' };
+
+// Header line that starts a file when the files of a template are edited as
+// text, with an optional output format: "### user-data (cloud-config)"
+const FILE_HEADER = /^### (\S+)(?: \((\S+)\))?\s*$/;
+
+// Artifact names with the main artifact first and the others sorted
+export const artifactNames = (artifacts = {}) =>
+  Object.keys(artifacts).sort((a, b) => {
+    if (a === MAIN_ARTIFACT || b === MAIN_ARTIFACT) {
+      return a === MAIN_ARTIFACT ? -1 : 1;
+    }
+    return a.localeCompare(b);
+  });
+
+// Format rendered artifacts as one text, with a header before each artifact
+// when there are several
+export const formatArtifacts = (artifacts = {}) => {
+  const names = artifactNames(artifacts);
+  if (names.length === 1 && names[0] === MAIN_ARTIFACT) {
+    return artifacts[MAIN_ARTIFACT];
+  }
+  return names.map(name => `### ${name}\n${artifacts[name]}`).join('\n');
+};
+
+// Format the files of a template for editing as text
+export const formatTemplateFiles = (files = []) =>
+  files.map(file => {
+    const format = OUTPUT_FORMATS[file.outputFormat];
+    return `### ${file.name}${format ? ` (${format})` : ''}\n${file.rawTemplate}`;
+  }).join('\n');
+
+// Parse the files of a template edited as text: each file starts with a
+// "### <name>" or "### <name> (<format>)" line
+export const parseTemplateFiles = (text) => {
+  const formatNumbers = Object.fromEntries(Object.entries(OUTPUT_FORMATS).map(([number, name]) => [name, Number(number)]));
+  const files = [];
+  if (!text || !text.trim()) {
+    return files;
+  }
+  text.split('\n').forEach((line, index) => {
+    const header = line.match(FILE_HEADER);
+    if (header) {
+      if (header[2] && !formatNumbers[header[2]]) {
+        throw new Error(`строка ${index + 1}: неизвестный формат "${header[2]}"`);
+      }
+      files.push({ name: header[1], outputFormat: formatNumbers[header[2]] || 0, lines: [] });
+    } else if (files.length > 0) {
+      files[files.length - 1].lines.push(line);
+    } else if (line.trim()) {
+      throw new Error(`строка ${index + 1}: файл должен начинаться с "### <имя>"`);
+    }
+  });
+  return files.map(file => ({ name: file.name, outputFormat: file.outputFormat, rawTemplate: file.lines.join('\n') }));
+};
+
+// Save an archive returned by ExportRenderedArtifacts
+export const downloadArchive = (response) => {
+  const url = URL.createObjectURL(new Blob([response.archive], { type: response.contentType }));
+  const link = document.createElement('a');
+  link.href = url;
+  link.download = response.fileName;
+  link.click();
+  URL.revokeObjectURL(url);
+};
@@ -0,0 +1,92 @@
+// Helpers for the parameters declared by templates (templatev1.Parameter)
+
+// Prefix of form fields holding parameter values
+const PARAMETER_PREFIX = 'parameters.';
+
+// Parameter types as numbered in templatev1.Parameter.Type
+const PARAMETER_TYPES = { 1: 'string', 2: 'integer', 3: 'number', 4: 'boolean' };
+
+// Build form fields for the parameters declared by a template
+export const parameterFields = (template) =>
+  (template?.parameters || []).map(parameter => {
+    const field = {
+      name: PARAMETER_PREFIX + parameter.name,
+      label: parameter.description ? `${parameter.name} (${parameter.description})` : parameter.name,
+      required: parameter.required && !parameter.defaultValue,
+      placeholder: parameter.defaultValue ? `По умолчанию: ${parameter.defaultValue}` : ''
+    };
+
+    if (parameter.allowedValues.length > 0) {
+      return {
+        ...field,
+        type: 'select',
+        options: parameter.allowedValues.map(value => ({ value, label: value }))
+      };
+    }
+
+    switch (PARAMETER_TYPES[parameter.type]) {
+      case 'boolean':
+        return {
+          ...field,
+          type: 'select',
+          options: [
+            { value: 'true', label: 'Да' },
+            { value: 'false', label: 'Нет' }
+          ]
+        };
+      case 'integer':
+      case 'number':
+        return { ...field, type: 'number', min: parameter.min, max: parameter.max };
+      default:
+        return { ...field, type: 'text' };
+    }
+  });
+
+// Collect the parameter values entered in a form
+export const collectParameters = (formData) => {
+  const parameters = {};
+  Object.entries(formData).forEach(([key, value]) => {
+    if (key.startsWith(PARAMETER_PREFIX) && value !== undefined && value !== '') {
+      parameters[key.substring(PARAMETER_PREFIX.length)] = String(value);
+    }
+  });
+  return parameters;
+};
+
+// Convert the parameter values of a resource to form values
+export const parameterFormValues = (parameters = {}) => {
+  const values = {};
+  Object.entries(parameters).forEach(([name, value]) => {
+    values[PARAMETER_PREFIX + name] = value;
+  });
+  return values;
+};
+
+// Format a parameter schema as JSON for editing, with type names instead of numbers
+export const formatParameterSchema = (parameters = []) => {
+  if (parameters.length === 0) {
+    return '';
+  }
+  return JSON.stringify(parameters.map(parameter => {
+    const declaration = { name: parameter.name, type: PARAMETER_TYPES[parameter.type] };
+    if (parameter.required) declaration.required = true;
+    if (parameter.defaultValue) declaration.defaultValue = parameter.defaultValue;
+    if (parameter.allowedValues.length > 0) declaration.allowedValues = parameter.allowedValues;
+    if (parameter.min !== undefined) declaration.min = parameter.min;
+    if (parameter.max !== undefined) declaration.max = parameter.max;
+    if (parameter.description) declaration.description = parameter.description;
+    return declaration;
+  }), null, 2);
+};
+
+// Parse a parameter schema edited as JSON
+export const parseParameterSchema = (json) => {
+  if (!json || !json.trim()) {
+    return [];
+  }
+  const typeNumbers = Object.fromEntries(Object.entries(PARAMETER_TYPES).map(([number, name]) => [name, Number(number)]));
+  return JSON.parse(json).map(parameter => ({
+    ...parameter,
+    type: typeNumbers[parameter.type] || 0
+  }));
+};
@@ -0,0 +1,67 @@
+// Helpers for the lifecycle and drift status of virtual machines and clusters
+import { timestampDate } from '@bufbuild/protobuf/wkt';
+
+// Lifecycle statuses as numbered in the Status enums of resources
+export const STATUSES = {
+  1: 'Ожидание',
+  2: 'Подготовка',
+  3: 'В работе',
+  4: 'Остановка',
+  5: 'Остановлено',
+  6: 'Обновление',
+  7: 'Удаление',
+  8: 'Ошибка',
+  9: 'Перезагрузка',
+  10: 'Приостановка',
+  11: 'Приостановлено'
+};
+
+// Actions of the event history of virtual machines
+export const EVENT_ACTIONS = {
+  create: 'Создание',
+  update: 'Изменение',
+  delete: 'Удаление',
+  start: 'Запуск',
+  stop: 'Остановка',
+  force_stop: 'Принудительная остановка',
+  restart: 'Перезагрузка',
+  suspend: 'Приостановка',
+  restore: 'Восстановление из снимка'
+};
+
+// Drift statuses as numbered in the DriftStatus enums of resources
+export const DRIFT_STATUSES = {
+  1: 'Актуально',
+  2: 'Расходится с шаблоном',
+  3: 'Шаблон не рендерится'
+};
+
+// Format a timestamp in the local time zone
+export const formatTimestamp = (timestamp) =>
+  timestamp ? timestampDate(timestamp).toLocaleString() : '—';
+
+// Format the conditions of a resource, one per line: "Ready: да (Provisioned)"
+export const formatConditions = (conditions = []) =>
+  conditions.map(condition => {
+    const reason = condition.reason ? ` (${condition.reason})` : '';
+    const message = condition.message ? `: ${condition.message}` : '';
+    return `${condition.type}: ${condition.status ? 'да' : 'нет'}${reason}${message}`;
+  }).join('\n') || '—';
+
+// Format the event history of a virtual machine, newest first:
+// "01.01.2025, 12:00:00 Запуск: успешно"
+export const formatEvents = (events = []) =>
+  [...events].reverse().map(event => {
+    const outcome = event.succeeded ? 'успешно' : `ошибка${event.message ? ` (${event.message})` : ''}`;
+    return `${formatTimestamp(event.time)} ${EVENT_ACTIONS[event.action] || event.action}: ${outcome}`;
+  }).join('\n') || '—';
+
+// Detail fields shared by virtual machines and clusters
+export const statusFields = [
+  { key: 'status', label: 'Статус', render: (resource) => STATUSES[resource.status] || '—' },
+  { key: 'conditions', label: 'Условия', render: (resource) => formatConditions(resource.conditions) },
+  { key: 'provisioner', label: 'Драйвер', render: (resource) => resource.provisioner || '—' },
+  { key: 'driftStatus', label: 'Соответствие шаблону', render: (resource) => DRIFT_STATUSES[resource.driftStatus] || '—' },
+  { key: 'createTime', label: 'Создан', render: (resource) => formatTimestamp(resource.createTime) },
+  { key: 'updateTime', label: 'Изменён', render: (resource) => formatTimestamp(resource.updateTime) }
+];
@@ -2,13 +2,14 @@
 // @generated from file kubernetes_cluster/v1/kubernetes_cluster.proto (package kubernetes_cluster.v1, syntax proto3)
 /* eslint-disable */
 
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv1";
+import { file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 
 /**
  * Describes the file kubernetes_cluster/v1/kubernetes_cluster.proto.
  */
 export const file_kubernetes_cluster_v1_kubernetes_cluster = /*@__PURE__*/
-  fileDesc("Ci5rdWJlcm5ldGVzX2NsdXN0ZXIvdjEva3ViZXJuZXRlc19jbHVzdGVyLnByb3RvEhVrdWJlcm5ldGVzX2NsdXN0ZXIudjEikgEKEUt1YmVybmV0ZXNDbHVzdGVyEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSDgoGcmVnaW9uGAMgASgJEhIKCm5vZGVfY291bnQYBCABKAUSDwoHdmVyc2lvbhgFIAEoCRITCgt0ZW1wbGF0ZV9pZBgGIAEoCRIZChFyZW5kZXJlZF90ZW1wbGF0ZRgHIAEoCSJmCh5DcmVhdGVLdWJlcm5ldGVzQ2x1c3RlclJlcXVlc3QSRAoSa3ViZXJuZXRlc19jbHVzdGVyGAEgASgLMigua3ViZXJuZXRlc19jbHVzdGVyLnYxLkt1YmVybmV0ZXNDbHVzdGVyImcKH0NyZWF0ZUt1YmVybmV0ZXNDbHVzdGVyUmVzcG9uc2USRAoSa3ViZXJuZXRlc19jbHVzdGVyGAEgASgLMigua3ViZXJuZXRlc19jbHVzdGVyLnYxLkt1YmVybmV0ZXNDbHVzdGVyIikKG0dldEt1YmVybmV0ZXNDbHVzdGVyUmVxdWVzdBIKCgJpZBgBIAEoCSJkChxHZXRLdWJlcm5ldGVzQ2x1c3RlclJlc3BvbnNlEkQKEmt1YmVybmV0ZXNfY2x1c3RlchgBIAEoCzIoLmt1YmVybmV0ZXNfY2x1c3Rlci52MS5LdWJlcm5ldGVzQ2x1c3RlciIfCh1MaXN0S3ViZXJuZXRlc0NsdXN0ZXJzUmVxdWVzdCJnCh5MaXN0S3ViZXJuZXRlc0NsdXN0ZXJzUmVzcG9uc2USRQoTa3ViZXJuZXRlc19jbHVzdGVycxgBIAMoCzIoLmt1YmVybmV0ZXNfY2x1c3Rlci52MS5LdWJlcm5ldGVzQ2x1c3RlciJmCh5VcGRhdGVLdWJlcm5ldGVzQ2x1c3RlclJlcXVlc3QSRAoSa3ViZXJuZXRlc19jbHVzdGVyGAEgASgLMigua3ViZXJuZXRlc19jbHVzdGVyLnYxLkt1YmVybmV0ZXNDbHVzdGVyImcKH1VwZGF0ZUt1YmVybmV0ZXNDbHVzdGVyUmVzcG9uc2USRAoSa3ViZXJuZXRlc19jbHVzdGVyGAEgASgLMigua3ViZXJuZXRlc19jbHVzdGVyLnYxLkt1YmVybmV0ZXNDbHVzdGVyIiwKHkRlbGV0ZUt1YmVybmV0ZXNDbHVzdGVyUmVxdWVzdBIKCgJpZBgBIAEoCSIyCh9EZWxldGVLdWJlcm5ldGVzQ2x1c3RlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiMwolR2V0S3ViZXJuZXRlc0NsdXN0ZXJLdWJlY29uZmlnUmVxdWVzdBIKCgJpZBgBIAEoCSI8CiZHZXRLdWJlcm5ldGVzQ2x1c3Rlckt1YmVjb25maWdSZXNwb25zZRISCgprdWJlY29uZmlnGAEgASgJMuQGChhLdWJlcm5ldGVzQ2x1c3RlclNlcnZpY2USiAEKF0NyZWF0ZUt1YmVybmV0ZXNDbHVzdGVyEjUua3ViZXJuZXRlc19jbHVzdGVyLnYxLkNyZWF0ZUt1YmVybmV0ZXNDbHVzdGVyUmVxdWVzdBo2Lmt1YmVybmV0ZXNfY2x1c3Rlci52MS5DcmVhdGVLdWJlcm5ldGVzQ2x1c3RlclJlc3BvbnNlEn8KFEdldEt1YmVybmV0ZXNDbHVzdGVyEjIua3ViZXJuZXRlc19jbHVzdGVyLnYxLkdldEt1YmVybmV0ZXNDbHVzdGVyUmVxdWVzdBozLmt1YmVybmV0ZXNfY2x1c3Rlci52MS5HZXRLdWJlcm5ldGVzQ2x1c3RlclJlc3BvbnNlEoUBChZMaXN0S3ViZXJuZXRlc0NsdXN0ZXJzEjQua3ViZXJuZXRlc19jbHVzdGVyLnYxLkxpc3RLdWJlcm5ldGVzQ2x1c3RlcnNSZXF1ZXN0GjUua3ViZXJuZXRlc19jbHVzdGVyLnYxLkxpc3RLdWJlcm5ldGVzQ2x1c3RlcnNSZXNwb25zZRKIAQoXVXBkYXRlS3ViZXJuZXRlc0NsdXN0ZXISNS5rdWJlcm5ldGVzX2NsdXN0ZXIudjEuVXBkYXRlS3ViZXJuZXRlc0NsdXN0ZXJSZXF1ZXN0GjYua3ViZXJuZXRlc19jbHVzdGVyLnYxLlVwZGF0ZUt1YmVybmV0ZXNDbHVzdGVyUmVzcG9uc2USiAEKF0RlbGV0ZUt1YmVybmV0ZXNDbHVzdGVyEjUua3ViZXJuZXRlc19jbHVzdGVyLnYxLkRlbGV0ZUt1YmVybmV0ZXNDbHVzdGVyUmVxdWVzdBo2Lmt1YmVybmV0ZXNfY2x1c3Rlci52MS5EZWxldGVLdWJlcm5ldGVzQ2x1c3RlclJlc3BvbnNlEp0BCh5HZXRLdWJlcm5ldGVzQ2x1c3Rlckt1YmVjb25maWcSPC5rdWJlcm5ldGVzX2NsdXN0ZXIudjEuR2V0S3ViZXJuZXRlc0NsdXN0ZXJLdWJlY29uZmlnUmVxdWVzdBo9Lmt1YmVybmV0ZXNfY2x1c3Rlci52MS5HZXRLdWJlcm5ldGVzQ2x1c3Rlckt1YmVjb25maWdSZXNwb25zZUL8AQoZY29tLmt1YmVybmV0ZXNfY2x1c3Rlci52MUIWS3ViZXJuZXRlc0NsdXN0ZXJQcm90b1ABWlZnaXRodWIuY29tL2FhMWV4L3BhYXMtcHJvdmlkZXIvcGtnL2FwaS9ncnBjL2t1YmVybmV0ZXNfY2x1c3Rlci92MTtrdWJlcm5ldGVzX2NsdXN0ZXJ2MaICA0tYWKoCFEt1YmVybmV0ZXNDbHVzdGVyLlYxygIUS3ViZXJuZXRlc0NsdXN0ZXJcVjHiAiBLdWJlcm5ldGVzQ2x1c3RlclxWMVxHUEJNZXRhZGF0YeoCFUt1YmVybmV0ZXNDbHVzdGVyOjpWMWIGcHJvdG8z");
+  fileDesc("Ci5rdWJlcm5ldGVzX2NsdXN0ZXIvdjEva3ViZXJuZXRlc19jbHVzdGVyLnByb3RvEhVrdWJlcm5ldGVzX2NsdXN0ZXIudjEaIGdvb2dsZS9wcm90b2J1Zi9maWVsZF9tYXNrLnByb3RvGh9nb29nbGUvcHJvdG9idWYvdGltZXN0YW1wLnByb3RvIpkJChFLdWJlcm5ldGVzQ2x1c3RlchIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEg4KBnJlZ2lvbhgDIAEoCRISCgpub2RlX2NvdW50GAQgASgFEg8KB3ZlcnNpb24YBSABKAkSEwoLdGVtcGxhdGVfaWQYBiABKAkSGAoQcmVzb3VyY2VfdmVyc2lvbhgIIAEoAxIZChF0ZW1wbGF0ZV9yZXZpc2lvbhgJIAEoAxJMCgpwYXJhbWV0ZXJzGAogAygLMjgua3ViZXJuZXRlc19jbHVzdGVyLnYxLkt1YmVybmV0ZXNDbHVzdGVyLlBhcmFtZXRlcnNFbnRyeRJbChJyZW5kZXJlZF9hcnRpZmFjdHMYCyADKAsyPy5rdWJlcm5ldGVzX2NsdXN0ZXIudjEuS3ViZXJuZXRlc0NsdXN0ZXIuUmVuZGVyZWRBcnRpZmFjdHNFbnRyeRJKCgxkcmlmdF9zdGF0dXMYDCABKA4yNC5rdWJlcm5ldGVzX2NsdXN0ZXIudjEuS3ViZXJuZXRlc0NsdXN0ZXIuRHJpZnRTdGF0dXMSNQoRZHJpZnRfc3RhdHVzX3RpbWUYDSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEj8KBnN0YXR1cxgOIAEoDjIvLmt1YmVybmV0ZXNfY2x1c3Rlci52MS5LdWJlcm5ldGVzQ2x1c3Rlci5TdGF0dXMSNAoKY29uZGl0aW9ucxgPIAMoCzIgLmt1YmVybmV0ZXNfY2x1c3Rlci52MS5Db25kaXRpb24SLwoLY3JlYXRlX3RpbWUYECABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi8KC3VwZGF0ZV90aW1lGBEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBITCgtwcm92aXNpb25lchgSIAEoCRoxCg9QYXJhbWV0ZXJzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARo4ChZSZW5kZXJlZEFydGlmYWN0c0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEifwoLRHJpZnRTdGF0dXMSHAoYRFJJRlRfU1RBVFVTX1VOU1BFQ0lGSUVEEAASGAoURFJJRlRfU1RBVFVTX0lOX1NZTkMQARIYChREUklGVF9TVEFUVVNfRFJJRlRFRBACEh4KGkRSSUZUX1NUQVRVU19SRU5ERVJfRkFJTEVEEAMixwEKBlN0YXR1cxIWChJTVEFUVVNfVU5TUEVDSUZJRUQQABISCg5TVEFUVVNfUEVORElORxABEhcKE1NUQVRVU19QUk9WSVNJT05JTkcQAhISCg5TVEFUVVNfUlVOTklORxADEhMKD1NUQVRVU19TVE9QUElORxAEEhIKDlNUQVRVU19TVE9QUEVEEAUSEwoPU1RBVFVTX1VQREFUSU5HEAYSEwoPU1RBVFVTX0RFTEVUSU5HEAcSEQoNU1RBVFVTX0ZBSUxFRBAISgQIBxAIUhFyZW5kZXJlZF90ZW1wbGF0ZSK6AQoJQ29uZGl0aW9uEgwKBHR5cGUYASABKAkSDgoGc3RhdHVzGAIgASgIEg4KBnJlYXNvbhgDIAEoCRIPCgdtZXNzYWdlGAQgASgJEjgKFGxhc3RfdHJhbnNpdGlvbl90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI0ChBsYXN0X3VwZGF0ZV90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJ9Ch5DcmVhdGVLdWJlcm5ldGVzQ2x1c3RlclJlcXVlc3QSRAoSa3ViZXJuZXRlc19jbHVzdGVyGAEgASgLMigua3ViZXJuZXRlc19jbHVzdGVyLnYxLkt1YmVybmV0ZXNDbHVzdGVyEhUKDXZhbGlkYXRlX29ubHkYAiABKAgifQofQ3JlYXRlS3ViZXJuZXRlc0NsdXN0ZXJSZXNwb25zZRJEChJrdWJlcm5ldGVzX2NsdXN0ZXIYASABKAsyKC5rdWJlcm5ldGVzX2NsdXN0ZXIudjEuS3ViZXJuZXRlc0NsdXN0ZXISFAoMb3BlcmF0aW9uX2lkGAIgASgJIikKG0dldEt1YmVybmV0ZXNDbHVzdGVyUmVxdWVzdBIKCgJpZBgBIAEoCSJkChxHZXRLdWJlcm5ldGVzQ2x1c3RlclJlc3BvbnNlEkQKEmt1YmVybmV0ZXNfY2x1c3RlchgBIAEoCzIoLmt1YmVybmV0ZXNfY2x1c3Rlci52MS5LdWJlcm5ldGVzQ2x1c3RlciJoCh1MaXN0S3ViZXJuZXRlc0NsdXN0ZXJzUmVxdWVzdBIRCglwYWdlX3NpemUYASABKAUSEgoKcGFnZV90b2tlbhgCIAEoCRIOCgZmaWx0ZXIYAyABKAkSEAoIb3JkZXJfYnkYBCABKAkigAEKHkxpc3RLdWJlcm5ldGVzQ2x1c3RlcnNSZXNwb25zZRJFChNrdWJlcm5ldGVzX2NsdXN0ZXJzGAEgAygLMigua3ViZXJuZXRlc19jbHVzdGVyLnYxLkt1YmVybmV0ZXNDbHVzdGVyEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSKuAQoeVXBkYXRlS3ViZXJuZXRlc0NsdXN0ZXJSZXF1ZXN0EkQKEmt1YmVybmV0ZXNfY2x1c3RlchgBIAEoCzIoLmt1YmVybmV0ZXNfY2x1c3Rlci52MS5LdWJlcm5ldGVzQ2x1c3RlchIvCgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2sSFQoNdmFsaWRhdGVfb25seRgDIAEoCCJ9Ch9VcGRhdGVLdWJlcm5ldGVzQ2x1c3RlclJlc3BvbnNlEkQKEmt1YmVybmV0ZXNfY2x1c3RlchgBIAEoCzIoLmt1YmVybmV0ZXNfY2x1c3Rlci52MS5LdWJlcm5ldGVzQ2x1c3RlchIUCgxvcGVyYXRpb25faWQYAiABKAkiRgoeRGVsZXRlS3ViZXJuZXRlc0NsdXN0ZXJSZXF1ZXN0EgoKAmlkGAEgASgJEhgKEHJlc291cmNlX3ZlcnNpb24YAiABKAMiSAofRGVsZXRlS3ViZXJuZXRlc0NsdXN0ZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhQKDG9wZXJhdGlvbl9pZBgCIAEoCSIzCiVHZXRLdWJlcm5ldGVzQ2x1c3Rlckt1YmVjb25maWdSZXF1ZXN0EgoKAmlkGAEgASgJIjwKJkdldEt1YmVybmV0ZXNDbHVzdGVyS3ViZWNvbmZpZ1Jlc3BvbnNlEhIKCmt1YmVjb25maWcYASABKAkiNgoaR2V0UmVuZGVyZWRBcnRpZmFjdFJlcXVlc3QSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCSI8ChtHZXRSZW5kZXJlZEFydGlmYWN0UmVzcG9uc2USDAoEbmFtZRgBIAEoCRIPCgdjb250ZW50GAIgASgJIr8BCh5FeHBvcnRSZW5kZXJlZEFydGlmYWN0c1JlcXVlc3QSCgoCaWQYASABKAkSTAoGZm9ybWF0GAIgASgOMjwua3ViZXJuZXRlc19jbHVzdGVyLnYxLkV4cG9ydFJlbmRlcmVkQXJ0aWZhY3RzUmVxdWVzdC5Gb3JtYXQiQwoGRm9ybWF0EhYKEkZPUk1BVF9VTlNQRUNJRklFRBAAEhEKDUZPUk1BVF9UQVJfR1oQARIOCgpGT1JNQVRfWklQEAIiWwofRXhwb3J0UmVuZGVyZWRBcnRpZmFjdHNSZXNwb25zZRIRCglmaWxlX25hbWUYASABKAkSFAoMY29udGVudF90eXBlGAIgASgJEg8KB2FyY2hpdmUYAyABKAwy7QgKGEt1YmVybmV0ZXNDbHVzdGVyU2VydmljZRKIAQoXQ3JlYXRlS3ViZXJuZXRlc0NsdXN0ZXISNS5rdWJlcm5ldGVzX2NsdXN0ZXIudjEuQ3JlYXRlS3ViZXJuZXRlc0NsdXN0ZXJSZXF1ZXN0GjYua3ViZXJuZXRlc19jbHVzdGVyLnYxLkNyZWF0ZUt1YmVybmV0ZXNDbHVzdGVyUmVzcG9uc2USfwoUR2V0S3ViZXJuZXRlc0NsdXN0ZXISMi5rdWJlcm5ldGVzX2NsdXN0ZXIudjEuR2V0S3ViZXJuZXRlc0NsdXN0ZXJSZXF1ZXN0GjMua3ViZXJuZXRlc19jbHVzdGVyLnYxLkdldEt1YmVybmV0ZXNDbHVzdGVyUmVzcG9uc2UShQEKFkxpc3RLdWJlcm5ldGVzQ2x1c3RlcnMSNC5rdWJlcm5ldGVzX2NsdXN0ZXIudjEuTGlzdEt1YmVybmV0ZXNDbHVzdGVyc1JlcXVlc3QaNS5rdWJlcm5ldGVzX2NsdXN0ZXIudjEuTGlzdEt1YmVybmV0ZXNDbHVzdGVyc1Jlc3BvbnNlEogBChdVcGRhdGVLdWJlcm5ldGVzQ2x1c3RlchI1Lmt1YmVybmV0ZXNfY2x1c3Rlci52MS5VcGRhdGVLdWJlcm5ldGVzQ2x1c3RlclJlcXVlc3QaNi5rdWJlcm5ldGVzX2NsdXN0ZXIudjEuVXBkYXRlS3ViZXJuZXRlc0NsdXN0ZXJSZXNwb25zZRKIAQoXRGVsZXRlS3ViZXJuZXRlc0NsdXN0ZXISNS5rdWJlcm5ldGVzX2NsdXN0ZXIudjEuRGVsZXRlS3ViZXJuZXRlc0NsdXN0ZXJSZXF1ZXN0GjYua3ViZXJuZXRlc19jbHVzdGVyLnYxLkRlbGV0ZUt1YmVybmV0ZXNDbHVzdGVyUmVzcG9uc2USfAoTR2V0UmVuZGVyZWRBcnRpZmFjdBIxLmt1YmVybmV0ZXNfY2x1c3Rlci52MS5HZXRSZW5kZXJlZEFydGlmYWN0UmVxdWVzdBoyLmt1YmVybmV0ZXNfY2x1c3Rlci52MS5HZXRSZW5kZXJlZEFydGlmYWN0UmVzcG9uc2USiAEKF0V4cG9ydFJlbmRlcmVkQXJ0aWZhY3RzEjUua3ViZXJuZXRlc19jbHVzdGVyLnYxLkV4cG9ydFJlbmRlcmVkQXJ0aWZhY3RzUmVxdWVzdBo2Lmt1YmVybmV0ZXNfY2x1c3Rlci52MS5FeHBvcnRSZW5kZXJlZEFydGlmYWN0c1Jlc3BvbnNlEp0BCh5HZXRLdWJlcm5ldGVzQ2x1c3Rlckt1YmVjb25maWcSPC5rdWJlcm5ldGVzX2NsdXN0ZXIudjEuR2V0S3ViZXJuZXRlc0NsdXN0ZXJLdWJlY29uZmlnUmVxdWVzdBo9Lmt1YmVybmV0ZXNfY2x1c3Rlci52MS5HZXRLdWJlcm5ldGVzQ2x1c3Rlckt1YmVjb25maWdSZXNwb25zZUL8AQoZY29tLmt1YmVybmV0ZXNfY2x1c3Rlci52MUIWS3ViZXJuZXRlc0NsdXN0ZXJQcm90b1ABWlZnaXRodWIuY29tL2FhMWV4L3BhYXMtcHJvdmlkZXIvcGtnL2FwaS9ncnBjL2t1YmVybmV0ZXNfY2x1c3Rlci92MTtrdWJlcm5ldGVzX2NsdXN0ZXJ2MaICA0tYWKoCFEt1YmVybmV0ZXNDbHVzdGVyLlYxygIUS3ViZXJuZXRlc0NsdXN0ZXJcVjHiAiBLdWJlcm5ldGVzQ2x1c3RlclxWMVxHUEJNZXRhZGF0YeoCFUt1YmVybmV0ZXNDbHVzdGVyOjpWMWIGcHJvdG8z", [file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
 
 /**
  * Describes the message kubernetes_cluster.v1.KubernetesCluster.
@@ -17,89 +18,160 @@ export const file_kubernetes_cluster_v1_kubernetes_cluster = /*@__PURE__*/
 export const KubernetesClusterSchema = /*@__PURE__*/
   messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 0);
 
+/**
+ * Describes the enum kubernetes_cluster.v1.KubernetesCluster.DriftStatus.
+ */
+export const KubernetesCluster_DriftStatusSchema = /*@__PURE__*/
+  enumDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 0, 0);
+
+/**
+ * @generated from enum kubernetes_cluster.v1.KubernetesCluster.DriftStatus
+ */
+export const KubernetesCluster_DriftStatus = /*@__PURE__*/
+  tsEnum(KubernetesCluster_DriftStatusSchema);
+
+/**
+ * Describes the enum kubernetes_cluster.v1.KubernetesCluster.Status.
+ */
+export const KubernetesCluster_StatusSchema = /*@__PURE__*/
+  enumDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 0, 1);
+
+/**
+ * @generated from enum kubernetes_cluster.v1.KubernetesCluster.Status
+ */
+export const KubernetesCluster_Status = /*@__PURE__*/
+  tsEnum(KubernetesCluster_StatusSchema);
+
+/**
+ * Describes the message kubernetes_cluster.v1.Condition.
+ * Use `create(ConditionSchema)` to create a new message.
+ */
+export const ConditionSchema = /*@__PURE__*/
+  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 1);
+
 /**
  * Describes the message kubernetes_cluster.v1.CreateKubernetesClusterRequest.
  * Use `create(CreateKubernetesClusterRequestSchema)` to create a new message.
  */
 export const CreateKubernetesClusterRequestSchema = /*@__PURE__*/
-  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 1);
+  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 2);
 
 /**
  * Describes the message kubernetes_cluster.v1.CreateKubernetesClusterResponse.
  * Use `create(CreateKubernetesClusterResponseSchema)` to create a new message.
  */
 export const CreateKubernetesClusterResponseSchema = /*@__PURE__*/
-  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 2);
+  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 3);
 
 /**
  * Describes the message kubernetes_cluster.v1.GetKubernetesClusterRequest.
  * Use `create(GetKubernetesClusterRequestSchema)` to create a new message.
  */
 export const GetKubernetesClusterRequestSchema = /*@__PURE__*/
-  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 3);
+  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 4);
 
 /**
  * Describes the message kubernetes_cluster.v1.GetKubernetesClusterResponse.
  * Use `create(GetKubernetesClusterResponseSchema)` to create a new message.
  */
 export const GetKubernetesClusterResponseSchema = /*@__PURE__*/
-  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 4);
+  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 5);
 
 /**
  * Describes the message kubernetes_cluster.v1.ListKubernetesClustersRequest.
  * Use `create(ListKubernetesClustersRequestSchema)` to create a new message.
  */
 export const ListKubernetesClustersRequestSchema = /*@__PURE__*/
-  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 5);
+  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 6);
 
 /**
  * Describes the message kubernetes_cluster.v1.ListKubernetesClustersResponse.
  * Use `create(ListKubernetesClustersResponseSchema)` to create a new message.
  */
 export const ListKubernetesClustersResponseSchema = /*@__PURE__*/
-  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 6);
+  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 7);
 
 /**
  * Describes the message kubernetes_cluster.v1.UpdateKubernetesClusterRequest.
  * Use `create(UpdateKubernetesClusterRequestSchema)` to create a new message.
  */
 export const UpdateKubernetesClusterRequestSchema = /*@__PURE__*/
-  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 7);
+  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 8);
 
 /**
  * Describes the message kubernetes_cluster.v1.UpdateKubernetesClusterResponse.
  * Use `create(UpdateKubernetesClusterResponseSchema)` to create a new message.
  */
 export const UpdateKubernetesClusterResponseSchema = /*@__PURE__*/
-  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 8);
+  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 9);
 
 /**
  * Describes the message kubernetes_cluster.v1.DeleteKubernetesClusterRequest.
  * Use `create(DeleteKubernetesClusterRequestSchema)` to create a new message.
  */
 export const DeleteKubernetesClusterRequestSchema = /*@__PURE__*/
-  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 9);
+  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 10);
 
 /**
  * Describes the message kubernetes_cluster.v1.DeleteKubernetesClusterResponse.
  * Use `create(DeleteKubernetesClusterResponseSchema)` to create a new message.
  */
 export const DeleteKubernetesClusterResponseSchema = /*@__PURE__*/
-  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 10);
+  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 11);
 
 /**
  * Describes the message kubernetes_cluster.v1.GetKubernetesClusterKubeconfigRequest.
  * Use `create(GetKubernetesClusterKubeconfigRequestSchema)` to create a new message.
  */
 export const GetKubernetesClusterKubeconfigRequestSchema = /*@__PURE__*/
-  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 11);
+  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 12);
 
 /**
  * Describes the message kubernetes_cluster.v1.GetKubernetesClusterKubeconfigResponse.
  * Use `create(GetKubernetesClusterKubeconfigResponseSchema)` to create a new message.
  */
 export const GetKubernetesClusterKubeconfigResponseSchema = /*@__PURE__*/
-  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 12);
+  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 13);
+
+/**
+ * Describes the message kubernetes_cluster.v1.GetRenderedArtifactRequest.
+ * Use `create(GetRenderedArtifactRequestSchema)` to create a new message.
+ */
+export const GetRenderedArtifactRequestSchema = /*@__PURE__*/
+  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 14);
+
+/**
+ * Describes the message kubernetes_cluster.v1.GetRenderedArtifactResponse.
+ * Use `create(GetRenderedArtifactResponseSchema)` to create a new message.
+ */
+export const GetRenderedArtifactResponseSchema = /*@__PURE__*/
+  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 15);
+
+/**
+ * Describes the message kubernetes_cluster.v1.ExportRenderedArtifactsRequest.
+ * Use `create(ExportRenderedArtifactsRequestSchema)` to create a new message.
+ */
+export const ExportRenderedArtifactsRequestSchema = /*@__PURE__*/
+  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 16);
+
+/**
+ * Describes the enum kubernetes_cluster.v1.ExportRenderedArtifactsRequest.Format.
+ */
+export const ExportRenderedArtifactsRequest_FormatSchema = /*@__PURE__*/
+  enumDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 16, 0);
+
+/**
+ * @generated from enum kubernetes_cluster.v1.ExportRenderedArtifactsRequest.Format
+ */
+export const ExportRenderedArtifactsRequest_Format = /*@__PURE__*/
+  tsEnum(ExportRenderedArtifactsRequest_FormatSchema);
+
+/**
+ * Describes the message kubernetes_cluster.v1.ExportRenderedArtifactsResponse.
+ * Use `create(ExportRenderedArtifactsResponseSchema)` to create a new message.
+ */
+export const ExportRenderedArtifactsResponseSchema = /*@__PURE__*/
+  messageDesc(file_kubernetes_cluster_v1_kubernetes_cluster, 17);
 
 /**
  * @generated from service kubernetes_cluster.v1.KubernetesClusterService
@@ -0,0 +1,118 @@
+// @generated by protoc-gen-es v2.2.5 with parameter "target=js"
+// @generated from file operations/v1/operations.proto (package operations.v1, syntax proto3)
+/* eslint-disable */
+
+import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv1";
+import { file_google_protobuf_duration, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_kubernetes_cluster_v1_kubernetes_cluster } from "../../kubernetes_cluster/v1/kubernetes_cluster_pb";
+import { file_virtual_machine_v1_virtual_machine } from "../../virtual_machine/v1/virtual_machine_pb";
+
+/**
+ * Describes the file operations/v1/operations.proto.
+ */
+export const file_operations_v1_operations = /*@__PURE__*/
+  fileDesc("Ch5vcGVyYXRpb25zL3YxL29wZXJhdGlvbnMucHJvdG8SDW9wZXJhdGlvbnMudjEaHmdvb2dsZS9wcm90b2J1Zi9kdXJhdGlvbi5wcm90bxofZ29vZ2xlL3Byb3RvYnVmL3RpbWVzdGFtcC5wcm90bxoua3ViZXJuZXRlc19jbHVzdGVyL3YxL2t1YmVybmV0ZXNfY2x1c3Rlci5wcm90bxoodmlydHVhbF9tYWNoaW5lL3YxL3ZpcnR1YWxfbWFjaGluZS5wcm90byLlCAoJT3BlcmF0aW9uEgoKAmlkGAEgASgJEisKBHR5cGUYAiABKA4yHS5vcGVyYXRpb25zLnYxLk9wZXJhdGlvbi5UeXBlEhwKEnZpcnR1YWxfbWFjaGluZV9pZBgDIAEoCUgAEh8KFWt1YmVybmV0ZXNfY2x1c3Rlcl9pZBgEIAEoCUgAEi0KBXN0YXRlGAUgASgOMh4ub3BlcmF0aW9ucy52MS5PcGVyYXRpb24uU3RhdGUSDAoEZG9uZRgGIAEoCBIYChBwcm9ncmVzc19wZXJjZW50GAcgASgFEhgKEGNhbmNlbF9yZXF1ZXN0ZWQYCCABKAgSPQoPdmlydHVhbF9tYWNoaW5lGAkgASgLMiIudmlydHVhbF9tYWNoaW5lLnYxLlZpcnR1YWxNYWNoaW5lSAESRgoSa3ViZXJuZXRlc19jbHVzdGVyGAogASgLMigua3ViZXJuZXRlc19jbHVzdGVyLnYxLkt1YmVybmV0ZXNDbHVzdGVySAESLAoFZXJyb3IYCyABKAsyHS5vcGVyYXRpb25zLnYxLk9wZXJhdGlvbkVycm9yEi8KC2NyZWF0ZV90aW1lGAwgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgt1cGRhdGVfdGltZRgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYDiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIpADCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIfChtUWVBFX0NSRUFURV9WSVJUVUFMX01BQ0hJTkUQARIfChtUWVBFX1VQREFURV9WSVJUVUFMX01BQ0hJTkUQAhIfChtUWVBFX0RFTEVURV9WSVJUVUFMX01BQ0hJTkUQAxIiCh5UWVBFX0NSRUFURV9LVUJFUk5FVEVTX0NMVVNURVIQBBIiCh5UWVBFX1VQREFURV9LVUJFUk5FVEVTX0NMVVNURVIQBRIiCh5UWVBFX0RFTEVURV9LVUJFUk5FVEVTX0NMVVNURVIQBhIeChpUWVBFX1NUQVJUX1ZJUlRVQUxfTUFDSElORRAHEh0KGVRZUEVfU1RPUF9WSVJUVUFMX01BQ0hJTkUQCBIgChxUWVBFX1JFU1RBUlRfVklSVFVBTF9NQUNISU5FEAkSIAocVFlQRV9TVVNQRU5EX1ZJUlRVQUxfTUFDSElORRAKEiAKHFRZUEVfUkVTVE9SRV9WSVJUVUFMX01BQ0hJTkUQCyKAAQoFU3RhdGUSFQoRU1RBVEVfVU5TUEVDSUZJRUQQABIRCg1TVEFURV9QRU5ESU5HEAESEQoNU1RBVEVfUlVOTklORxACEhMKD1NUQVRFX1NVQ0NFRURFRBADEhAKDFNUQVRFX0ZBSUxFRBAEEhMKD1NUQVRFX0NBTkNFTExFRBAFQgoKCHJlc291cmNlQggKBnJlc3VsdCIvCg5PcGVyYXRpb25FcnJvchIMCgRjb2RlGAEgASgJEg8KB21lc3NhZ2UYAiABKAkiIQoTR2V0T3BlcmF0aW9uUmVxdWVzdBIKCgJpZBgBIAEoCSJDChRHZXRPcGVyYXRpb25SZXNwb25zZRIrCglvcGVyYXRpb24YASABKAsyGC5vcGVyYXRpb25zLnYxLk9wZXJhdGlvbiJgChVMaXN0T3BlcmF0aW9uc1JlcXVlc3QSEQoJcGFnZV9zaXplGAEgASgFEhIKCnBhZ2VfdG9rZW4YAiABKAkSDgoGZmlsdGVyGAMgASgJEhAKCG9yZGVyX2J5GAQgASgJIl8KFkxpc3RPcGVyYXRpb25zUmVzcG9uc2USLAoKb3BlcmF0aW9ucxgBIAMoCzIYLm9wZXJhdGlvbnMudjEuT3BlcmF0aW9uEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSIkChZDYW5jZWxPcGVyYXRpb25SZXF1ZXN0EgoKAmlkGAEgASgJIkYKF0NhbmNlbE9wZXJhdGlvblJlc3BvbnNlEisKCW9wZXJhdGlvbhgBIAEoCzIYLm9wZXJhdGlvbnMudjEuT3BlcmF0aW9uIk4KFFdhaXRPcGVyYXRpb25SZXF1ZXN0EgoKAmlkGAEgASgJEioKB3RpbWVvdXQYAiABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24iRAoVV2FpdE9wZXJhdGlvblJlc3BvbnNlEisKCW9wZXJhdGlvbhgBIAEoCzIYLm9wZXJhdGlvbnMudjEuT3BlcmF0aW9uMogDChBPcGVyYXRpb25TZXJ2aWNlElcKDEdldE9wZXJhdGlvbhIiLm9wZXJhdGlvbnMudjEuR2V0T3BlcmF0aW9uUmVxdWVzdBojLm9wZXJhdGlvbnMudjEuR2V0T3BlcmF0aW9uUmVzcG9uc2USXQoOTGlzdE9wZXJhdGlvbnMSJC5vcGVyYXRpb25zLnYxLkxpc3RPcGVyYXRpb25zUmVxdWVzdBolLm9wZXJhdGlvbnMudjEuTGlzdE9wZXJhdGlvbnNSZXNwb25zZRJgCg9DYW5jZWxPcGVyYXRpb24SJS5vcGVyYXRpb25zLnYxLkNhbmNlbE9wZXJhdGlvblJlcXVlc3QaJi5vcGVyYXRpb25zLnYxLkNhbmNlbE9wZXJhdGlvblJlc3BvbnNlEloKDVdhaXRPcGVyYXRpb24SIy5vcGVyYXRpb25zLnYxLldhaXRPcGVyYXRpb25SZXF1ZXN0GiQub3BlcmF0aW9ucy52MS5XYWl0T3BlcmF0aW9uUmVzcG9uc2VCwQEKEWNvbS5vcGVyYXRpb25zLnYxQg9PcGVyYXRpb25zUHJvdG9QAVpGZ2l0aHViLmNvbS9hYTFleC9wYWFzLXByb3ZpZGVyL3BrZy9hcGkvZ3JwYy9vcGVyYXRpb25zL3YxO29wZXJhdGlvbnN2MaICA09YWKoCDU9wZXJhdGlvbnMuVjHKAg1PcGVyYXRpb25zXFYx4gIZT3BlcmF0aW9uc1xWMVxHUEJNZXRhZGF0YeoCDk9wZXJhdGlvbnM6OlYxYgZwcm90bzM=", [file_google_protobuf_duration, file_google_protobuf_timestamp, file_kubernetes_cluster_v1_kubernetes_cluster, file_virtual_machine_v1_virtual_machine]);
+
+/**
+ * Describes the message operations.v1.Operation.
+ * Use `create(OperationSchema)` to create a new message.
+ */
+export const OperationSchema = /*@__PURE__*/
+  messageDesc(file_operations_v1_operations, 0);
+
+/**
+ * Describes the enum operations.v1.Operation.Type.
+ */
+export const Operation_TypeSchema = /*@__PURE__*/
+  enumDesc(file_operations_v1_operations, 0, 0);
+
+/**
+ * @generated from enum operations.v1.Operation.Type
+ */
+export const Operation_Type = /*@__PURE__*/
+  tsEnum(Operation_TypeSchema);
+
+/**
+ * Describes the enum operations.v1.Operation.State.
+ */
+export const Operation_StateSchema = /*@__PURE__*/
+  enumDesc(file_operations_v1_operations, 0, 1);
+
+/**
+ * @generated from enum operations.v1.Operation.State
+ */
+export const Operation_State = /*@__PURE__*/
+  tsEnum(Operation_StateSchema);
+
+/**
+ * Describes the message operations.v1.OperationError.
+ * Use `create(OperationErrorSchema)` to create a new message.
+ */
+export const OperationErrorSchema = /*@__PURE__*/
+  messageDesc(file_operations_v1_operations, 1);
+
+/**
+ * Describes the message operations.v1.GetOperationRequest.
+ * Use `create(GetOperationRequestSchema)` to create a new message.
+ */
+export const GetOperationRequestSchema = /*@__PURE__*/
+  messageDesc(file_operations_v1_operations, 2);
+
+/**
+ * Describes the message operations.v1.GetOperationResponse.
+ * Use `create(GetOperationResponseSchema)` to create a new message.
+ */
+export const GetOperationResponseSchema = /*@__PURE__*/
+  messageDesc(file_operations_v1_operations, 3);
+
+/**
+ * Describes the message operations.v1.ListOperationsRequest.
+ * Use `create(ListOperationsRequestSchema)` to create a new message.
+ */
+export const ListOperationsRequestSchema = /*@__PURE__*/
+  messageDesc(file_operations_v1_operations, 4);
+
+/**
+ * Describes the message operations.v1.ListOperationsResponse.
+ * Use `create(ListOperationsResponseSchema)` to create a new message.
+ */
+export const ListOperationsResponseSchema = /*@__PURE__*/
+  messageDesc(file_operations_v1_operations, 5);
+
+/**
+ * Describes the message operations.v1.CancelOperationRequest.
+ * Use `create(CancelOperationRequestSchema)` to create a new message.
+ */
+export const CancelOperationRequestSchema = /*@__PURE__*/
+  messageDesc(file_operations_v1_operations, 6);
+
+/**
+ * Describes the message operations.v1.CancelOperationResponse.
+ * Use `create(CancelOperationResponseSchema)` to create a new message.
+ */
+export const CancelOperationResponseSchema = /*@__PURE__*/
+  messageDesc(file_operations_v1_operations, 7);
+
+/**
+ * Describes the message operations.v1.WaitOperationRequest.
+ * Use `create(WaitOperationRequestSchema)` to create a new message.
+ */
+export const WaitOperationRequestSchema = /*@__PURE__*/
+  messageDesc(file_operations_v1_operations, 8);
+
+/**
+ * Describes the message operations.v1.WaitOperationResponse.
+ * Use `create(WaitOperationResponseSchema)` to create a new message.
+ */
+export const WaitOperationResponseSchema = /*@__PURE__*/
+  messageDesc(file_operations_v1_operations, 9);
+
+/**
+ * OperationService tracks the long-running changes to virtual machines and
+ * Kubernetes clusters, in the style of google.longrunning.Operations
+ *
+ * @generated from service operations.v1.OperationService
+ */
+export const OperationService = /*@__PURE__*/
+  serviceDesc(file_operations_v1_operations, 0);
+
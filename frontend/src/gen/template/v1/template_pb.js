@@ -3,12 +3,15 @@
 /* eslint-disable */
 
 import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv1";
+import { file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_kubernetes_cluster_v1_kubernetes_cluster } from "../../kubernetes_cluster/v1/kubernetes_cluster_pb";
+import { file_virtual_machine_v1_virtual_machine } from "../../virtual_machine/v1/virtual_machine_pb";
 
 /**
  * Describes the file template/v1/template.proto.
  */
 export const file_template_v1_template = /*@__PURE__*/
-  fileDesc("Chp0ZW1wbGF0ZS92MS90ZW1wbGF0ZS5wcm90bxILdGVtcGxhdGUudjEipAEKCFRlbXBsYXRlEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSKAoEdHlwZRgDIAEoDjIaLnRlbXBsYXRlLnYxLlRlbXBsYXRlLlR5cGUSFAoMcmF3X3RlbXBsYXRlGAQgASgJIj4KBFR5cGUSFAoQVFlQRV9VTlNQRUNJRklFRBAAEgsKB1RZUEVfVk0QARITCg9UWVBFX0tVQkVSTkVURVMQAiJAChVDcmVhdGVUZW1wbGF0ZVJlcXVlc3QSJwoIdGVtcGxhdGUYASABKAsyFS50ZW1wbGF0ZS52MS5UZW1wbGF0ZSIkChZDcmVhdGVUZW1wbGF0ZVJlc3BvbnNlEgoKAmlkGAEgASgJIiAKEkdldFRlbXBsYXRlUmVxdWVzdBIKCgJpZBgBIAEoCSI+ChNHZXRUZW1wbGF0ZVJlc3BvbnNlEicKCHRlbXBsYXRlGAEgASgLMhUudGVtcGxhdGUudjEuVGVtcGxhdGUiQAoUTGlzdFRlbXBsYXRlc1JlcXVlc3QSKAoEdHlwZRgBIAEoDjIaLnRlbXBsYXRlLnYxLlRlbXBsYXRlLlR5cGUiQQoVTGlzdFRlbXBsYXRlc1Jlc3BvbnNlEigKCXRlbXBsYXRlcxgBIAMoCzIVLnRlbXBsYXRlLnYxLlRlbXBsYXRlIkAKFVVwZGF0ZVRlbXBsYXRlUmVxdWVzdBInCgh0ZW1wbGF0ZRgBIAEoCzIVLnRlbXBsYXRlLnYxLlRlbXBsYXRlIkEKFlVwZGF0ZVRlbXBsYXRlUmVzcG9uc2USJwoIdGVtcGxhdGUYASABKAsyFS50ZW1wbGF0ZS52MS5UZW1wbGF0ZSIjChVEZWxldGVUZW1wbGF0ZVJlcXVlc3QSCgoCaWQYASABKAkiKQoWRGVsZXRlVGVtcGxhdGVSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIMswDCg9UZW1wbGF0ZVNlcnZpY2USWQoOQ3JlYXRlVGVtcGxhdGUSIi50ZW1wbGF0ZS52MS5DcmVhdGVUZW1wbGF0ZVJlcXVlc3QaIy50ZW1wbGF0ZS52MS5DcmVhdGVUZW1wbGF0ZVJlc3BvbnNlElAKC0dldFRlbXBsYXRlEh8udGVtcGxhdGUudjEuR2V0VGVtcGxhdGVSZXF1ZXN0GiAudGVtcGxhdGUudjEuR2V0VGVtcGxhdGVSZXNwb25zZRJWCg1MaXN0VGVtcGxhdGVzEiEudGVtcGxhdGUudjEuTGlzdFRlbXBsYXRlc1JlcXVlc3QaIi50ZW1wbGF0ZS52MS5MaXN0VGVtcGxhdGVzUmVzcG9uc2USWQoOVXBkYXRlVGVtcGxhdGUSIi50ZW1wbGF0ZS52MS5VcGRhdGVUZW1wbGF0ZVJlcXVlc3QaIy50ZW1wbGF0ZS52MS5VcGRhdGVUZW1wbGF0ZVJlc3BvbnNlElkKDkRlbGV0ZVRlbXBsYXRlEiIudGVtcGxhdGUudjEuRGVsZXRlVGVtcGxhdGVSZXF1ZXN0GiMudGVtcGxhdGUudjEuRGVsZXRlVGVtcGxhdGVSZXNwb25zZUKxAQoPY29tLnRlbXBsYXRlLnYxQg1UZW1wbGF0ZVByb3RvUAFaQmdpdGh1Yi5jb20vYWExZXgvcGFhcy1wcm92aWRlci9wa2cvYXBpL2dycGMvdGVtcGxhdGUvdjE7dGVtcGxhdGV2MaICA1RYWKoCC1RlbXBsYXRlLlYxygILVGVtcGxhdGVcVjHiAhdUZW1wbGF0ZVxWMVxHUEJNZXRhZGF0YeoCDFRlbXBsYXRlOjpWMWIGcHJvdG8z");
+  fileDesc("Chp0ZW1wbGF0ZS92MS90ZW1wbGF0ZS5wcm90bxILdGVtcGxhdGUudjEaIGdvb2dsZS9wcm90b2J1Zi9maWVsZF9tYXNrLnByb3RvGh9nb29nbGUvcHJvdG9idWYvdGltZXN0YW1wLnByb3RvGi5rdWJlcm5ldGVzX2NsdXN0ZXIvdjEva3ViZXJuZXRlc19jbHVzdGVyLnByb3RvGih2aXJ0dWFsX21hY2hpbmUvdjEvdmlydHVhbF9tYWNoaW5lLnByb3RvIsYDCghUZW1wbGF0ZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEigKBHR5cGUYAyABKA4yGi50ZW1wbGF0ZS52MS5UZW1wbGF0ZS5UeXBlEhQKDHJhd190ZW1wbGF0ZRgEIAEoCRIYChByZXNvdXJjZV92ZXJzaW9uGAUgASgDEhAKCHJldmlzaW9uGAYgASgDEioKCnBhcmFtZXRlcnMYByADKAsyFi50ZW1wbGF0ZS52MS5QYXJhbWV0ZXISKAoFZmlsZXMYCCADKAsyGS50ZW1wbGF0ZS52MS5UZW1wbGF0ZUZpbGUSMAoNb3V0cHV0X2Zvcm1hdBgJIAEoDjIZLnRlbXBsYXRlLnYxLk91dHB1dEZvcm1hdBIYChBub3JtYWxpemVfb3V0cHV0GAogASgIEhUKDW91dHB1dF9zY2hlbWEYCyABKAkSEwoLZGVzY3JpcHRpb24YDCABKAkSFAoMY2F0YWxvZ19maWxlGA0gASgJIlAKBFR5cGUSFAoQVFlQRV9VTlNQRUNJRklFRBAAEgsKB1RZUEVfVk0QARITCg9UWVBFX0tVQkVSTkVURVMQAhIQCgxUWVBFX1BBUlRJQUwQAyJ7CgxUZW1wbGF0ZUZpbGUSDAoEbmFtZRgBIAEoCRIUCgxyYXdfdGVtcGxhdGUYAiABKAkSMAoNb3V0cHV0X2Zvcm1hdBgDIAEoDjIZLnRlbXBsYXRlLnYxLk91dHB1dEZvcm1hdBIVCg1vdXRwdXRfc2NoZW1hGAQgASgJIrICCglQYXJhbWV0ZXISDAoEbmFtZRgBIAEoCRIpCgR0eXBlGAIgASgOMhsudGVtcGxhdGUudjEuUGFyYW1ldGVyLlR5cGUSEAoIcmVxdWlyZWQYAyABKAgSFQoNZGVmYXVsdF92YWx1ZRgEIAEoCRIWCg5hbGxvd2VkX3ZhbHVlcxgFIAMoCRIQCgNtaW4YBiABKAFIAIgBARIQCgNtYXgYByABKAFIAYgBARITCgtkZXNjcmlwdGlvbhgIIAEoCSJiCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIPCgtUWVBFX1NUUklORxABEhAKDFRZUEVfSU5URUdFUhACEg8KC1RZUEVfTlVNQkVSEAMSEAoMVFlQRV9CT09MRUFOEARCBgoEX21pbkIGCgRfbWF4IoYDChBUZW1wbGF0ZVJldmlzaW9uEhMKC3RlbXBsYXRlX2lkGAEgASgJEhAKCHJldmlzaW9uGAIgASgDEgwKBG5hbWUYAyABKAkSKAoEdHlwZRgEIAEoDjIaLnRlbXBsYXRlLnYxLlRlbXBsYXRlLlR5cGUSFAoMcmF3X3RlbXBsYXRlGAUgASgJEi8KC2NyZWF0ZV90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIqCgpwYXJhbWV0ZXJzGAcgAygLMhYudGVtcGxhdGUudjEuUGFyYW1ldGVyEigKBWZpbGVzGAggAygLMhkudGVtcGxhdGUudjEuVGVtcGxhdGVGaWxlEjAKDW91dHB1dF9mb3JtYXQYCSABKA4yGS50ZW1wbGF0ZS52MS5PdXRwdXRGb3JtYXQSGAoQbm9ybWFsaXplX291dHB1dBgKIAEoCBIVCg1vdXRwdXRfc2NoZW1hGAsgASgJEhMKC2Rlc2NyaXB0aW9uGAwgASgJIlcKFUNyZWF0ZVRlbXBsYXRlUmVxdWVzdBInCgh0ZW1wbGF0ZRgBIAEoCzIVLnRlbXBsYXRlLnYxLlRlbXBsYXRlEhUKDXZhbGlkYXRlX29ubHkYAiABKAgiJAoWQ3JlYXRlVGVtcGxhdGVSZXNwb25zZRIKCgJpZBgBIAEoCSIgChJHZXRUZW1wbGF0ZVJlcXVlc3QSCgoCaWQYASABKAkiPgoTR2V0VGVtcGxhdGVSZXNwb25zZRInCgh0ZW1wbGF0ZRgBIAEoCzIVLnRlbXBsYXRlLnYxLlRlbXBsYXRlIokBChRMaXN0VGVtcGxhdGVzUmVxdWVzdBIoCgR0eXBlGAEgASgOMhoudGVtcGxhdGUudjEuVGVtcGxhdGUuVHlwZRIRCglwYWdlX3NpemUYAiABKAUSEgoKcGFnZV90b2tlbhgDIAEoCRIOCgZmaWx0ZXIYBCABKAkSEAoIb3JkZXJfYnkYBSABKAkiWgoVTGlzdFRlbXBsYXRlc1Jlc3BvbnNlEigKCXRlbXBsYXRlcxgBIAMoCzIVLnRlbXBsYXRlLnYxLlRlbXBsYXRlEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSKlAQoVVXBkYXRlVGVtcGxhdGVSZXF1ZXN0EicKCHRlbXBsYXRlGAEgASgLMhUudGVtcGxhdGUudjEuVGVtcGxhdGUSLwoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrEhUKDXZhbGlkYXRlX29ubHkYAyABKAgSGwoTcmVyZW5kZXJfZGVwZW5kZW50cxgEIAEoCCKKAQoWVXBkYXRlVGVtcGxhdGVSZXNwb25zZRInCgh0ZW1wbGF0ZRgBIAEoCzIVLnRlbXBsYXRlLnYxLlRlbXBsYXRlEi8KCnJlcmVuZGVyZWQYAiADKAsyGy50ZW1wbGF0ZS52MS5SZXJlbmRlclJlc3VsdBIWCg5yZXJlbmRlcl9lcnJvchgDIAEoCSJMChVEZWxldGVUZW1wbGF0ZVJlcXVlc3QSCgoCaWQYASABKAkSGAoQcmVzb3VyY2VfdmVyc2lvbhgCIAEoAxINCgVmb3JjZRgDIAEoCCIpChZEZWxldGVUZW1wbGF0ZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiMwocTGlzdFRlbXBsYXRlUmV2aXNpb25zUmVxdWVzdBITCgt0ZW1wbGF0ZV9pZBgBIAEoCSJRCh1MaXN0VGVtcGxhdGVSZXZpc2lvbnNSZXNwb25zZRIwCglyZXZpc2lvbnMYASADKAsyHS50ZW1wbGF0ZS52MS5UZW1wbGF0ZVJldmlzaW9uIkMKGkdldFRlbXBsYXRlUmV2aXNpb25SZXF1ZXN0EhMKC3RlbXBsYXRlX2lkGAEgASgJEhAKCHJldmlzaW9uGAIgASgDIk4KG0dldFRlbXBsYXRlUmV2aXNpb25SZXNwb25zZRIvCghyZXZpc2lvbhgBIAEoCzIdLnRlbXBsYXRlLnYxLlRlbXBsYXRlUmV2aXNpb24iWgoXUm9sbGJhY2tUZW1wbGF0ZVJlcXVlc3QSEwoLdGVtcGxhdGVfaWQYASABKAkSEAoIcmV2aXNpb24YAiABKAMSGAoQcmVzb3VyY2VfdmVyc2lvbhgDIAEoAyJDChhSb2xsYmFja1RlbXBsYXRlUmVzcG9uc2USJwoIdGVtcGxhdGUYASABKAsyFS50ZW1wbGF0ZS52MS5UZW1wbGF0ZSJJChdEZXNjcmliZVRlbXBsYXRlUmVxdWVzdBIMCgJpZBgBIAEoCUgAEhYKDHJhd190ZW1wbGF0ZRgCIAEoCUgAQggKBnNvdXJjZSJQChhEZXNjcmliZVRlbXBsYXRlUmVzcG9uc2USDgoGZmllbGRzGAEgAygJEhEKCXZhcmlhYmxlcxgCIAMoCRIRCglmdW5jdGlvbnMYAyADKAkikAIKFVJlbmRlclRlbXBsYXRlUmVxdWVzdBITCgt0ZW1wbGF0ZV9pZBgBIAEoCRIQCghyZXZpc2lvbhgCIAEoAxIcChJ2aXJ0dWFsX21hY2hpbmVfaWQYAyABKAlIABIfChVrdWJlcm5ldGVzX2NsdXN0ZXJfaWQYBCABKAlIABI9Cg92aXJ0dWFsX21hY2hpbmUYBSABKAsyIi52aXJ0dWFsX21hY2hpbmUudjEuVmlydHVhbE1hY2hpbmVIABJGChJrdWJlcm5ldGVzX2NsdXN0ZXIYBiABKAsyKC5rdWJlcm5ldGVzX2NsdXN0ZXIudjEuS3ViZXJuZXRlc0NsdXN0ZXJIAEIKCghyZXNvdXJjZSLeAQoWUmVuZGVyVGVtcGxhdGVSZXNwb25zZRIZChF0ZW1wbGF0ZV9yZXZpc2lvbhgCIAEoAxJWChJyZW5kZXJlZF9hcnRpZmFjdHMYAyADKAsyOi50ZW1wbGF0ZS52MS5SZW5kZXJUZW1wbGF0ZVJlc3BvbnNlLlJlbmRlcmVkQXJ0aWZhY3RzRW50cnkaOAoWUmVuZGVyZWRBcnRpZmFjdHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBSgQIARACUhFyZW5kZXJlZF90ZW1wbGF0ZSIsCh5HZXRUZW1wbGF0ZURlcGVuZGVuY2llc1JlcXVlc3QSCgoCaWQYASABKAkiVwoRVGVtcGxhdGVSZWZlcmVuY2USCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIoCgR0eXBlGAMgASgOMhoudGVtcGxhdGUudjEuVGVtcGxhdGUuVHlwZSKLAQofR2V0VGVtcGxhdGVEZXBlbmRlbmNpZXNSZXNwb25zZRI0CgxkZXBlbmRlbmNpZXMYASADKAsyHi50ZW1wbGF0ZS52MS5UZW1wbGF0ZVJlZmVyZW5jZRIyCgpkZXBlbmRlbnRzGAIgAygLMh4udGVtcGxhdGUudjEuVGVtcGxhdGVSZWZlcmVuY2UiIQofR2V0VGVtcGxhdGVDYXRhbG9nU3RhdHVzUmVxdWVzdCKIAQoTVGVtcGxhdGVDYXRhbG9nRmlsZRIMCgRwYXRoGAEgASgJEhMKC3RlbXBsYXRlX2lkGAIgASgJEhAKCHJldmlzaW9uGAMgASgDEi0KCWxvYWRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFZXJyb3IYBSABKAkitwEKIEdldFRlbXBsYXRlQ2F0YWxvZ1N0YXR1c1Jlc3BvbnNlEgsKA2RpchgBIAEoCRIQCgh3YXRjaGluZxgCIAEoCBI0ChBsYXN0X3JlbG9hZF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVlcnJvchgEIAEoCRIvCgVmaWxlcxgFIAMoCzIgLnRlbXBsYXRlLnYxLlRlbXBsYXRlQ2F0YWxvZ0ZpbGUiQAoYUmVyZW5kZXJSZXNvdXJjZXNSZXF1ZXN0EhMKC3RlbXBsYXRlX2lkGAEgASgJEg8KB2RyeV9ydW4YAiABKAgigQIKDlJlcmVuZGVyUmVzdWx0EhwKEnZpcnR1YWxfbWFjaGluZV9pZBgBIAEoCUgAEh8KFWt1YmVybmV0ZXNfY2x1c3Rlcl9pZBgCIAEoCUgAEgwKBG5hbWUYAyABKAkSEwoLdGVtcGxhdGVfaWQYBCABKAkSIgoacHJldmlvdXNfdGVtcGxhdGVfcmV2aXNpb24YBSABKAMSGQoRdGVtcGxhdGVfcmV2aXNpb24YBiABKAMSDwoHY2hhbmdlZBgHIAEoCBIMCgRkaWZmGAggASgJEg0KBWVycm9yGAkgASgJEhQKDG9wZXJhdGlvbl9pZBgKIAEoCUIKCghyZXNvdXJjZSJJChlSZXJlbmRlclJlc291cmNlc1Jlc3BvbnNlEiwKB3Jlc3VsdHMYASADKAsyGy50ZW1wbGF0ZS52MS5SZXJlbmRlclJlc3VsdCJxCg9HZXREcmlmdFJlcXVlc3QSHAoSdmlydHVhbF9tYWNoaW5lX2lkGAEgASgJSAASHwoVa3ViZXJuZXRlc19jbHVzdGVyX2lkGAIgASgJSAASEwoLdGVtcGxhdGVfaWQYAyABKAlCCgoIcmVzb3VyY2Ui5wEKC0RyaWZ0UmVzdWx0EhwKEnZpcnR1YWxfbWFjaGluZV9pZBgBIAEoCUgAEh8KFWt1YmVybmV0ZXNfY2x1c3Rlcl9pZBgCIAEoCUgAEgwKBG5hbWUYAyABKAkSEwoLdGVtcGxhdGVfaWQYBCABKAkSGQoRdGVtcGxhdGVfcmV2aXNpb24YBSABKAMSIQoZY3VycmVudF90ZW1wbGF0ZV9yZXZpc2lvbhgGIAEoAxIPCgdkcmlmdGVkGAcgASgIEgwKBGRpZmYYCCABKAkSDQoFZXJyb3IYCSABKAlCCgoIcmVzb3VyY2UiPQoQR2V0RHJpZnRSZXNwb25zZRIpCgdyZXN1bHRzGAEgAygLMhgudGVtcGxhdGUudjEuRHJpZnRSZXN1bHQqxQEKDE91dHB1dEZvcm1hdBIdChlPVVRQVVRfRk9STUFUX1VOU1BFQ0lGSUVEEAASFwoTT1VUUFVUX0ZPUk1BVF9QTEFJThABEhYKEk9VVFBVVF9GT1JNQVRfWUFNTBACEhYKEk9VVFBVVF9GT1JNQVRfSlNPThADEhYKEk9VVFBVVF9GT1JNQVRfVE9NTBAEEhUKEU9VVFBVVF9GT1JNQVRfSU5JEAUSHgoaT1VUUFVUX0ZPUk1BVF9DTE9VRF9DT05GSUcQBjLfCgoPVGVtcGxhdGVTZXJ2aWNlElkKDkNyZWF0ZVRlbXBsYXRlEiIudGVtcGxhdGUudjEuQ3JlYXRlVGVtcGxhdGVSZXF1ZXN0GiMudGVtcGxhdGUudjEuQ3JlYXRlVGVtcGxhdGVSZXNwb25zZRJQCgtHZXRUZW1wbGF0ZRIfLnRlbXBsYXRlLnYxLkdldFRlbXBsYXRlUmVxdWVzdBogLnRlbXBsYXRlLnYxLkdldFRlbXBsYXRlUmVzcG9uc2USVgoNTGlzdFRlbXBsYXRlcxIhLnRlbXBsYXRlLnYxLkxpc3RUZW1wbGF0ZXNSZXF1ZXN0GiIudGVtcGxhdGUudjEuTGlzdFRlbXBsYXRlc1Jlc3BvbnNlElkKDlVwZGF0ZVRlbXBsYXRlEiIudGVtcGxhdGUudjEuVXBkYXRlVGVtcGxhdGVSZXF1ZXN0GiMudGVtcGxhdGUudjEuVXBkYXRlVGVtcGxhdGVSZXNwb25zZRJZCg5EZWxldGVUZW1wbGF0ZRIiLnRlbXBsYXRlLnYxLkRlbGV0ZVRlbXBsYXRlUmVxdWVzdBojLnRlbXBsYXRlLnYxLkRlbGV0ZVRlbXBsYXRlUmVzcG9uc2USbgoVTGlzdFRlbXBsYXRlUmV2aXNpb25zEikudGVtcGxhdGUudjEuTGlzdFRlbXBsYXRlUmV2aXNpb25zUmVxdWVzdBoqLnRlbXBsYXRlLnYxLkxpc3RUZW1wbGF0ZVJldmlzaW9uc1Jlc3BvbnNlEmgKE0dldFRlbXBsYXRlUmV2aXNpb24SJy50ZW1wbGF0ZS52MS5HZXRUZW1wbGF0ZVJldmlzaW9uUmVxdWVzdBooLnRlbXBsYXRlLnYxLkdldFRlbXBsYXRlUmV2aXNpb25SZXNwb25zZRJfChBSb2xsYmFja1RlbXBsYXRlEiQudGVtcGxhdGUudjEuUm9sbGJhY2tUZW1wbGF0ZVJlcXVlc3QaJS50ZW1wbGF0ZS52MS5Sb2xsYmFja1RlbXBsYXRlUmVzcG9uc2USXwoQRGVzY3JpYmVUZW1wbGF0ZRIkLnRlbXBsYXRlLnYxLkRlc2NyaWJlVGVtcGxhdGVSZXF1ZXN0GiUudGVtcGxhdGUudjEuRGVzY3JpYmVUZW1wbGF0ZVJlc3BvbnNlElkKDlJlbmRlclRlbXBsYXRlEiIudGVtcGxhdGUudjEuUmVuZGVyVGVtcGxhdGVSZXF1ZXN0GiMudGVtcGxhdGUudjEuUmVuZGVyVGVtcGxhdGVSZXNwb25zZRJ0ChdHZXRUZW1wbGF0ZURlcGVuZGVuY2llcxIrLnRlbXBsYXRlLnYxLkdldFRlbXBsYXRlRGVwZW5kZW5jaWVzUmVxdWVzdBosLnRlbXBsYXRlLnYxLkdldFRlbXBsYXRlRGVwZW5kZW5jaWVzUmVzcG9uc2USdwoYR2V0VGVtcGxhdGVDYXRhbG9nU3RhdHVzEiwudGVtcGxhdGUudjEuR2V0VGVtcGxhdGVDYXRhbG9nU3RhdHVzUmVxdWVzdBotLnRlbXBsYXRlLnYxLkdldFRlbXBsYXRlQ2F0YWxvZ1N0YXR1c1Jlc3BvbnNlEmIKEVJlcmVuZGVyUmVzb3VyY2VzEiUudGVtcGxhdGUudjEuUmVyZW5kZXJSZXNvdXJjZXNSZXF1ZXN0GiYudGVtcGxhdGUudjEuUmVyZW5kZXJSZXNvdXJjZXNSZXNwb25zZRJHCghHZXREcmlmdBIcLnRlbXBsYXRlLnYxLkdldERyaWZ0UmVxdWVzdBodLnRlbXBsYXRlLnYxLkdldERyaWZ0UmVzcG9uc2VCsQEKD2NvbS50ZW1wbGF0ZS52MUINVGVtcGxhdGVQcm90b1ABWkJnaXRodWIuY29tL2FhMWV4L3BhYXMtcHJvdmlkZXIvcGtnL2FwaS9ncnBjL3RlbXBsYXRlL3YxO3RlbXBsYXRldjGiAgNUWFiqAgtUZW1wbGF0ZS5WMcoCC1RlbXBsYXRlXFYx4gIXVGVtcGxhdGVcVjFcR1BCTWV0YWRhdGHqAgxUZW1wbGF0ZTo6VjFiBnByb3RvMw==", [file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_kubernetes_cluster_v1_kubernetes_cluster, file_virtual_machine_v1_virtual_machine]);
 
 /**
  * Describes the message template.v1.Template.
@@ -29,75 +32,276 @@ export const Template_TypeSchema = /*@__PURE__*/
 export const Template_Type = /*@__PURE__*/
   tsEnum(Template_TypeSchema);
 
+/**
+ * Describes the message template.v1.TemplateFile.
+ * Use `create(TemplateFileSchema)` to create a new message.
+ */
+export const TemplateFileSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 1);
+
+/**
+ * Describes the message template.v1.Parameter.
+ * Use `create(ParameterSchema)` to create a new message.
+ */
+export const ParameterSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 2);
+
+/**
+ * Describes the enum template.v1.Parameter.Type.
+ */
+export const Parameter_TypeSchema = /*@__PURE__*/
+  enumDesc(file_template_v1_template, 2, 0);
+
+/**
+ * @generated from enum template.v1.Parameter.Type
+ */
+export const Parameter_Type = /*@__PURE__*/
+  tsEnum(Parameter_TypeSchema);
+
+/**
+ * Describes the message template.v1.TemplateRevision.
+ * Use `create(TemplateRevisionSchema)` to create a new message.
+ */
+export const TemplateRevisionSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 3);
+
 /**
  * Describes the message template.v1.CreateTemplateRequest.
  * Use `create(CreateTemplateRequestSchema)` to create a new message.
  */
 export const CreateTemplateRequestSchema = /*@__PURE__*/
-  messageDesc(file_template_v1_template, 1);
+  messageDesc(file_template_v1_template, 4);
 
 /**
  * Describes the message template.v1.CreateTemplateResponse.
  * Use `create(CreateTemplateResponseSchema)` to create a new message.
  */
 export const CreateTemplateResponseSchema = /*@__PURE__*/
-  messageDesc(file_template_v1_template, 2);
+  messageDesc(file_template_v1_template, 5);
 
 /**
  * Describes the message template.v1.GetTemplateRequest.
  * Use `create(GetTemplateRequestSchema)` to create a new message.
  */
 export const GetTemplateRequestSchema = /*@__PURE__*/
-  messageDesc(file_template_v1_template, 3);
+  messageDesc(file_template_v1_template, 6);
 
 /**
  * Describes the message template.v1.GetTemplateResponse.
  * Use `create(GetTemplateResponseSchema)` to create a new message.
  */
 export const GetTemplateResponseSchema = /*@__PURE__*/
-  messageDesc(file_template_v1_template, 4);
+  messageDesc(file_template_v1_template, 7);
 
 /**
  * Describes the message template.v1.ListTemplatesRequest.
  * Use `create(ListTemplatesRequestSchema)` to create a new message.
  */
 export const ListTemplatesRequestSchema = /*@__PURE__*/
-  messageDesc(file_template_v1_template, 5);
+  messageDesc(file_template_v1_template, 8);
 
 /**
  * Describes the message template.v1.ListTemplatesResponse.
  * Use `create(ListTemplatesResponseSchema)` to create a new message.
  */
 export const ListTemplatesResponseSchema = /*@__PURE__*/
-  messageDesc(file_template_v1_template, 6);
+  messageDesc(file_template_v1_template, 9);
 
 /**
  * Describes the message template.v1.UpdateTemplateRequest.
  * Use `create(UpdateTemplateRequestSchema)` to create a new message.
  */
 export const UpdateTemplateRequestSchema = /*@__PURE__*/
-  messageDesc(file_template_v1_template, 7);
+  messageDesc(file_template_v1_template, 10);
 
 /**
  * Describes the message template.v1.UpdateTemplateResponse.
  * Use `create(UpdateTemplateResponseSchema)` to create a new message.
  */
 export const UpdateTemplateResponseSchema = /*@__PURE__*/
-  messageDesc(file_template_v1_template, 8);
+  messageDesc(file_template_v1_template, 11);
 
 /**
  * Describes the message template.v1.DeleteTemplateRequest.
  * Use `create(DeleteTemplateRequestSchema)` to create a new message.
  */
 export const DeleteTemplateRequestSchema = /*@__PURE__*/
-  messageDesc(file_template_v1_template, 9);
+  messageDesc(file_template_v1_template, 12);
 
 /**
  * Describes the message template.v1.DeleteTemplateResponse.
  * Use `create(DeleteTemplateResponseSchema)` to create a new message.
  */
 export const DeleteTemplateResponseSchema = /*@__PURE__*/
-  messageDesc(file_template_v1_template, 10);
+  messageDesc(file_template_v1_template, 13);
+
+/**
+ * Describes the message template.v1.ListTemplateRevisionsRequest.
+ * Use `create(ListTemplateRevisionsRequestSchema)` to create a new message.
+ */
+export const ListTemplateRevisionsRequestSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 14);
+
+/**
+ * Describes the message template.v1.ListTemplateRevisionsResponse.
+ * Use `create(ListTemplateRevisionsResponseSchema)` to create a new message.
+ */
+export const ListTemplateRevisionsResponseSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 15);
+
+/**
+ * Describes the message template.v1.GetTemplateRevisionRequest.
+ * Use `create(GetTemplateRevisionRequestSchema)` to create a new message.
+ */
+export const GetTemplateRevisionRequestSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 16);
+
+/**
+ * Describes the message template.v1.GetTemplateRevisionResponse.
+ * Use `create(GetTemplateRevisionResponseSchema)` to create a new message.
+ */
+export const GetTemplateRevisionResponseSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 17);
+
+/**
+ * Describes the message template.v1.RollbackTemplateRequest.
+ * Use `create(RollbackTemplateRequestSchema)` to create a new message.
+ */
+export const RollbackTemplateRequestSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 18);
+
+/**
+ * Describes the message template.v1.RollbackTemplateResponse.
+ * Use `create(RollbackTemplateResponseSchema)` to create a new message.
+ */
+export const RollbackTemplateResponseSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 19);
+
+/**
+ * Describes the message template.v1.DescribeTemplateRequest.
+ * Use `create(DescribeTemplateRequestSchema)` to create a new message.
+ */
+export const DescribeTemplateRequestSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 20);
+
+/**
+ * Describes the message template.v1.DescribeTemplateResponse.
+ * Use `create(DescribeTemplateResponseSchema)` to create a new message.
+ */
+export const DescribeTemplateResponseSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 21);
+
+/**
+ * Describes the message template.v1.RenderTemplateRequest.
+ * Use `create(RenderTemplateRequestSchema)` to create a new message.
+ */
+export const RenderTemplateRequestSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 22);
+
+/**
+ * Describes the message template.v1.RenderTemplateResponse.
+ * Use `create(RenderTemplateResponseSchema)` to create a new message.
+ */
+export const RenderTemplateResponseSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 23);
+
+/**
+ * Describes the message template.v1.GetTemplateDependenciesRequest.
+ * Use `create(GetTemplateDependenciesRequestSchema)` to create a new message.
+ */
+export const GetTemplateDependenciesRequestSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 24);
+
+/**
+ * Describes the message template.v1.TemplateReference.
+ * Use `create(TemplateReferenceSchema)` to create a new message.
+ */
+export const TemplateReferenceSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 25);
+
+/**
+ * Describes the message template.v1.GetTemplateDependenciesResponse.
+ * Use `create(GetTemplateDependenciesResponseSchema)` to create a new message.
+ */
+export const GetTemplateDependenciesResponseSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 26);
+
+/**
+ * Describes the message template.v1.GetTemplateCatalogStatusRequest.
+ * Use `create(GetTemplateCatalogStatusRequestSchema)` to create a new message.
+ */
+export const GetTemplateCatalogStatusRequestSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 27);
+
+/**
+ * Describes the message template.v1.TemplateCatalogFile.
+ * Use `create(TemplateCatalogFileSchema)` to create a new message.
+ */
+export const TemplateCatalogFileSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 28);
+
+/**
+ * Describes the message template.v1.GetTemplateCatalogStatusResponse.
+ * Use `create(GetTemplateCatalogStatusResponseSchema)` to create a new message.
+ */
+export const GetTemplateCatalogStatusResponseSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 29);
+
+/**
+ * Describes the message template.v1.RerenderResourcesRequest.
+ * Use `create(RerenderResourcesRequestSchema)` to create a new message.
+ */
+export const RerenderResourcesRequestSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 30);
+
+/**
+ * Describes the message template.v1.RerenderResult.
+ * Use `create(RerenderResultSchema)` to create a new message.
+ */
+export const RerenderResultSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 31);
+
+/**
+ * Describes the message template.v1.RerenderResourcesResponse.
+ * Use `create(RerenderResourcesResponseSchema)` to create a new message.
+ */
+export const RerenderResourcesResponseSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 32);
+
+/**
+ * Describes the message template.v1.GetDriftRequest.
+ * Use `create(GetDriftRequestSchema)` to create a new message.
+ */
+export const GetDriftRequestSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 33);
+
+/**
+ * Describes the message template.v1.DriftResult.
+ * Use `create(DriftResultSchema)` to create a new message.
+ */
+export const DriftResultSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 34);
+
+/**
+ * Describes the message template.v1.GetDriftResponse.
+ * Use `create(GetDriftResponseSchema)` to create a new message.
+ */
+export const GetDriftResponseSchema = /*@__PURE__*/
+  messageDesc(file_template_v1_template, 35);
+
+/**
+ * Describes the enum template.v1.OutputFormat.
+ */
+export const OutputFormatSchema = /*@__PURE__*/
+  enumDesc(file_template_v1_template, 0);
+
+/**
+ * OutputFormat is the format of rendered output
+ *
+ * @generated from enum template.v1.OutputFormat
+ */
+export const OutputFormat = /*@__PURE__*/
+  tsEnum(OutputFormatSchema);
 
 /**
  * Services
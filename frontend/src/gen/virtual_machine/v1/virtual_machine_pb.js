@@ -2,13 +2,14 @@
 // @generated from file virtual_machine/v1/virtual_machine.proto (package virtual_machine.v1, syntax proto3)
 /* eslint-disable */
 
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv1";
+import { file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 
 /**
  * Describes the file virtual_machine/v1/virtual_machine.proto.
  */
 export const file_virtual_machine_v1_virtual_machine = /*@__PURE__*/
-  fileDesc("Cih2aXJ0dWFsX21hY2hpbmUvdjEvdmlydHVhbF9tYWNoaW5lLnByb3RvEhJ2aXJ0dWFsX21hY2hpbmUudjEigwEKDlZpcnR1YWxNYWNoaW5lEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSCwoDY3B1GAMgASgFEg4KBm1lbW9yeRgEIAEoBRIKCgJvcxgFIAEoCRITCgt0ZW1wbGF0ZV9pZBgGIAEoCRIZChFyZW5kZXJlZF90ZW1wbGF0ZRgHIAEoCSJaChtDcmVhdGVWaXJ0dWFsTWFjaGluZVJlcXVlc3QSOwoPdmlydHVhbF9tYWNoaW5lGAEgASgLMiIudmlydHVhbF9tYWNoaW5lLnYxLlZpcnR1YWxNYWNoaW5lIlsKHENyZWF0ZVZpcnR1YWxNYWNoaW5lUmVzcG9uc2USOwoPdmlydHVhbF9tYWNoaW5lGAEgASgLMiIudmlydHVhbF9tYWNoaW5lLnYxLlZpcnR1YWxNYWNoaW5lIiYKGEdldFZpcnR1YWxNYWNoaW5lUmVxdWVzdBIKCgJpZBgBIAEoCSJYChlHZXRWaXJ0dWFsTWFjaGluZVJlc3BvbnNlEjsKD3ZpcnR1YWxfbWFjaGluZRgBIAEoCzIiLnZpcnR1YWxfbWFjaGluZS52MS5WaXJ0dWFsTWFjaGluZSIcChpMaXN0VmlydHVhbE1hY2hpbmVzUmVxdWVzdCJbChtMaXN0VmlydHVhbE1hY2hpbmVzUmVzcG9uc2USPAoQdmlydHVhbF9tYWNoaW5lcxgBIAMoCzIiLnZpcnR1YWxfbWFjaGluZS52MS5WaXJ0dWFsTWFjaGluZSJaChtVcGRhdGVWaXJ0dWFsTWFjaGluZVJlcXVlc3QSOwoPdmlydHVhbF9tYWNoaW5lGAEgASgLMiIudmlydHVhbF9tYWNoaW5lLnYxLlZpcnR1YWxNYWNoaW5lIlsKHFVwZGF0ZVZpcnR1YWxNYWNoaW5lUmVzcG9uc2USOwoPdmlydHVhbF9tYWNoaW5lGAEgASgLMiIudmlydHVhbF9tYWNoaW5lLnYxLlZpcnR1YWxNYWNoaW5lIikKG0RlbGV0ZVZpcnR1YWxNYWNoaW5lUmVxdWVzdBIKCgJpZBgBIAEoCSIvChxEZWxldGVWaXJ0dWFsTWFjaGluZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgy8gQKFVZpcnR1YWxNYWNoaW5lU2VydmljZRJ5ChRDcmVhdGVWaXJ0dWFsTWFjaGluZRIvLnZpcnR1YWxfbWFjaGluZS52MS5DcmVhdGVWaXJ0dWFsTWFjaGluZVJlcXVlc3QaMC52aXJ0dWFsX21hY2hpbmUudjEuQ3JlYXRlVmlydHVhbE1hY2hpbmVSZXNwb25zZRJwChFHZXRWaXJ0dWFsTWFjaGluZRIsLnZpcnR1YWxfbWFjaGluZS52MS5HZXRWaXJ0dWFsTWFjaGluZVJlcXVlc3QaLS52aXJ0dWFsX21hY2hpbmUudjEuR2V0VmlydHVhbE1hY2hpbmVSZXNwb25zZRJ2ChNMaXN0VmlydHVhbE1hY2hpbmVzEi4udmlydHVhbF9tYWNoaW5lLnYxLkxpc3RWaXJ0dWFsTWFjaGluZXNSZXF1ZXN0Gi8udmlydHVhbF9tYWNoaW5lLnYxLkxpc3RWaXJ0dWFsTWFjaGluZXNSZXNwb25zZRJ5ChRVcGRhdGVWaXJ0dWFsTWFjaGluZRIvLnZpcnR1YWxfbWFjaGluZS52MS5VcGRhdGVWaXJ0dWFsTWFjaGluZVJlcXVlc3QaMC52aXJ0dWFsX21hY2hpbmUudjEuVXBkYXRlVmlydHVhbE1hY2hpbmVSZXNwb25zZRJ5ChREZWxldGVWaXJ0dWFsTWFjaGluZRIvLnZpcnR1YWxfbWFjaGluZS52MS5EZWxldGVWaXJ0dWFsTWFjaGluZVJlcXVlc3QaMC52aXJ0dWFsX21hY2hpbmUudjEuRGVsZXRlVmlydHVhbE1hY2hpbmVSZXNwb25zZULkAQoWY29tLnZpcnR1YWxfbWFjaGluZS52MUITVmlydHVhbE1hY2hpbmVQcm90b1ABWlBnaXRodWIuY29tL2FhMWV4L3BhYXMtcHJvdmlkZXIvcGtnL2FwaS9ncnBjL3ZpcnR1YWxfbWFjaGluZS92MTt2aXJ0dWFsX21hY2hpbmV2MaICA1ZYWKoCEVZpcnR1YWxNYWNoaW5lLlYxygIRVmlydHVhbE1hY2hpbmVcVjHiAh1WaXJ0dWFsTWFjaGluZVxWMVxHUEJNZXRhZGF0YeoCElZpcnR1YWxNYWNoaW5lOjpWMWIGcHJvdG8z");
+  fileDesc("Cih2aXJ0dWFsX21hY2hpbmUvdjEvdmlydHVhbF9tYWNoaW5lLnByb3RvEhJ2aXJ0dWFsX21hY2hpbmUudjEaIGdvb2dsZS9wcm90b2J1Zi9maWVsZF9tYXNrLnByb3RvGh9nb29nbGUvcHJvdG9idWYvdGltZXN0YW1wLnByb3RvIt4JCg5WaXJ0dWFsTWFjaGluZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEgsKA2NwdRgDIAEoBRIOCgZtZW1vcnkYBCABKAUSCgoCb3MYBSABKAkSEwoLdGVtcGxhdGVfaWQYBiABKAkSGAoQcmVzb3VyY2VfdmVyc2lvbhgIIAEoAxIZChF0ZW1wbGF0ZV9yZXZpc2lvbhgJIAEoAxJGCgpwYXJhbWV0ZXJzGAogAygLMjIudmlydHVhbF9tYWNoaW5lLnYxLlZpcnR1YWxNYWNoaW5lLlBhcmFtZXRlcnNFbnRyeRJVChJyZW5kZXJlZF9hcnRpZmFjdHMYCyADKAsyOS52aXJ0dWFsX21hY2hpbmUudjEuVmlydHVhbE1hY2hpbmUuUmVuZGVyZWRBcnRpZmFjdHNFbnRyeRJECgxkcmlmdF9zdGF0dXMYDCABKA4yLi52aXJ0dWFsX21hY2hpbmUudjEuVmlydHVhbE1hY2hpbmUuRHJpZnRTdGF0dXMSNQoRZHJpZnRfc3RhdHVzX3RpbWUYDSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjkKBnN0YXR1cxgOIAEoDjIpLnZpcnR1YWxfbWFjaGluZS52MS5WaXJ0dWFsTWFjaGluZS5TdGF0dXMSMQoKY29uZGl0aW9ucxgPIAMoCzIdLnZpcnR1YWxfbWFjaGluZS52MS5Db25kaXRpb24SLwoLY3JlYXRlX3RpbWUYECABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi8KC3VwZGF0ZV90aW1lGBEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBITCgtwcm92aXNpb25lchgSIAEoCRIpCgZldmVudHMYEyADKAsyGS52aXJ0dWFsX21hY2hpbmUudjEuRXZlbnQaMQoPUGFyYW1ldGVyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEaOAoWUmVuZGVyZWRBcnRpZmFjdHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIn8KC0RyaWZ0U3RhdHVzEhwKGERSSUZUX1NUQVRVU19VTlNQRUNJRklFRBAAEhgKFERSSUZUX1NUQVRVU19JTl9TWU5DEAESGAoURFJJRlRfU1RBVFVTX0RSSUZURUQQAhIeChpEUklGVF9TVEFUVVNfUkVOREVSX0ZBSUxFRBADIosCCgZTdGF0dXMSFgoSU1RBVFVTX1VOU1BFQ0lGSUVEEAASEgoOU1RBVFVTX1BFTkRJTkcQARIXChNTVEFUVVNfUFJPVklTSU9OSU5HEAISEgoOU1RBVFVTX1JVTk5JTkcQAxITCg9TVEFUVVNfU1RPUFBJTkcQBBISCg5TVEFUVVNfU1RPUFBFRBAFEhMKD1NUQVRVU19VUERBVElORxAGEhMKD1NUQVRVU19ERUxFVElORxAHEhEKDVNUQVRVU19GQUlMRUQQCBIVChFTVEFUVVNfUkVTVEFSVElORxAJEhUKEVNUQVRVU19TVVNQRU5ESU5HEAoSFAoQU1RBVFVTX1NVU1BFTkRFRBALSgQIBxAIUhFyZW5kZXJlZF90ZW1wbGF0ZSK6AQoJQ29uZGl0aW9uEgwKBHR5cGUYASABKAkSDgoGc3RhdHVzGAIgASgIEg4KBnJlYXNvbhgDIAEoCRIPCgdtZXNzYWdlGAQgASgJEjgKFGxhc3RfdHJhbnNpdGlvbl90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI0ChBsYXN0X3VwZGF0ZV90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCL0AwoWVmlydHVhbE1hY2hpbmVTbmFwc2hvdBIKCgJpZBgBIAEoCRIaChJ2aXJ0dWFsX21hY2hpbmVfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRILCgNjcHUYBCABKAUSDgoGbWVtb3J5GAUgASgFEgoKAm9zGAYgASgJEhMKC3RlbXBsYXRlX2lkGAcgASgJEhkKEXRlbXBsYXRlX3JldmlzaW9uGAggASgDEk4KCnBhcmFtZXRlcnMYCSADKAsyOi52aXJ0dWFsX21hY2hpbmUudjEuVmlydHVhbE1hY2hpbmVTbmFwc2hvdC5QYXJhbWV0ZXJzRW50cnkSXQoScmVuZGVyZWRfYXJ0aWZhY3RzGAogAygLMkEudmlydHVhbF9tYWNoaW5lLnYxLlZpcnR1YWxNYWNoaW5lU25hcHNob3QuUmVuZGVyZWRBcnRpZmFjdHNFbnRyeRIvCgtjcmVhdGVfdGltZRgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAaMQoPUGFyYW1ldGVyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEaOAoWUmVuZGVyZWRBcnRpZmFjdHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBImUKBUV2ZW50Eg4KBmFjdGlvbhgBIAEoCRIRCglzdWNjZWVkZWQYAiABKAgSDwoHbWVzc2FnZRgDIAEoCRIoCgR0aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJxChtDcmVhdGVWaXJ0dWFsTWFjaGluZVJlcXVlc3QSOwoPdmlydHVhbF9tYWNoaW5lGAEgASgLMiIudmlydHVhbF9tYWNoaW5lLnYxLlZpcnR1YWxNYWNoaW5lEhUKDXZhbGlkYXRlX29ubHkYAiABKAgicQocQ3JlYXRlVmlydHVhbE1hY2hpbmVSZXNwb25zZRI7Cg92aXJ0dWFsX21hY2hpbmUYASABKAsyIi52aXJ0dWFsX21hY2hpbmUudjEuVmlydHVhbE1hY2hpbmUSFAoMb3BlcmF0aW9uX2lkGAIgASgJIiYKGEdldFZpcnR1YWxNYWNoaW5lUmVxdWVzdBIKCgJpZBgBIAEoCSJYChlHZXRWaXJ0dWFsTWFjaGluZVJlc3BvbnNlEjsKD3ZpcnR1YWxfbWFjaGluZRgBIAEoCzIiLnZpcnR1YWxfbWFjaGluZS52MS5WaXJ0dWFsTWFjaGluZSJlChpMaXN0VmlydHVhbE1hY2hpbmVzUmVxdWVzdBIRCglwYWdlX3NpemUYASABKAUSEgoKcGFnZV90b2tlbhgCIAEoCRIOCgZmaWx0ZXIYAyABKAkSEAoIb3JkZXJfYnkYBCABKAkidAobTGlzdFZpcnR1YWxNYWNoaW5lc1Jlc3BvbnNlEjwKEHZpcnR1YWxfbWFjaGluZXMYASADKAsyIi52aXJ0dWFsX21hY2hpbmUudjEuVmlydHVhbE1hY2hpbmUSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIqIBChtVcGRhdGVWaXJ0dWFsTWFjaGluZVJlcXVlc3QSOwoPdmlydHVhbF9tYWNoaW5lGAEgASgLMiIudmlydHVhbF9tYWNoaW5lLnYxLlZpcnR1YWxNYWNoaW5lEi8KC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzaxIVCg12YWxpZGF0ZV9vbmx5GAMgASgIInEKHFVwZGF0ZVZpcnR1YWxNYWNoaW5lUmVzcG9uc2USOwoPdmlydHVhbF9tYWNoaW5lGAEgASgLMiIudmlydHVhbF9tYWNoaW5lLnYxLlZpcnR1YWxNYWNoaW5lEhQKDG9wZXJhdGlvbl9pZBgCIAEoCSJDChtEZWxldGVWaXJ0dWFsTWFjaGluZVJlcXVlc3QSCgoCaWQYASABKAkSGAoQcmVzb3VyY2VfdmVyc2lvbhgCIAEoAyJFChxEZWxldGVWaXJ0dWFsTWFjaGluZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSFAoMb3BlcmF0aW9uX2lkGAIgASgJIjYKGkdldFJlbmRlcmVkQXJ0aWZhY3RSZXF1ZXN0EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkiPAobR2V0UmVuZGVyZWRBcnRpZmFjdFJlc3BvbnNlEgwKBG5hbWUYASABKAkSDwoHY29udGVudBgCIAEoCSK8AQoeRXhwb3J0UmVuZGVyZWRBcnRpZmFjdHNSZXF1ZXN0EgoKAmlkGAEgASgJEkkKBmZvcm1hdBgCIAEoDjI5LnZpcnR1YWxfbWFjaGluZS52MS5FeHBvcnRSZW5kZXJlZEFydGlmYWN0c1JlcXVlc3QuRm9ybWF0IkMKBkZvcm1hdBIWChJGT1JNQVRfVU5TUEVDSUZJRUQQABIRCg1GT1JNQVRfVEFSX0daEAESDgoKRk9STUFUX1pJUBACIlsKH0V4cG9ydFJlbmRlcmVkQXJ0aWZhY3RzUmVzcG9uc2USEQoJZmlsZV9uYW1lGAEgASgJEhQKDGNvbnRlbnRfdHlwZRgCIAEoCRIPCgdhcmNoaXZlGAMgASgMIkIKGlN0YXJ0VmlydHVhbE1hY2hpbmVSZXF1ZXN0EgoKAmlkGAEgASgJEhgKEHJlc291cmNlX3ZlcnNpb24YAiABKAMicAobU3RhcnRWaXJ0dWFsTWFjaGluZVJlc3BvbnNlEjsKD3ZpcnR1YWxfbWFjaGluZRgBIAEoCzIiLnZpcnR1YWxfbWFjaGluZS52MS5WaXJ0dWFsTWFjaGluZRIUCgxvcGVyYXRpb25faWQYAiABKAkiUAoZU3RvcFZpcnR1YWxNYWNoaW5lUmVxdWVzdBIKCgJpZBgBIAEoCRIYChByZXNvdXJjZV92ZXJzaW9uGAIgASgDEg0KBWZvcmNlGAMgASgIIm8KGlN0b3BWaXJ0dWFsTWFjaGluZVJlc3BvbnNlEjsKD3ZpcnR1YWxfbWFjaGluZRgBIAEoCzIiLnZpcnR1YWxfbWFjaGluZS52MS5WaXJ0dWFsTWFjaGluZRIUCgxvcGVyYXRpb25faWQYAiABKAkiRAocUmVzdGFydFZpcnR1YWxNYWNoaW5lUmVxdWVzdBIKCgJpZBgBIAEoCRIYChByZXNvdXJjZV92ZXJzaW9uGAIgASgDInIKHVJlc3RhcnRWaXJ0dWFsTWFjaGluZVJlc3BvbnNlEjsKD3ZpcnR1YWxfbWFjaGluZRgBIAEoCzIiLnZpcnR1YWxfbWFjaGluZS52MS5WaXJ0dWFsTWFjaGluZRIUCgxvcGVyYXRpb25faWQYAiABKAkiRAocU3VzcGVuZFZpcnR1YWxNYWNoaW5lUmVxdWVzdBIKCgJpZBgBIAEoCRIYChByZXNvdXJjZV92ZXJzaW9uGAIgASgDInIKHVN1c3BlbmRWaXJ0dWFsTWFjaGluZVJlc3BvbnNlEjsKD3ZpcnR1YWxfbWFjaGluZRgBIAEoCzIiLnZpcnR1YWxfbWFjaGluZS52MS5WaXJ0dWFsTWFjaGluZRIUCgxvcGVyYXRpb25faWQYAiABKAkiTwojQ3JlYXRlVmlydHVhbE1hY2hpbmVTbmFwc2hvdFJlcXVlc3QSGgoSdmlydHVhbF9tYWNoaW5lX2lkGAEgASgJEgwKBG5hbWUYAiABKAkiZAokQ3JlYXRlVmlydHVhbE1hY2hpbmVTbmFwc2hvdFJlc3BvbnNlEjwKCHNuYXBzaG90GAEgASgLMioudmlydHVhbF9tYWNoaW5lLnYxLlZpcnR1YWxNYWNoaW5lU25hcHNob3QiiQEKIkxpc3RWaXJ0dWFsTWFjaGluZVNuYXBzaG90c1JlcXVlc3QSGgoSdmlydHVhbF9tYWNoaW5lX2lkGAEgASgJEhEKCXBhZ2Vfc2l6ZRgCIAEoBRISCgpwYWdlX3Rva2VuGAMgASgJEg4KBmZpbHRlchgEIAEoCRIQCghvcmRlcl9ieRgFIAEoCSJ9CiNMaXN0VmlydHVhbE1hY2hpbmVTbmFwc2hvdHNSZXNwb25zZRI9CglzbmFwc2hvdHMYASADKAsyKi52aXJ0dWFsX21hY2hpbmUudjEuVmlydHVhbE1hY2hpbmVTbmFwc2hvdBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiYwokUmVzdG9yZVZpcnR1YWxNYWNoaW5lU25hcHNob3RSZXF1ZXN0EgoKAmlkGAEgASgJEhgKEHJlc291cmNlX3ZlcnNpb24YAiABKAMSFQoNdmFsaWRhdGVfb25seRgDIAEoCCJ6CiVSZXN0b3JlVmlydHVhbE1hY2hpbmVTbmFwc2hvdFJlc3BvbnNlEjsKD3ZpcnR1YWxfbWFjaGluZRgBIAEoCzIiLnZpcnR1YWxfbWFjaGluZS52MS5WaXJ0dWFsTWFjaGluZRIUCgxvcGVyYXRpb25faWQYAiABKAkiMQojRGVsZXRlVmlydHVhbE1hY2hpbmVTbmFwc2hvdFJlcXVlc3QSCgoCaWQYASABKAkiNwokRGVsZXRlVmlydHVhbE1hY2hpbmVTbmFwc2hvdFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgyqA8KFVZpcnR1YWxNYWNoaW5lU2VydmljZRJ5ChRDcmVhdGVWaXJ0dWFsTWFjaGluZRIvLnZpcnR1YWxfbWFjaGluZS52MS5DcmVhdGVWaXJ0dWFsTWFjaGluZVJlcXVlc3QaMC52aXJ0dWFsX21hY2hpbmUudjEuQ3JlYXRlVmlydHVhbE1hY2hpbmVSZXNwb25zZRJwChFHZXRWaXJ0dWFsTWFjaGluZRIsLnZpcnR1YWxfbWFjaGluZS52MS5HZXRWaXJ0dWFsTWFjaGluZVJlcXVlc3QaLS52aXJ0dWFsX21hY2hpbmUudjEuR2V0VmlydHVhbE1hY2hpbmVSZXNwb25zZRJ2ChNMaXN0VmlydHVhbE1hY2hpbmVzEi4udmlydHVhbF9tYWNoaW5lLnYxLkxpc3RWaXJ0dWFsTWFjaGluZXNSZXF1ZXN0Gi8udmlydHVhbF9tYWNoaW5lLnYxLkxpc3RWaXJ0dWFsTWFjaGluZXNSZXNwb25zZRJ5ChRVcGRhdGVWaXJ0dWFsTWFjaGluZRIvLnZpcnR1YWxfbWFjaGluZS52MS5VcGRhdGVWaXJ0dWFsTWFjaGluZVJlcXVlc3QaMC52aXJ0dWFsX21hY2hpbmUudjEuVXBkYXRlVmlydHVhbE1hY2hpbmVSZXNwb25zZRJ5ChREZWxldGVWaXJ0dWFsTWFjaGluZRIvLnZpcnR1YWxfbWFjaGluZS52MS5EZWxldGVWaXJ0dWFsTWFjaGluZVJlcXVlc3QaMC52aXJ0dWFsX21hY2hpbmUudjEuRGVsZXRlVmlydHVhbE1hY2hpbmVSZXNwb25zZRJ2ChNHZXRSZW5kZXJlZEFydGlmYWN0Ei4udmlydHVhbF9tYWNoaW5lLnYxLkdldFJlbmRlcmVkQXJ0aWZhY3RSZXF1ZXN0Gi8udmlydHVhbF9tYWNoaW5lLnYxLkdldFJlbmRlcmVkQXJ0aWZhY3RSZXNwb25zZRKCAQoXRXhwb3J0UmVuZGVyZWRBcnRpZmFjdHMSMi52aXJ0dWFsX21hY2hpbmUudjEuRXhwb3J0UmVuZGVyZWRBcnRpZmFjdHNSZXF1ZXN0GjMudmlydHVhbF9tYWNoaW5lLnYxLkV4cG9ydFJlbmRlcmVkQXJ0aWZhY3RzUmVzcG9uc2USdgoTU3RhcnRWaXJ0dWFsTWFjaGluZRIuLnZpcnR1YWxfbWFjaGluZS52MS5TdGFydFZpcnR1YWxNYWNoaW5lUmVxdWVzdBovLnZpcnR1YWxfbWFjaGluZS52MS5TdGFydFZpcnR1YWxNYWNoaW5lUmVzcG9uc2UScwoSU3RvcFZpcnR1YWxNYWNoaW5lEi0udmlydHVhbF9tYWNoaW5lLnYxLlN0b3BWaXJ0dWFsTWFjaGluZVJlcXVlc3QaLi52aXJ0dWFsX21hY2hpbmUudjEuU3RvcFZpcnR1YWxNYWNoaW5lUmVzcG9uc2USfAoVUmVzdGFydFZpcnR1YWxNYWNoaW5lEjAudmlydHVhbF9tYWNoaW5lLnYxLlJlc3RhcnRWaXJ0dWFsTWFjaGluZVJlcXVlc3QaMS52aXJ0dWFsX21hY2hpbmUudjEuUmVzdGFydFZpcnR1YWxNYWNoaW5lUmVzcG9uc2USfAoVU3VzcGVuZFZpcnR1YWxNYWNoaW5lEjAudmlydHVhbF9tYWNoaW5lLnYxLlN1c3BlbmRWaXJ0dWFsTWFjaGluZVJlcXVlc3QaMS52aXJ0dWFsX21hY2hpbmUudjEuU3VzcGVuZFZpcnR1YWxNYWNoaW5lUmVzcG9uc2USkQEKHENyZWF0ZVZpcnR1YWxNYWNoaW5lU25hcHNob3QSNy52aXJ0dWFsX21hY2hpbmUudjEuQ3JlYXRlVmlydHVhbE1hY2hpbmVTbmFwc2hvdFJlcXVlc3QaOC52aXJ0dWFsX21hY2hpbmUudjEuQ3JlYXRlVmlydHVhbE1hY2hpbmVTbmFwc2hvdFJlc3BvbnNlEo4BChtMaXN0VmlydHVhbE1hY2hpbmVTbmFwc2hvdHMSNi52aXJ0dWFsX21hY2hpbmUudjEuTGlzdFZpcnR1YWxNYWNoaW5lU25hcHNob3RzUmVxdWVzdBo3LnZpcnR1YWxfbWFjaGluZS52MS5MaXN0VmlydHVhbE1hY2hpbmVTbmFwc2hvdHNSZXNwb25zZRKUAQodUmVzdG9yZVZpcnR1YWxNYWNoaW5lU25hcHNob3QSOC52aXJ0dWFsX21hY2hpbmUudjEuUmVzdG9yZVZpcnR1YWxNYWNoaW5lU25hcHNob3RSZXF1ZXN0GjkudmlydHVhbF9tYWNoaW5lLnYxLlJlc3RvcmVWaXJ0dWFsTWFjaGluZVNuYXBzaG90UmVzcG9uc2USkQEKHERlbGV0ZVZpcnR1YWxNYWNoaW5lU25hcHNob3QSNy52aXJ0dWFsX21hY2hpbmUudjEuRGVsZXRlVmlydHVhbE1hY2hpbmVTbmFwc2hvdFJlcXVlc3QaOC52aXJ0dWFsX21hY2hpbmUudjEuRGVsZXRlVmlydHVhbE1hY2hpbmVTbmFwc2hvdFJlc3BvbnNlQuQBChZjb20udmlydHVhbF9tYWNoaW5lLnYxQhNWaXJ0dWFsTWFjaGluZVByb3RvUAFaUGdpdGh1Yi5jb20vYWExZXgvcGFhcy1wcm92aWRlci9wa2cvYXBpL2dycGMvdmlydHVhbF9tYWNoaW5lL3YxO3ZpcnR1YWxfbWFjaGluZXYxogIDVlhYqgIRVmlydHVhbE1hY2hpbmUuVjHKAhFWaXJ0dWFsTWFjaGluZVxWMeICHVZpcnR1YWxNYWNoaW5lXFYxXEdQQk1ldGFkYXRh6gISVmlydHVhbE1hY2hpbmU6OlYxYgZwcm90bzM=", [file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
 
 /**
  * Describes the message virtual_machine.v1.VirtualMachine.
@@ -17,75 +18,272 @@ export const file_virtual_machine_v1_virtual_machine = /*@__PURE__*/
 export const VirtualMachineSchema = /*@__PURE__*/
   messageDesc(file_virtual_machine_v1_virtual_machine, 0);
 
+/**
+ * Describes the enum virtual_machine.v1.VirtualMachine.DriftStatus.
+ */
+export const VirtualMachine_DriftStatusSchema = /*@__PURE__*/
+  enumDesc(file_virtual_machine_v1_virtual_machine, 0, 0);
+
+/**
+ * @generated from enum virtual_machine.v1.VirtualMachine.DriftStatus
+ */
+export const VirtualMachine_DriftStatus = /*@__PURE__*/
+  tsEnum(VirtualMachine_DriftStatusSchema);
+
+/**
+ * Describes the enum virtual_machine.v1.VirtualMachine.Status.
+ */
+export const VirtualMachine_StatusSchema = /*@__PURE__*/
+  enumDesc(file_virtual_machine_v1_virtual_machine, 0, 1);
+
+/**
+ * @generated from enum virtual_machine.v1.VirtualMachine.Status
+ */
+export const VirtualMachine_Status = /*@__PURE__*/
+  tsEnum(VirtualMachine_StatusSchema);
+
+/**
+ * Describes the message virtual_machine.v1.Condition.
+ * Use `create(ConditionSchema)` to create a new message.
+ */
+export const ConditionSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 1);
+
+/**
+ * Describes the message virtual_machine.v1.VirtualMachineSnapshot.
+ * Use `create(VirtualMachineSnapshotSchema)` to create a new message.
+ */
+export const VirtualMachineSnapshotSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 2);
+
+/**
+ * Describes the message virtual_machine.v1.Event.
+ * Use `create(EventSchema)` to create a new message.
+ */
+export const EventSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 3);
+
 /**
  * Describes the message virtual_machine.v1.CreateVirtualMachineRequest.
  * Use `create(CreateVirtualMachineRequestSchema)` to create a new message.
  */
 export const CreateVirtualMachineRequestSchema = /*@__PURE__*/
-  messageDesc(file_virtual_machine_v1_virtual_machine, 1);
+  messageDesc(file_virtual_machine_v1_virtual_machine, 4);
 
 /**
  * Describes the message virtual_machine.v1.CreateVirtualMachineResponse.
  * Use `create(CreateVirtualMachineResponseSchema)` to create a new message.
  */
 export const CreateVirtualMachineResponseSchema = /*@__PURE__*/
-  messageDesc(file_virtual_machine_v1_virtual_machine, 2);
+  messageDesc(file_virtual_machine_v1_virtual_machine, 5);
 
 /**
  * Describes the message virtual_machine.v1.GetVirtualMachineRequest.
  * Use `create(GetVirtualMachineRequestSchema)` to create a new message.
  */
 export const GetVirtualMachineRequestSchema = /*@__PURE__*/
-  messageDesc(file_virtual_machine_v1_virtual_machine, 3);
+  messageDesc(file_virtual_machine_v1_virtual_machine, 6);
 
 /**
  * Describes the message virtual_machine.v1.GetVirtualMachineResponse.
  * Use `create(GetVirtualMachineResponseSchema)` to create a new message.
  */
 export const GetVirtualMachineResponseSchema = /*@__PURE__*/
-  messageDesc(file_virtual_machine_v1_virtual_machine, 4);
+  messageDesc(file_virtual_machine_v1_virtual_machine, 7);
 
 /**
  * Describes the message virtual_machine.v1.ListVirtualMachinesRequest.
  * Use `create(ListVirtualMachinesRequestSchema)` to create a new message.
  */
 export const ListVirtualMachinesRequestSchema = /*@__PURE__*/
-  messageDesc(file_virtual_machine_v1_virtual_machine, 5);
+  messageDesc(file_virtual_machine_v1_virtual_machine, 8);
 
 /**
  * Describes the message virtual_machine.v1.ListVirtualMachinesResponse.
  * Use `create(ListVirtualMachinesResponseSchema)` to create a new message.
  */
 export const ListVirtualMachinesResponseSchema = /*@__PURE__*/
-  messageDesc(file_virtual_machine_v1_virtual_machine, 6);
+  messageDesc(file_virtual_machine_v1_virtual_machine, 9);
 
 /**
  * Describes the message virtual_machine.v1.UpdateVirtualMachineRequest.
  * Use `create(UpdateVirtualMachineRequestSchema)` to create a new message.
  */
 export const UpdateVirtualMachineRequestSchema = /*@__PURE__*/
-  messageDesc(file_virtual_machine_v1_virtual_machine, 7);
+  messageDesc(file_virtual_machine_v1_virtual_machine, 10);
 
 /**
  * Describes the message virtual_machine.v1.UpdateVirtualMachineResponse.
  * Use `create(UpdateVirtualMachineResponseSchema)` to create a new message.
  */
 export const UpdateVirtualMachineResponseSchema = /*@__PURE__*/
-  messageDesc(file_virtual_machine_v1_virtual_machine, 8);
+  messageDesc(file_virtual_machine_v1_virtual_machine, 11);
 
 /**
  * Describes the message virtual_machine.v1.DeleteVirtualMachineRequest.
  * Use `create(DeleteVirtualMachineRequestSchema)` to create a new message.
  */
 export const DeleteVirtualMachineRequestSchema = /*@__PURE__*/
-  messageDesc(file_virtual_machine_v1_virtual_machine, 9);
+  messageDesc(file_virtual_machine_v1_virtual_machine, 12);
 
 /**
  * Describes the message virtual_machine.v1.DeleteVirtualMachineResponse.
  * Use `create(DeleteVirtualMachineResponseSchema)` to create a new message.
  */
 export const DeleteVirtualMachineResponseSchema = /*@__PURE__*/
-  messageDesc(file_virtual_machine_v1_virtual_machine, 10);
+  messageDesc(file_virtual_machine_v1_virtual_machine, 13);
+
+/**
+ * Describes the message virtual_machine.v1.GetRenderedArtifactRequest.
+ * Use `create(GetRenderedArtifactRequestSchema)` to create a new message.
+ */
+export const GetRenderedArtifactRequestSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 14);
+
+/**
+ * Describes the message virtual_machine.v1.GetRenderedArtifactResponse.
+ * Use `create(GetRenderedArtifactResponseSchema)` to create a new message.
+ */
+export const GetRenderedArtifactResponseSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 15);
+
+/**
+ * Describes the message virtual_machine.v1.ExportRenderedArtifactsRequest.
+ * Use `create(ExportRenderedArtifactsRequestSchema)` to create a new message.
+ */
+export const ExportRenderedArtifactsRequestSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 16);
+
+/**
+ * Describes the enum virtual_machine.v1.ExportRenderedArtifactsRequest.Format.
+ */
+export const ExportRenderedArtifactsRequest_FormatSchema = /*@__PURE__*/
+  enumDesc(file_virtual_machine_v1_virtual_machine, 16, 0);
+
+/**
+ * @generated from enum virtual_machine.v1.ExportRenderedArtifactsRequest.Format
+ */
+export const ExportRenderedArtifactsRequest_Format = /*@__PURE__*/
+  tsEnum(ExportRenderedArtifactsRequest_FormatSchema);
+
+/**
+ * Describes the message virtual_machine.v1.ExportRenderedArtifactsResponse.
+ * Use `create(ExportRenderedArtifactsResponseSchema)` to create a new message.
+ */
+export const ExportRenderedArtifactsResponseSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 17);
+
+/**
+ * Describes the message virtual_machine.v1.StartVirtualMachineRequest.
+ * Use `create(StartVirtualMachineRequestSchema)` to create a new message.
+ */
+export const StartVirtualMachineRequestSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 18);
+
+/**
+ * Describes the message virtual_machine.v1.StartVirtualMachineResponse.
+ * Use `create(StartVirtualMachineResponseSchema)` to create a new message.
+ */
+export const StartVirtualMachineResponseSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 19);
+
+/**
+ * Describes the message virtual_machine.v1.StopVirtualMachineRequest.
+ * Use `create(StopVirtualMachineRequestSchema)` to create a new message.
+ */
+export const StopVirtualMachineRequestSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 20);
+
+/**
+ * Describes the message virtual_machine.v1.StopVirtualMachineResponse.
+ * Use `create(StopVirtualMachineResponseSchema)` to create a new message.
+ */
+export const StopVirtualMachineResponseSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 21);
+
+/**
+ * Describes the message virtual_machine.v1.RestartVirtualMachineRequest.
+ * Use `create(RestartVirtualMachineRequestSchema)` to create a new message.
+ */
+export const RestartVirtualMachineRequestSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 22);
+
+/**
+ * Describes the message virtual_machine.v1.RestartVirtualMachineResponse.
+ * Use `create(RestartVirtualMachineResponseSchema)` to create a new message.
+ */
+export const RestartVirtualMachineResponseSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 23);
+
+/**
+ * Describes the message virtual_machine.v1.SuspendVirtualMachineRequest.
+ * Use `create(SuspendVirtualMachineRequestSchema)` to create a new message.
+ */
+export const SuspendVirtualMachineRequestSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 24);
+
+/**
+ * Describes the message virtual_machine.v1.SuspendVirtualMachineResponse.
+ * Use `create(SuspendVirtualMachineResponseSchema)` to create a new message.
+ */
+export const SuspendVirtualMachineResponseSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 25);
+
+/**
+ * Describes the message virtual_machine.v1.CreateVirtualMachineSnapshotRequest.
+ * Use `create(CreateVirtualMachineSnapshotRequestSchema)` to create a new message.
+ */
+export const CreateVirtualMachineSnapshotRequestSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 26);
+
+/**
+ * Describes the message virtual_machine.v1.CreateVirtualMachineSnapshotResponse.
+ * Use `create(CreateVirtualMachineSnapshotResponseSchema)` to create a new message.
+ */
+export const CreateVirtualMachineSnapshotResponseSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 27);
+
+/**
+ * Describes the message virtual_machine.v1.ListVirtualMachineSnapshotsRequest.
+ * Use `create(ListVirtualMachineSnapshotsRequestSchema)` to create a new message.
+ */
+export const ListVirtualMachineSnapshotsRequestSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 28);
+
+/**
+ * Describes the message virtual_machine.v1.ListVirtualMachineSnapshotsResponse.
+ * Use `create(ListVirtualMachineSnapshotsResponseSchema)` to create a new message.
+ */
+export const ListVirtualMachineSnapshotsResponseSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 29);
+
+/**
+ * Describes the message virtual_machine.v1.RestoreVirtualMachineSnapshotRequest.
+ * Use `create(RestoreVirtualMachineSnapshotRequestSchema)` to create a new message.
+ */
+export const RestoreVirtualMachineSnapshotRequestSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 30);
+
+/**
+ * Describes the message virtual_machine.v1.RestoreVirtualMachineSnapshotResponse.
+ * Use `create(RestoreVirtualMachineSnapshotResponseSchema)` to create a new message.
+ */
+export const RestoreVirtualMachineSnapshotResponseSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 31);
+
+/**
+ * Describes the message virtual_machine.v1.DeleteVirtualMachineSnapshotRequest.
+ * Use `create(DeleteVirtualMachineSnapshotRequestSchema)` to create a new message.
+ */
+export const DeleteVirtualMachineSnapshotRequestSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 32);
+
+/**
+ * Describes the message virtual_machine.v1.DeleteVirtualMachineSnapshotResponse.
+ * Use `create(DeleteVirtualMachineSnapshotResponseSchema)` to create a new message.
+ */
+export const DeleteVirtualMachineSnapshotResponseSchema = /*@__PURE__*/
+  messageDesc(file_virtual_machine_v1_virtual_machine, 33);
 
 /**
  * @generated from service virtual_machine.v1.VirtualMachineService
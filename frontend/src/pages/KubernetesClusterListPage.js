@@ -3,12 +3,19 @@ import ResourceList from '../components/ResourceList';
 import ResourceDetail from '../components/ResourceDetail';
 import ResourceForm from '../components/ResourceForm';
 import client from '../client/client';
+import { parameterFields, collectParameters } from '../components/parameters';
+import { formatArtifacts, downloadArchive } from '../components/artifacts';
+import { STATUSES, statusFields } from '../components/status';
 import './KubernetesClusterListPage.css';
 import {Button, IconButton, Tooltip} from "@mui/material";
 import {Add as AddIcon, CloudDownload as DownloadIcon} from "@mui/icons-material";
 
+// Number of resources loaded per page
+const PAGE_SIZE = 50;
+
 const KubernetesClusterListPage = () => {
   const [clusters, setClusters] = useState([]);
+  const [nextPageToken, setNextPageToken] = useState('');
   const [loading, setLoading] = useState(true);
   const [error, setError] = useState(null);
   const [selectedCluster, setSelectedCluster] = useState(null);
@@ -22,6 +29,7 @@ const KubernetesClusterListPage = () => {
     { key: 'region', label: 'Регион' },
     { key: 'nodeCount', label: 'Количество узлов' },
     { key: 'version', label: 'Версия Kubernetes' },
+    { key: 'status', label: 'Статус', render: (cluster) => STATUSES[cluster.status] || '—' },
     { 
       key: 'kubeconfig', 
       label: 'Kubeconfig', 
@@ -49,7 +57,9 @@ const KubernetesClusterListPage = () => {
     { key: 'region', label: 'Регион' },
     { key: 'nodeCount', label: 'Количество узлов' },
     { key: 'version', label: 'Версия Kubernetes' },
-    { key: 'templateId', label: 'ID шаблона' }
+    { key: 'templateId', label: 'ID шаблона' },
+    { key: 'templateRevision', label: 'Ревизия шаблона' },
+    ...statusFields
   ];
 
   // Fetch clusters and templates on component mount
@@ -59,12 +69,20 @@ const KubernetesClusterListPage = () => {
   }, []);
 
   // Fetch Kubernetes clusters from the API
-  const fetchKubernetesClusters = async () => {
-    setLoading(true);
+  const fetchKubernetesClusters = async (pageToken = '') => {
+    if (!pageToken) {
+      setLoading(true);
+    }
     setError(null);
     try {
-      const response = await client.kubernetesClusters.listKubernetesClusters({});
-      setClusters(response.kubernetesClusters || []);
+      const response = await client.kubernetesClusters.listKubernetesClusters({
+        pageSize: PAGE_SIZE,
+        pageToken,
+        orderBy: 'name'
+      });
+      const page = response.kubernetesClusters || [];
+      setClusters(prev => (pageToken ? [...prev, ...page] : page));
+      setNextPageToken(response.nextPageToken || '');
     } catch (err) {
       setError('Ошибка при загрузке кластеров Kubernetes: ' + (err.message || 'Неизвестная ошибка'));
       console.error('Error fetching Kubernetes clusters:', err);
@@ -151,10 +169,20 @@ const KubernetesClusterListPage = () => {
     setIsEditModalOpen(true);
   };
 
+  // Download the rendered artifacts as an archive
+  const handleDownloadArtifacts = async (resource) => {
+    try {
+      downloadArchive(await client.kubernetesClusters.exportRenderedArtifacts({ id: resource.id }));
+    } catch (err) {
+      setError('Ошибка при скачивании артефактов кластера: ' + (err.message || 'Неизвестная ошибка'));
+      console.error('Error exporting artifacts:', err);
+    }
+  };
+
   // Handle delete cluster
   const handleDeleteCluster = async (cluster) => {
     try {
-      await client.kubernetesClusters.deleteKubernetesCluster({ id: cluster.id });
+      await client.kubernetesClusters.deleteKubernetesCluster({ id: cluster.id, resourceVersion: cluster.resourceVersion });
       // Refresh the cluster list
       fetchKubernetesClusters();
     } catch (err) {
@@ -196,34 +224,45 @@ const KubernetesClusterListPage = () => {
   };
 
   // Handle form submit (create or update)
-  const handleFormSubmit = async (formData) => {
+  const handleFormSubmit = async (formData, { preview = false } = {}) => {
     try {
       // Convert numeric fields to numbers
       formData.nodeCount = parseInt(formData.nodeCount, 10);
 
       if (selectedCluster) {
         // Update existing cluster
-        await client.kubernetesClusters.updateKubernetesCluster({
+        const response = await client.kubernetesClusters.updateKubernetesCluster({
+          validateOnly: preview,
           kubernetesCluster: {
             id: formData.id,
             name: formData.name,
             region: formData.region,
             nodeCount: formData.nodeCount,
             version: formData.version,
-            templateId: formData.templateId
+            templateId: formData.templateId,
+            parameters: collectParameters(formData),
+            resourceVersion: selectedCluster.resourceVersion
           }
         });
+        if (preview) {
+          return formatArtifacts(response.kubernetesCluster.renderedArtifacts);
+        }
       } else {
         // Create new cluster
-        await client.kubernetesClusters.createKubernetesCluster({
+        const response = await client.kubernetesClusters.createKubernetesCluster({
+          validateOnly: preview,
           kubernetesCluster: {
             name: formData.name,
             region: formData.region,
             nodeCount: formData.nodeCount,
             version: formData.version,
-            templateId: formData.templateId
+            templateId: formData.templateId,
+            parameters: collectParameters(formData)
           }
         });
+        if (preview) {
+          return formatArtifacts(response.kubernetesCluster.renderedArtifacts);
+        }
       }
 
       // Close the modal and refresh the cluster list
@@ -231,6 +270,10 @@ const KubernetesClusterListPage = () => {
       setSelectedCluster(null);
       fetchKubernetesClusters();
     } catch (err) {
+      // Show errors of a dry run next to the form
+      if (preview) {
+        return 'Ошибка: ' + (err.rawMessage || err.message || 'Неизвестная ошибка');
+      }
       setError('Ошибка при сохранении кластера: ' + (err.message || 'Неизвестная ошибка'));
       console.error('Error saving cluster:', err);
     }
@@ -259,13 +302,15 @@ const KubernetesClusterListPage = () => {
       {error && (
         <div className="error-message">
           <p>{error}</p>
-          <button onClick={fetchKubernetesClusters}>Повторить</button>
+          <button onClick={() => fetchKubernetesClusters()}>Повторить</button>
         </div>
       )}
 
       {!loading && !error && (
         <ResourceList 
           resources={clusters}
+          hasMore={Boolean(nextPageToken)}
+          onLoadMore={() => fetchKubernetesClusters(nextPageToken)}
           columns={columns}
           onView={handleViewCluster}
           onEdit={handleEditCluster}
@@ -278,6 +323,7 @@ const KubernetesClusterListPage = () => {
         <ResourceDetail 
           resource={selectedCluster}
           fields={detailFields}
+          onDownload={handleDownloadArtifacts}
           onClose={() => {
             setIsViewModalOpen(false);
             setSelectedCluster(null);
@@ -290,11 +336,13 @@ const KubernetesClusterListPage = () => {
         <ResourceForm 
           resource={selectedCluster}
           fields={formFields}
+          dynamicFields={(formData) => parameterFields(templates.find(template => template.id === formData.templateId))}
           onSubmit={handleFormSubmit}
           onCancel={() => {
             setIsEditModalOpen(false);
             setSelectedCluster(null);
           }}
+          previewable
           title={selectedCluster ? "Редактирование Кластера Kubernetes" : "Создание Кластера Kubernetes"}
           submitButtonText={selectedCluster ? "Сохранить" : "Создать"}
         />
@@ -1,14 +1,21 @@
-import React, { useState, useEffect } from 'react';
+import React, { useState, useEffect, useMemo } from 'react';
 import ResourceList from '../components/ResourceList';
 import ResourceDetail from '../components/ResourceDetail';
 import ResourceForm from '../components/ResourceForm';
 import client from '../client/client';
+import { Code } from '@connectrpc/connect';
+import { formatParameterSchema, parseParameterSchema } from '../components/parameters';
+import { formatTemplateFiles, parseTemplateFiles, OUTPUT_FORMATS } from '../components/artifacts';
 import './TemplateListPage.css';
 import {Button} from "@mui/material";
 import {Add as AddIcon} from "@mui/icons-material";
 
+// Number of resources loaded per page
+const PAGE_SIZE = 50;
+
 const TemplateListPage = () => {
   const [templates, setTemplates] = useState([]);
+  const [nextPageToken, setNextPageToken] = useState('');
   const [loading, setLoading] = useState(true);
   const [error, setError] = useState(null);
   const [selectedTemplate, setSelectedTemplate] = useState(null);
@@ -25,6 +32,7 @@ const TemplateListPage = () => {
         switch (template.type) {
           case 1: return 'Виртуальная машина';
           case 2: return 'Kubernetes кластер';
+          case 3: return 'Фрагмент';
           default: return 'Неизвестный';
         }
       }
@@ -46,6 +54,8 @@ const TemplateListPage = () => {
   const detailFields = [
     { key: 'id', label: 'ID' },
     { key: 'name', label: 'Имя' },
+    { key: 'revision', label: 'Ревизия' },
+    { key: 'description', label: 'Описание' },
     { 
       key: 'type', 
       label: 'Тип',
@@ -53,6 +63,7 @@ const TemplateListPage = () => {
         switch (template.type) {
           case 1: return 'Виртуальная машина';
           case 2: return 'Kubernetes кластер';
+          case 3: return 'Фрагмент';
           default: return 'Неизвестный';
         }
       }
@@ -61,7 +72,33 @@ const TemplateListPage = () => {
       key: 'rawTemplate', 
       label: 'Шаблон',
       render: (template) => (
-        <pre className="template-code">{template.rawTemplate}</pre>
+        <pre className="template-code">{template.rawTemplate || '—'}</pre>
+      )
+    },
+    {
+      key: 'files',
+      label: 'Файлы',
+      render: (template) => (
+        <pre className="template-code">{formatTemplateFiles(template.files) || '—'}</pre>
+      )
+    },
+    {
+      key: 'outputFormat',
+      label: 'Формат результата',
+      render: (template) => `${OUTPUT_FORMATS[template.outputFormat] || 'plain'}${template.normalizeOutput ? ', с нормализацией' : ''}`
+    },
+    {
+      key: 'outputSchema',
+      label: 'Схема результата',
+      render: (template) => (
+        <pre className="template-code">{template.outputSchema || '—'}</pre>
+      )
+    },
+    {
+      key: 'parameters',
+      label: 'Параметры',
+      render: (template) => (
+        <pre className="template-code">{formatParameterSchema(template.parameters) || '—'}</pre>
       )
     }
   ];
@@ -75,6 +112,12 @@ const TemplateListPage = () => {
       placeholder: 'Введите имя шаблона',
       required: true
     },
+    {
+      name: 'description',
+      label: 'Описание',
+      type: 'text',
+      placeholder: 'Кратко опишите назначение шаблона'
+    },
     {
       name: 'type',
       label: 'Тип',
@@ -82,30 +125,85 @@ const TemplateListPage = () => {
       required: true,
       options: [
         { value: '1', label: 'Виртуальная машина' },
-        { value: '2', label: 'Kubernetes кластер' }
+        { value: '2', label: 'Kubernetes кластер' },
+        { value: '3', label: 'Фрагмент (вызывается из других шаблонов)' }
       ]
     },
     {
       name: 'rawTemplate',
       label: 'Шаблон',
       type: 'textarea',
-      placeholder: 'Введите шаблон',
-      required: true
+      placeholder: 'Введите шаблон (артефакт "main"); можно оставить пустым, если заданы файлы'
+    },
+    {
+      name: 'files',
+      label: 'Файлы',
+      type: 'textarea',
+      placeholder: '### user-data (cloud-config)\n#cloud-config\nhostname: {{ .Name }}\n### network-config (yaml)\nversion: 2'
+    },
+    {
+      name: 'outputFormat',
+      label: 'Формат результата',
+      type: 'select',
+      options: [
+        { value: '0', label: 'Без проверки' },
+        ...Object.entries(OUTPUT_FORMATS).map(([value, label]) => ({ value, label }))
+      ]
+    },
+    {
+      name: 'outputSchema',
+      label: 'Схема результата (JSON Schema, JSON или YAML)',
+      type: 'textarea',
+      placeholder: '{"type": "object", "required": ["users", "packages"]}'
+    },
+    {
+      name: 'normalizeOutput',
+      label: 'Нормализовать результат',
+      type: 'select',
+      options: [
+        { value: 'false', label: 'Нет' },
+        { value: 'true', label: 'Да' }
+      ]
+    },
+    {
+      name: 'parameters',
+      label: 'Параметры (JSON)',
+      type: 'textarea',
+      placeholder: '[{"name": "disk_size", "type": "integer", "required": true, "min": 10, "description": "Размер диска в ГБ"}]'
     }
   ];
 
+  // The parameter schema is edited as JSON, the files as text with a header before each file
+  const editedTemplate = useMemo(() => (
+    selectedTemplate && {
+      ...selectedTemplate,
+      parameters: formatParameterSchema(selectedTemplate.parameters),
+      files: formatTemplateFiles(selectedTemplate.files),
+      outputFormat: String(selectedTemplate.outputFormat),
+      normalizeOutput: String(selectedTemplate.normalizeOutput)
+    }
+  ), [selectedTemplate]);
+
   // Fetch templates on component mount
   useEffect(() => {
     fetchTemplates();
   }, []);
 
   // Fetch templates from the API
-  const fetchTemplates = async () => {
-    setLoading(true);
+  const fetchTemplates = async (pageToken = '') => {
+    if (!pageToken) {
+      setLoading(true);
+    }
     setError(null);
     try {
-      const response = await client.templates.listTemplates({});
-      setTemplates(response.templates || []);
+      const response = await client.templates.listTemplates({
+        pageSize: PAGE_SIZE,
+        pageToken,
+        orderBy: 'name'
+      });
+      const page = response.templates || [];
+      setTemplates(prev => (pageToken ? [...prev, ...page] : page));
+      setNextPageToken(response.nextPageToken || '');
     } catch (err) {
       setError('Ошибка при загрузке шаблонов: ' + (err.message || 'Неизвестная ошибка'));
       console.error('Error fetching templates:', err);
@@ -127,12 +225,17 @@ const TemplateListPage = () => {
   };
 
   // Handle delete template
-  const handleDeleteTemplate = async (template) => {
+  const handleDeleteTemplate = async (template, force = false) => {
     try {
-      await client.templates.deleteTemplate({ id: template.id });
+      await client.templates.deleteTemplate({ id: template.id, resourceVersion: template.resourceVersion, force });
       // Refresh the template list
       fetchTemplates();
     } catch (err) {
+      // The template is still used by resources, offer to delete them as well
+      if (!force && err.code === Code.FailedPrecondition &&
+          window.confirm(`${err.rawMessage}\n\nУдалить шаблон вместе со всеми зависимыми ресурсами?`)) {
+        return handleDeleteTemplate(template, true);
+      }
       setError('Ошибка при удалении шаблона: ' + (err.message || 'Неизвестная ошибка'));
       console.error('Error deleting template:', err);
     }
@@ -141,8 +244,32 @@ const TemplateListPage = () => {
   // Handle form submit (create or update)
   const handleFormSubmit = async (formData) => {
     try {
-      // Convert type to number
+      // Convert type and output format to numbers
       formData.type = parseInt(formData.type, 10);
+      formData.outputFormat = parseInt(formData.outputFormat || '0', 10);
+      formData.normalizeOutput = formData.normalizeOutput === 'true';
+
+      // Parse the parameter schema
+      let parameters;
+      try {
+        parameters = parseParameterSchema(formData.parameters);
+      } catch (err) {
+        setError('Некорректный JSON параметров: ' + err.message);
+        return;
+      }
+
+      // Parse the files, keeping the output schemas of existing files, which
+      // are not part of the text
+      let files;
+      try {
+        files = parseTemplateFiles(formData.files).map(file => ({
+          ...file,
+          outputSchema: selectedTemplate?.files.find(existing => existing.name === file.name)?.outputSchema || ''
+        }));
+      } catch (err) {
+        setError('Некорректные файлы: ' + err.message);
+        return;
+      }
 
       if (selectedTemplate) {
         // Update existing template
@@ -150,8 +277,15 @@ const TemplateListPage = () => {
           template: {
             id: formData.id,
             name: formData.name,
+            description: formData.description,
             type: formData.type,
-            rawTemplate: formData.rawTemplate
+            rawTemplate: formData.rawTemplate,
+            parameters,
+            files,
+            outputFormat: formData.outputFormat,
+            normalizeOutput: formData.normalizeOutput,
+            outputSchema: formData.outputSchema,
+            resourceVersion: selectedTemplate.resourceVersion
           }
         });
       } else {
@@ -159,8 +293,14 @@ const TemplateListPage = () => {
         await client.templates.createTemplate({
           template: {
             name: formData.name,
+            description: formData.description,
             type: formData.type,
-            rawTemplate: formData.rawTemplate
+            rawTemplate: formData.rawTemplate,
+            parameters,
+            files,
+            outputFormat: formData.outputFormat,
+            normalizeOutput: formData.normalizeOutput,
+            outputSchema: formData.outputSchema
           }
         });
       }
@@ -198,13 +338,15 @@ const TemplateListPage = () => {
       {error && (
         <div className="error-message">
           <p>{error}</p>
-          <button onClick={fetchTemplates}>Повторить</button>
+          <button onClick={() => fetchTemplates()}>Повторить</button>
         </div>
       )}
 
       {!loading && !error && (
         <ResourceList 
           resources={templates}
+          hasMore={Boolean(nextPageToken)}
+          onLoadMore={() => fetchTemplates(nextPageToken)}
           columns={columns}
           onView={handleViewTemplate}
           onEdit={handleEditTemplate}
@@ -227,7 +369,7 @@ const TemplateListPage = () => {
 
       {isEditModalOpen && (
         <ResourceForm 
-          resource={selectedTemplate}
+          resource={editedTemplate}
           fields={formFields}
           onSubmit={handleFormSubmit}
           onCancel={() => {
@@ -3,12 +3,19 @@ import ResourceList from '../components/ResourceList';
 import ResourceDetail from '../components/ResourceDetail';
 import ResourceForm from '../components/ResourceForm';
 import client from '../client/client';
+import { parameterFields, collectParameters } from '../components/parameters';
+import { formatArtifacts, downloadArchive } from '../components/artifacts';
+import { STATUSES, statusFields, formatEvents, formatTimestamp } from '../components/status';
 import './VirtualMachineListPage.css';
 import {Button} from "@mui/material";
 import {Add as AddIcon} from "@mui/icons-material";
 
+// Number of resources loaded per page
+const PAGE_SIZE = 50;
+
 const VirtualMachineListPage = () => {
   const [virtualMachines, setVirtualMachines] = useState([]);
+  const [nextPageToken, setNextPageToken] = useState('');
   const [loading, setLoading] = useState(true);
   const [error, setError] = useState(null);
   const [selectedVM, setSelectedVM] = useState(null);
@@ -21,7 +28,8 @@ const VirtualMachineListPage = () => {
     { key: 'name', label: 'Имя' },
     { key: 'cpu', label: 'CPU (ядра)' },
     { key: 'memory', label: 'Память (МБ)' },
-    { key: 'os', label: 'ОС' }
+    { key: 'os', label: 'ОС' },
+    { key: 'status', label: 'Статус', render: (vm) => STATUSES[vm.status] || '—' }
   ];
 
   // Define fields for the VM detail view
@@ -31,9 +39,27 @@ const VirtualMachineListPage = () => {
     { key: 'cpu', label: 'CPU (ядра)' },
     { key: 'memory', label: 'Память (МБ)' },
     { key: 'os', label: 'Операционная система' },
-    { key: 'templateId', label: 'ID шаблона' }
+    { key: 'templateId', label: 'ID шаблона' },
+    { key: 'templateRevision', label: 'Ревизия шаблона' },
+    ...statusFields,
+    { key: 'events', label: 'События', render: (vm) => formatEvents(vm.events) }
   ];
 
+  // Power actions offered in the VM detail view
+  const powerActions = [
+    { label: 'Запустить', method: 'startVirtualMachine' },
+    { label: 'Остановить', method: 'stopVirtualMachine' },
+    { label: 'Выключить', method: 'stopVirtualMachine', force: true },
+    { label: 'Перезагрузить', method: 'restartVirtualMachine' },
+    { label: 'Приостановить', method: 'suspendVirtualMachine' }
+  ].map(({ label, method, force }) => ({
+    label,
+    onClick: (vm) => handlePowerAction(vm, method, force)
+  })).concat([
+    { label: 'Создать снимок', onClick: (vm) => handleCreateSnapshot(vm) },
+    { label: 'Восстановить из снимка', onClick: (vm) => handleRestoreSnapshot(vm) }
+  ]);
+
   // Fetch VMs and templates on component mount
   useEffect(() => {
     fetchVirtualMachines();
@@ -41,12 +67,20 @@ const VirtualMachineListPage = () => {
   }, []);
 
   // Fetch virtual machines from the API
-  const fetchVirtualMachines = async () => {
-    setLoading(true);
+  const fetchVirtualMachines = async (pageToken = '') => {
+    if (!pageToken) {
+      setLoading(true);
+    }
     setError(null);
     try {
-      const response = await client.virtualMachines.listVirtualMachines({});
-      setVirtualMachines(response.virtualMachines || []);
+      const response = await client.virtualMachines.listVirtualMachines({
+        pageSize: PAGE_SIZE,
+        pageToken,
+        orderBy: 'name'
+      });
+      const page = response.virtualMachines || [];
+      setVirtualMachines(prev => (pageToken ? [...prev, ...page] : page));
+      setNextPageToken(response.nextPageToken || '');
     } catch (err) {
       setError('Ошибка при загрузке виртуальных машин: ' + (err.message || 'Неизвестная ошибка'));
       console.error('Error fetching virtual machines:', err);
@@ -132,10 +166,20 @@ const VirtualMachineListPage = () => {
     setIsEditModalOpen(true);
   };
 
+  // Download the rendered artifacts as an archive
+  const handleDownloadArtifacts = async (resource) => {
+    try {
+      downloadArchive(await client.virtualMachines.exportRenderedArtifacts({ id: resource.id }));
+    } catch (err) {
+      setError('Ошибка при скачивании артефактов виртуальной машины: ' + (err.message || 'Неизвестная ошибка'));
+      console.error('Error exporting artifacts:', err);
+    }
+  };
+
   // Handle delete VM
   const handleDeleteVM = async (vm) => {
     try {
-      await client.virtualMachines.deleteVirtualMachine({ id: vm.id });
+      await client.virtualMachines.deleteVirtualMachine({ id: vm.id, resourceVersion: vm.resourceVersion });
       // Refresh the VM list
       fetchVirtualMachines();
     } catch (err) {
@@ -144,8 +188,61 @@ const VirtualMachineListPage = () => {
     }
   };
 
+  // Handle a power action on a VM
+  const handlePowerAction = async (vm, method, force = false) => {
+    try {
+      await client.virtualMachines[method]({ id: vm.id, resourceVersion: vm.resourceVersion, ...(force && { force }) });
+      // Close the detail view and refresh the VM list
+      setIsViewModalOpen(false);
+      setSelectedVM(null);
+      fetchVirtualMachines();
+    } catch (err) {
+      setError('Ошибка при управлении питанием виртуальной машины: ' + (err.message || 'Неизвестная ошибка'));
+      console.error('Error changing the power state of virtual machine:', err);
+    }
+  };
+
+  // Handle creating a snapshot of a VM
+  const handleCreateSnapshot = async (vm) => {
+    const name = window.prompt('Название снимка:');
+    if (!name) {
+      return;
+    }
+    try {
+      await client.virtualMachines.createVirtualMachineSnapshot({ virtualMachineId: vm.id, name });
+    } catch (err) {
+      setError('Ошибка при создании снимка виртуальной машины: ' + (err.message || 'Неизвестная ошибка'));
+      console.error('Error creating snapshot:', err);
+    }
+  };
+
+  // Handle restoring a VM from one of its snapshots
+  const handleRestoreSnapshot = async (vm) => {
+    try {
+      const { snapshots } = await client.virtualMachines.listVirtualMachineSnapshots({ virtualMachineId: vm.id });
+      if (snapshots.length === 0) {
+        setError('У виртуальной машины нет снимков');
+        return;
+      }
+      const choices = snapshots.map((snapshot, index) => `${index + 1}. ${snapshot.name} (${formatTimestamp(snapshot.createTime)})`);
+      const choice = parseInt(window.prompt(`Номер снимка для восстановления:\n${choices.join('\n')}`), 10);
+      const snapshot = snapshots[choice - 1];
+      if (!snapshot) {
+        return;
+      }
+      await client.virtualMachines.restoreVirtualMachineSnapshot({ id: snapshot.id, resourceVersion: vm.resourceVersion });
+      // Close the detail view and refresh the VM list
+      setIsViewModalOpen(false);
+      setSelectedVM(null);
+      fetchVirtualMachines();
+    } catch (err) {
+      setError('Ошибка при восстановлении виртуальной машины из снимка: ' + (err.message || 'Неизвестная ошибка'));
+      console.error('Error restoring snapshot:', err);
+    }
+  };
+
   // Handle form submit (create or update)
-  const handleFormSubmit = async (formData) => {
+  const handleFormSubmit = async (formData, { preview = false } = {}) => {
     try {
       // Convert numeric fields to numbers
       formData.cpu = parseInt(formData.cpu, 10);
@@ -153,27 +250,38 @@ const VirtualMachineListPage = () => {
 
       if (selectedVM) {
         // Update existing VM
-        await client.virtualMachines.updateVirtualMachine({
+        const response = await client.virtualMachines.updateVirtualMachine({
+          validateOnly: preview,
           virtualMachine: {
             id: formData.id,
             name: formData.name,
             cpu: formData.cpu,
             memory: formData.memory,
             os: formData.os,
-            templateId: formData.templateId
+            templateId: formData.templateId,
+            parameters: collectParameters(formData),
+            resourceVersion: selectedVM.resourceVersion
           }
         });
+        if (preview) {
+          return formatArtifacts(response.virtualMachine.renderedArtifacts);
+        }
       } else {
         // Create new VM
-        await client.virtualMachines.createVirtualMachine({
+        const response = await client.virtualMachines.createVirtualMachine({
+          validateOnly: preview,
           virtualMachine: {
             name: formData.name,
             cpu: formData.cpu,
             memory: formData.memory,
             os: formData.os,
-            templateId: formData.templateId
+            templateId: formData.templateId,
+            parameters: collectParameters(formData)
           }
         });
+        if (preview) {
+          return formatArtifacts(response.virtualMachine.renderedArtifacts);
+        }
       }
 
       // Close the modal and refresh the VM list
@@ -181,6 +289,10 @@ const VirtualMachineListPage = () => {
       setSelectedVM(null);
       fetchVirtualMachines();
     } catch (err) {
+      // Show errors of a dry run next to the form
+      if (preview) {
+        return 'Ошибка: ' + (err.rawMessage || err.message || 'Неизвестная ошибка');
+      }
       setError('Ошибка при сохранении виртуальной машины: ' + (err.message || 'Неизвестная ошибка'));
       console.error('Error saving virtual machine:', err);
     }
@@ -209,13 +321,15 @@ const VirtualMachineListPage = () => {
       {error && (
         <div className="error-message">
           <p>{error}</p>
-          <button onClick={fetchVirtualMachines}>Повторить</button>
+          <button onClick={() => fetchVirtualMachines()}>Повторить</button>
         </div>
       )}
 
       {!loading && !error && (
         <ResourceList 
           resources={virtualMachines}
+          hasMore={Boolean(nextPageToken)}
+          onLoadMore={() => fetchVirtualMachines(nextPageToken)}
           columns={columns}
           onView={handleViewVM}
           onEdit={handleEditVM}
@@ -228,6 +342,8 @@ const VirtualMachineListPage = () => {
         <ResourceDetail 
           resource={selectedVM}
           fields={detailFields}
+          onDownload={handleDownloadArtifacts}
+          actions={powerActions}
           onClose={() => {
             setIsViewModalOpen(false);
             setSelectedVM(null);
@@ -240,11 +356,13 @@ const VirtualMachineListPage = () => {
         <ResourceForm 
           resource={selectedVM}
           fields={formFields}
+          dynamicFields={(formData) => parameterFields(templates.find(template => template.id === formData.templateId))}
           onSubmit={handleFormSubmit}
           onCancel={() => {
             setIsEditModalOpen(false);
             setSelectedVM(null);
           }}
+          previewable
           title={selectedVM ? "Редактирование Виртуальной Машины" : "Создание Виртуальной Машины"}
           submitButtonText={selectedVM ? "Сохранить" : "Создать"}
         />
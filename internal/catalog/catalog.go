@@ -0,0 +1,312 @@
+// Package catalog loads the templates shipped as files in a directory. Each
+// *.tmpl file holds one template: a YAML front-matter block with its metadata,
+// followed by the template body.
+//
+//	---
+//	id: vm-template-1
+//	name: Basic VM Template
+//	type: vm
+//	description: Plain text summary of a virtual machine
+//	output_format: yaml
+//	parameters:
+//	  - name: disk_size
+//	    type: integer
+//	    required: true
+//	    min: 10
+//	---
+//	name: {{ .Name }}
+//	disk: {{ .Parameters.disk_size }}
+package catalog
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/aa1ex/paas-provider/internal/convert"
+	"github.com/aa1ex/paas-provider/internal/storage"
+	"github.com/aa1ex/paas-provider/internal/tmplproc"
+	"github.com/aa1ex/paas-provider/internal/validation"
+)
+
+// Extension is the extension of template files in a catalog directory
+const Extension = ".tmpl"
+
+// frontMatterDelimiter opens and closes the front-matter block
+const frontMatterDelimiter = "---"
+
+// File is a template file of a catalog directory
+type File struct {
+	Path     string
+	Template storage.Template
+	Err      error // why the file could not be loaded; Template is unset if not nil
+}
+
+// frontMatter is the metadata block of a template file
+type frontMatter struct {
+	ID              string      `yaml:"id"`
+	Name            string      `yaml:"name"`
+	Type            string      `yaml:"type"`
+	Description     string      `yaml:"description"`
+	OutputFormat    string      `yaml:"output_format"`
+	NormalizeOutput bool        `yaml:"normalize_output"`
+	OutputSchema    yaml.Node   `yaml:"output_schema"` // JSON Schema as a string or inline YAML
+	Parameters      []parameter `yaml:"parameters"`
+	Files           []file      `yaml:"files"`
+}
+
+// parameter declares a template parameter in the front matter
+type parameter struct {
+	Name          string   `yaml:"name"`
+	Type          string   `yaml:"type"`
+	Required      bool     `yaml:"required"`
+	Default       string   `yaml:"default"`
+	AllowedValues []string `yaml:"allowed_values"`
+	Min           *float64 `yaml:"min"`
+	Max           *float64 `yaml:"max"`
+	Description   string   `yaml:"description"`
+}
+
+// file declares an additional file of a template in the front matter
+type file struct {
+	Name         string    `yaml:"name"`
+	OutputFormat string    `yaml:"output_format"`
+	OutputSchema yaml.Node `yaml:"output_schema"`
+	Template     string    `yaml:"template"`
+}
+
+// Load reads the template files of a directory, sorted by path. A file that
+// cannot be parsed, does not validate or reuses the ID of an earlier file is
+// returned with its error and does not stop the others from loading.
+func Load(dir string) ([]File, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+Extension))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates in %s: %w", dir, err)
+	}
+	if _, err := os.Stat(dir); err != nil {
+		return nil, fmt.Errorf("failed to read template directory: %w", err)
+	}
+	sort.Strings(paths)
+
+	files := make([]File, 0, len(paths))
+	seen := make(map[string]string, len(paths))
+	for _, path := range paths {
+		f := File{Path: path}
+		f.Template, f.Err = ReadFile(path)
+		if f.Err == nil {
+			if other, ok := seen[f.Template.ID]; ok {
+				f.Template, f.Err = storage.Template{}, fmt.Errorf("template ID %q is already used by %s", f.Template.ID, other)
+			} else {
+				seen[f.Template.ID] = path
+			}
+		}
+		files = append(files, f)
+	}
+	return files, nil
+}
+
+// ReadFile reads and validates one template file, marking the template as
+// loaded from it
+func ReadFile(path string) (storage.Template, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return storage.Template{}, fmt.Errorf("failed to read template file: %w", err)
+	}
+	name := strings.TrimSuffix(filepath.Base(path), Extension)
+	template, err := Parse(name, content)
+	if err != nil {
+		return storage.Template{}, err
+	}
+	template.CatalogFile = path
+	return template, nil
+}
+
+// Parse parses a template file. The ID defaults to name, the file name
+// without its extension, and the template name defaults to the ID.
+func Parse(name string, content []byte) (storage.Template, error) {
+	header, body, err := splitFrontMatter(content)
+	if err != nil {
+		return storage.Template{}, err
+	}
+
+	var meta frontMatter
+	// Start with the opening delimiter's line so that errors report file lines
+	dec := yaml.NewDecoder(io.MultiReader(strings.NewReader("\n"), bytes.NewReader(header)))
+	dec.KnownFields(true)
+	if err := dec.Decode(&meta); err != nil && !errors.Is(err, io.EOF) {
+		var typeErr *yaml.TypeError
+		if errors.As(err, &typeErr) {
+			return storage.Template{}, fmt.Errorf("invalid front matter: %s", strings.Join(typeErr.Errors, "; "))
+		}
+		return storage.Template{}, fmt.Errorf("invalid front matter: %w", err)
+	}
+
+	template := storage.Template{
+		ID:              meta.ID,
+		Name:            meta.Name,
+		Type:            meta.Type,
+		Description:     meta.Description,
+		RawTemplate:     string(body),
+		OutputFormat:    meta.OutputFormat,
+		NormalizeOutput: meta.NormalizeOutput,
+	}
+	if template.ID == "" {
+		template.ID = name
+	}
+	if template.Name == "" {
+		template.Name = template.ID
+	}
+	if template.OutputSchema, err = schemaText(&meta.OutputSchema); err != nil {
+		return storage.Template{}, fmt.Errorf("invalid output_schema: %w", err)
+	}
+	for _, p := range meta.Parameters {
+		template.Parameters = append(template.Parameters, storage.Parameter{
+			Name:          p.Name,
+			Type:          p.Type,
+			Required:      p.Required,
+			DefaultValue:  p.Default,
+			AllowedValues: p.AllowedValues,
+			Min:           p.Min,
+			Max:           p.Max,
+			Description:   p.Description,
+		})
+	}
+	for i, f := range meta.Files {
+		schema, err := schemaText(&f.OutputSchema)
+		if err != nil {
+			return storage.Template{}, fmt.Errorf("invalid files[%d].output_schema: %w", i, err)
+		}
+		template.Files = append(template.Files, storage.TemplateFile{
+			Name:         f.Name,
+			RawTemplate:  f.Template,
+			OutputFormat: f.OutputFormat,
+			OutputSchema: schema,
+		})
+	}
+
+	if errs := Validate(template); errs.HasErrors() {
+		return storage.Template{}, errs
+	}
+	return template, nil
+}
+
+// Validate checks a template loaded from a file like the API checks templates
+// on create, as far as it does not need other stored templates
+func Validate(template storage.Template) validation.Errors {
+	var errs validation.Errors
+	switch template.Type {
+	case "vm", "kubernetes", "partial":
+	case "":
+		errs.Add("type", "is required")
+	default:
+		errs.Add("type", fmt.Sprintf("unknown template type %q, must be vm, kubernetes or partial", template.Type))
+	}
+	proto := convert.StorageTemplateToProto(template)
+	validation.ValidateParameterSchema(proto.Parameters, &errs)
+	validation.ValidateTemplateFiles(proto.Files, &errs)
+	if errs.HasErrors() {
+		return errs
+	}
+	return tmplproc.ValidateTemplate(template)
+}
+
+// splitFrontMatter splits a template file into its front matter and body. The
+// body starts after the line closing the front matter.
+func splitFrontMatter(content []byte) (header, body []byte, err error) {
+	content = bytes.TrimPrefix(content, []byte("\ufeff")) // byte order mark
+	first, rest, _ := bytes.Cut(content, []byte("\n"))
+	if string(bytes.TrimRight(first, " \t\r")) != frontMatterDelimiter {
+		return nil, nil, fmt.Errorf("missing front matter: the file must start with a %q line", frontMatterDelimiter)
+	}
+
+	for offset := 0; offset < len(rest); {
+		line, _, _ := bytes.Cut(rest[offset:], []byte("\n"))
+		if string(bytes.TrimRight(line, " \t\r")) == frontMatterDelimiter {
+			body = rest[min(offset+len(line)+1, len(rest)):]
+			return rest[:offset], body, nil
+		}
+		offset += len(line) + 1
+	}
+	return nil, nil, fmt.Errorf("unterminated front matter: missing closing %q line", frontMatterDelimiter)
+}
+
+// schemaText returns an output schema given in the front matter as text, either
+// the string itself or inline YAML re-encoded
+func schemaText(node *yaml.Node) (string, error) {
+	switch node.Kind {
+	case 0:
+		return "", nil
+	case yaml.ScalarNode:
+		return node.Value, nil
+	}
+	out, err := yaml.Marshal(node)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// Store creates a catalog template, or updates the stored template if it
+// differs. A template that is already up to date is left alone, so that
+// loading an unchanged catalog does not create new revisions. It returns the
+// stored template and reports whether the storage was changed.
+func Store(store storage.Storage, template storage.Template) (storage.Template, bool, error) {
+	stored, err := store.GetTemplate(template.ID)
+	if errors.Is(err, storage.ErrNotFound) {
+		created, err := store.CreateTemplate(template)
+		if err != nil {
+			return storage.Template{}, false, err
+		}
+		return created, true, nil
+	}
+	if err != nil {
+		return storage.Template{}, false, err
+	}
+	if Unchanged(stored, template) {
+		return stored, false, nil
+	}
+
+	template.ResourceVersion = stored.ResourceVersion
+	updated, err := store.UpdateTemplate(template)
+	if err != nil {
+		return storage.Template{}, false, err
+	}
+	return updated, true, nil
+}
+
+// Unchanged reports whether a stored template has the content of a template
+// loaded from a file, ignoring the fields the storage maintains
+func Unchanged(stored, loaded storage.Template) bool {
+	stored.Revision, stored.ResourceVersion = 0, 0
+	loaded.Revision, loaded.ResourceVersion = 0, 0
+	return reflect.DeepEqual(normalize(stored), normalize(loaded))
+}
+
+// normalize makes empty lists nil, which storage drivers may not preserve
+func normalize(template storage.Template) storage.Template {
+	if len(template.Files) == 0 {
+		template.Files = nil
+	}
+	if len(template.Parameters) == 0 {
+		template.Parameters = nil
+	}
+	parameters := make([]storage.Parameter, len(template.Parameters))
+	for i, parameter := range template.Parameters {
+		if len(parameter.AllowedValues) == 0 {
+			parameter.AllowedValues = nil
+		}
+		parameters[i] = parameter
+	}
+	if template.Parameters != nil {
+		template.Parameters = parameters
+	}
+	return template
+}
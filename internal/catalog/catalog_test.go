@@ -0,0 +1,262 @@
+package catalog_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aa1ex/paas-provider/internal/catalog"
+	"github.com/aa1ex/paas-provider/internal/storage"
+)
+
+func TestParseFrontMatter(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		wantBody string
+		wantErr  string
+	}{
+		{
+			name:     "front matter and body",
+			content:  "---\ntype: vm\n---\n{{ .Name }}\n",
+			wantBody: "{{ .Name }}\n",
+		},
+		{
+			name:     "byte order mark",
+			content:  "\ufeff---\ntype: vm\n---\n{{ .Name }}",
+			wantBody: "{{ .Name }}",
+		},
+		{
+			name:     "CRLF line endings",
+			content:  "---\r\ntype: vm\r\n---\r\n{{ .Name }}\r\n",
+			wantBody: "{{ .Name }}\r\n",
+		},
+		{
+			name:     "trailing spaces after the delimiters",
+			content:  "--- \ntype: vm\n---\t\n{{ .Name }}",
+			wantBody: "{{ .Name }}",
+		},
+		{
+			name:     "closing delimiter at the end of the file",
+			content:  "---\ntype: vm\nfiles:\n  - name: extra\n    template: x\n---",
+			wantBody: "",
+		},
+		{
+			name:     "delimiter in the body",
+			content:  "---\ntype: vm\n---\na\n---\nb",
+			wantBody: "a\n---\nb",
+		},
+		{
+			name:    "missing front matter",
+			content: "{{ .Name }}",
+			wantErr: "missing front matter",
+		},
+		{
+			name:    "text before the front matter",
+			content: "\n---\ntype: vm\n---\n",
+			wantErr: "missing front matter",
+		},
+		{
+			name:    "empty file",
+			content: "",
+			wantErr: "missing front matter",
+		},
+		{
+			name:    "unterminated front matter",
+			content: "---\ntype: vm\n{{ .Name }}",
+			wantErr: "unterminated front matter",
+		},
+		{
+			name:    "longer delimiter does not close",
+			content: "---\ntype: vm\n----\n{{ .Name }}",
+			wantErr: "unterminated front matter",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template, err := catalog.Parse("web", []byte(tt.content))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if template.RawTemplate != tt.wantBody {
+				t.Errorf("body = %q, want %q", template.RawTemplate, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    func(t *testing.T, template storage.Template)
+		wantErr string
+	}{
+		{
+			name:    "defaults from the file name",
+			content: "---\ntype: partial\n---\n{{ .Name }}",
+			want: func(t *testing.T, template storage.Template) {
+				if template.ID != "web" || template.Name != "web" {
+					t.Errorf("ID and name = %q, %q, want both from the file name", template.ID, template.Name)
+				}
+			},
+		},
+		{
+			name: "metadata and parameters",
+			content: `---
+id: vm-1
+name: Web server
+type: vm
+description: A web server
+output_format: yaml
+normalize_output: true
+parameters:
+  - name: disk
+    type: integer
+    required: true
+    default: "10"
+    min: 10
+    max: 100
+  - name: tier
+    type: string
+    allowed_values: [small, large]
+---
+disk: {{ .Parameters.disk }}
+`,
+			want: func(t *testing.T, template storage.Template) {
+				if template.ID != "vm-1" || template.Name != "Web server" || template.Type != "vm" || template.Description != "A web server" ||
+					template.OutputFormat != "yaml" || !template.NormalizeOutput {
+					t.Errorf("metadata = %+v", template)
+				}
+				if len(template.Parameters) != 2 {
+					t.Fatalf("got %d parameters, want 2", len(template.Parameters))
+				}
+				disk := template.Parameters[0]
+				if disk.Name != "disk" || disk.Type != "integer" || !disk.Required || disk.DefaultValue != "10" ||
+					disk.Min == nil || *disk.Min != 10 || disk.Max == nil || *disk.Max != 100 {
+					t.Errorf("disk parameter = %+v", disk)
+				}
+				if tier := template.Parameters[1]; strings.Join(tier.AllowedValues, ",") != "small,large" {
+					t.Errorf("tier allowed values = %v", tier.AllowedValues)
+				}
+			},
+		},
+		{
+			name: "output schema as a string",
+			content: `---
+type: vm
+output_format: json
+output_schema: '{"type": "object"}'
+---
+{}`,
+			want: func(t *testing.T, template storage.Template) {
+				if template.OutputSchema != `{"type": "object"}` {
+					t.Errorf("output schema = %q", template.OutputSchema)
+				}
+			},
+		},
+		{
+			name: "inline output schema",
+			content: `---
+type: vm
+output_format: json
+output_schema:
+  type: object
+  required: [name]
+---
+{"name": "x"}`,
+			want: func(t *testing.T, template storage.Template) {
+				if template.OutputSchema != "type: object\nrequired: [name]\n" {
+					t.Errorf("output schema = %q", template.OutputSchema)
+				}
+			},
+		},
+		{
+			name: "files with inline output schemas",
+			content: `---
+type: vm
+files:
+  - name: user-data
+    output_format: json
+    output_schema:
+      type: object
+    template: '{"name": "{{ .Name }}"}'
+---
+`,
+			want: func(t *testing.T, template storage.Template) {
+				if len(template.Files) != 1 {
+					t.Fatalf("got %d files, want 1", len(template.Files))
+				}
+				file := template.Files[0]
+				if file.Name != "user-data" || file.OutputFormat != "json" || file.OutputSchema != "type: object\n" || file.RawTemplate != `{"name": "{{ .Name }}"}` {
+					t.Errorf("file = %+v", file)
+				}
+			},
+		},
+		{
+			name:    "unknown field",
+			content: "---\ntype: vm\nowner: me\n---\n",
+			wantErr: "line 3: field owner not found",
+		},
+		{
+			name:    "unknown parameter field",
+			content: "---\ntype: vm\nparameters:\n  - name: disk\n    kind: integer\n---\n",
+			wantErr: "line 5: field kind not found",
+		},
+		{
+			name:    "wrong field type",
+			content: "---\ntype: vm\nnormalize_output: maybe\n---\n",
+			wantErr: "line 3: cannot unmarshal",
+		},
+		{
+			name:    "malformed YAML",
+			content: "---\ntype: [vm\n---\n",
+			wantErr: "invalid front matter",
+		},
+		{
+			name:    "missing type",
+			content: "---\nname: web\n---\n",
+			wantErr: "type",
+		},
+		{
+			name:    "unknown type",
+			content: "---\ntype: database\n---\n",
+			wantErr: "unknown template type",
+		},
+		{
+			name:    "field the resources do not supply",
+			content: "---\ntype: vm\n---\n{{ .Region }}",
+			wantErr: "Region",
+		},
+		{
+			name:    "partial with files",
+			content: "---\ntype: partial\nfiles:\n  - name: extra\n    template: x\n---\n",
+			wantErr: "partials cannot have files",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template, err := catalog.Parse("web", []byte(tt.content))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if template.CatalogFile != "" {
+				t.Errorf("Parse set the catalog file to %q", template.CatalogFile)
+			}
+			tt.want(t, template)
+		})
+	}
+}
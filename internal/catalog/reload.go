@@ -0,0 +1,310 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
+	"github.com/aa1ex/paas-provider/internal/tmplproc"
+)
+
+// reloadDelay is how long the directory must be quiet before a change is
+// reloaded, so that an editor saving a file or Kubernetes swapping a ConfigMap
+// volume triggers a single reload
+const reloadDelay = 500 * time.Millisecond
+
+// Status is the state of the catalog after the last reload
+type Status struct {
+	Dir        string
+	Watching   bool // whether changes to the directory are reloaded
+	LastReload time.Time
+	Err        error // why the directory could not be read, if it could not
+	Files      []FileStatus
+}
+
+// FileStatus is the state of one template file after the last reload
+type FileStatus struct {
+	Path       string
+	TemplateID string
+	// Revision is the revision stored from the file. If the file has an error,
+	// it is the last good version, which stays in storage.
+	Revision int64
+	LoadedAt time.Time // when the file was last loaded successfully
+	Err      error     // why the current content of the file was rejected
+}
+
+// Reloader keeps the stored templates in sync with a catalog directory. Files
+// that fail to load are rejected and leave the stored template at its last good
+// version. Templates loaded from a file can only be changed through the file;
+// once it is removed they stay stored and the API manages them.
+type Reloader struct {
+	dir       string
+	store     storage.Storage
+	processor *tmplproc.TemplateProcessor
+
+	reloadMu sync.Mutex // serializes reloads
+
+	mu     sync.RWMutex
+	status Status
+}
+
+// NewReloader creates a reloader for the templates of a directory
+func NewReloader(dir string, store storage.Storage, processor *tmplproc.TemplateProcessor) *Reloader {
+	return &Reloader{
+		dir:       dir,
+		store:     store,
+		processor: processor,
+		status:    Status{Dir: dir},
+	}
+}
+
+// Status returns the state of the catalog after the last reload
+func (r *Reloader) Status() Status {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	status := r.status
+	status.Files = append([]FileStatus(nil), r.status.Files...)
+	return status
+}
+
+// Reload loads the directory and stores the templates that changed. Partials
+// are stored first, so that templates calling a new partial validate, and
+// files rejected because of another file of the same reload, such as a
+// template calling a partial that is renamed with it, are retried once the
+// others are stored. Templates whose file was removed are handed over to the
+// API. It only returns an error if the directory cannot be read.
+func (r *Reloader) Reload() error {
+	r.reloadMu.Lock()
+	defer r.reloadMu.Unlock()
+
+	now := time.Now()
+	files, err := Load(r.dir)
+	if err != nil {
+		r.mu.Lock()
+		r.status.LastReload, r.status.Err = now, err
+		r.mu.Unlock()
+		return err
+	}
+
+	previous := make(map[string]FileStatus)
+	for _, file := range r.Status().Files {
+		previous[file.Path] = file
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].Template.Type == "partial" && files[j].Template.Type != "partial"
+	})
+
+	// Store the files until a pass stores none of the remaining ones
+	type result struct {
+		template storage.Template
+		changed  bool
+		err      error
+	}
+	results := make(map[string]result, len(files))
+	loaded := make(map[string]storage.Template, len(files))
+	for _, file := range files {
+		if file.Err == nil {
+			loaded[file.Template.ID] = file.Template
+		}
+	}
+	for pending := files; len(pending) > 0; {
+		var rejected []File
+		for _, file := range pending {
+			template, changed, err := r.storeFile(file, loaded)
+			results[file.Path] = result{template: template, changed: changed, err: err}
+			if err != nil {
+				rejected = append(rejected, file)
+			}
+		}
+		if len(rejected) == len(pending) {
+			break
+		}
+		pending = rejected
+	}
+
+	statuses := make([]FileStatus, 0, len(files))
+	paths := make(map[string]bool, len(files))
+	for _, file := range files {
+		status := previous[file.Path]
+		status.Path = file.Path
+		paths[file.Path] = true
+
+		result := results[file.Path]
+		if result.err != nil {
+			status.Err = result.err
+			if status.Revision > 0 {
+				log.Printf("Warning: Rejected change to template file %s, keeping revision %d of template %q: %v", file.Path, status.Revision, status.TemplateID, result.err)
+			} else {
+				log.Printf("Warning: Skipping template file %s: %v", file.Path, result.err)
+			}
+			statuses = append(statuses, status)
+			continue
+		}
+
+		template := result.template
+		if result.changed {
+			log.Printf("Loaded template %q from %s as revision %d", template.Name, file.Path, template.Revision)
+		} else if status.Err != nil || status.Revision == 0 {
+			log.Printf("Template %q from %s is up to date", template.Name, file.Path)
+		}
+		status.TemplateID, status.Revision, status.LoadedAt, status.Err = template.ID, template.Revision, now, nil
+		statuses = append(statuses, status)
+	}
+	if err := r.releaseRemoved(paths); err != nil {
+		log.Printf("Warning: Could not hand templates of removed files over to the API: %v", err)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Path < statuses[j].Path })
+	r.mu.Lock()
+	r.status.LastReload, r.status.Err, r.status.Files = now, nil, statuses
+	r.mu.Unlock()
+	return nil
+}
+
+// storeFile checks a loaded template like the API checks an update and stores
+// it. The templates that would call it are judged by their content in loaded,
+// the templates of the files of the reload, if they have a file.
+func (r *Reloader) storeFile(file File, loaded map[string]storage.Template) (storage.Template, bool, error) {
+	if file.Err != nil {
+		return storage.Template{}, false, file.Err
+	}
+	if err := r.processor.ValidatePartials(file.Template); err != nil {
+		return storage.Template{}, false, err
+	}
+
+	stored, err := r.store.GetTemplate(file.Template.ID)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+	case err != nil:
+		return storage.Template{}, false, fmt.Errorf("failed to get template %q: %w", file.Template.ID, err)
+	default:
+		// A partial that is renamed or becomes a regular template must not be
+		// called by other templates
+		if stored.Type == "partial" && (file.Template.Type != "partial" || file.Template.Name != stored.Name) {
+			if err := r.checkNoDependents(stored, loaded); err != nil {
+				return storage.Template{}, false, err
+			}
+		}
+		if stored.CatalogFile == "" && !Unchanged(stored, file.Template) {
+			log.Printf("Warning: Template file %s replaces template %q, which was managed through the API", file.Path, stored.ID)
+		}
+	}
+
+	template, changed, err := Store(r.store, file.Template)
+	if err != nil {
+		return storage.Template{}, false, fmt.Errorf("failed to store template %q: %w", file.Template.ID, err)
+	}
+	if changed {
+		r.processor.Invalidate(template.ID)
+	}
+	return template, changed, nil
+}
+
+// checkNoDependents fails if other templates call a partial, with the
+// templates in loaded replacing the stored ones
+func (r *Reloader) checkNoDependents(partial storage.Template, loaded map[string]storage.Template) error {
+	stored, _, err := r.store.ListTemplates("", storage.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list templates: %w", err)
+	}
+	templates := make([]storage.Template, 0, len(stored)+len(loaded))
+	for _, template := range stored {
+		if _, ok := loaded[template.ID]; !ok {
+			templates = append(templates, template)
+		}
+	}
+	for _, template := range loaded {
+		templates = append(templates, template)
+	}
+
+	dependents := tmplproc.DependentsIn(partial, templates)
+	if len(dependents) == 0 {
+		return nil
+	}
+	names := make([]string, len(dependents))
+	for i, dependent := range dependents {
+		names[i] = strconv.Quote(dependent.Name)
+	}
+	return fmt.Errorf("partial %q is called by templates %s", partial.Name, strings.Join(names, ", "))
+}
+
+// releaseRemoved hands the templates loaded from files that are no longer in
+// the catalog over to the API. They stay stored with their content.
+func (r *Reloader) releaseRemoved(paths map[string]bool) error {
+	templates, _, err := r.store.ListTemplates("", storage.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, template := range templates {
+		if template.CatalogFile == "" || paths[template.CatalogFile] {
+			continue
+		}
+		path := template.CatalogFile
+		template.CatalogFile = ""
+		if _, err := r.store.UpdateTemplate(template); err != nil {
+			return fmt.Errorf("failed to update template %q: %w", template.ID, err)
+		}
+		log.Printf("Template file %s was removed, template %q stays stored and can be changed through the API", path, template.ID)
+	}
+	return nil
+}
+
+// Watch reloads the directory whenever something in it changes, until ctx is
+// done. Watching the directory rather than the files also catches the atomic
+// swap of the "..data" symlink with which Kubernetes updates ConfigMap volumes.
+func (r *Reloader) Watch(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to watch templates: %w", err)
+	}
+	defer watcher.Close()
+	if err := watcher.Add(r.dir); err != nil {
+		return fmt.Errorf("failed to watch %s: %w", r.dir, err)
+	}
+
+	r.setWatching(true)
+	defer r.setWatching(false)
+
+	timer := time.NewTimer(reloadDelay)
+	timer.Stop()
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			// Reading the files changes their access time, not worth a reload
+			if event.Op == fsnotify.Chmod {
+				continue
+			}
+			timer.Reset(reloadDelay)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			log.Printf("Warning: Error watching templates in %s: %v", r.dir, err)
+		case <-timer.C:
+			if err := r.Reload(); err != nil {
+				log.Printf("Warning: Could not reload templates: %v", err)
+			}
+		}
+	}
+}
+
+func (r *Reloader) setWatching(watching bool) {
+	r.mu.Lock()
+	r.status.Watching = watching
+	r.mu.Unlock()
+}
@@ -0,0 +1,140 @@
+package catalog_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aa1ex/paas-provider/internal/catalog"
+	"github.com/aa1ex/paas-provider/internal/storage"
+	"github.com/aa1ex/paas-provider/internal/tmplproc"
+)
+
+// writeFile writes a template file to a catalog directory
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name+catalog.Extension)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	return path
+}
+
+// reload reloads a catalog and returns the status of its files by template file name
+func reload(t *testing.T, r *catalog.Reloader) map[string]catalog.FileStatus {
+	t.Helper()
+	if err := r.Reload(); err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	files := make(map[string]catalog.FileStatus)
+	for _, file := range r.Status().Files {
+		files[strings.TrimSuffix(filepath.Base(file.Path), catalog.Extension)] = file
+	}
+	return files
+}
+
+// getTemplate gets a stored template
+func getTemplate(t *testing.T, store storage.Storage, id string) storage.Template {
+	t.Helper()
+	template, err := store.GetTemplate(id)
+	if err != nil {
+		t.Fatalf("GetTemplate(%s): %v", id, err)
+	}
+	return template
+}
+
+func newReloader(t *testing.T) (*catalog.Reloader, storage.Storage, string) {
+	t.Helper()
+	dir := t.TempDir()
+	store := storage.NewMemoryStorage()
+	return catalog.NewReloader(dir, store, tmplproc.NewTemplateProcessor(store, tmplproc.DefaultLimits)), store, dir
+}
+
+func TestReloadMarksCatalogTemplates(t *testing.T) {
+	r, store, dir := newReloader(t)
+	path := writeFile(t, dir, "web", "---\ntype: vm\n---\n{{ .Name }}")
+
+	// A template managed through the API is taken over by a file with its ID
+	if _, err := store.CreateTemplate(storage.Template{ID: "web", Name: "web", Type: "vm", RawTemplate: "api: {{ .Name }}"}); err != nil {
+		t.Fatalf("CreateTemplate: %v", err)
+	}
+	files := reload(t, r)
+	if files["web"].Err != nil {
+		t.Fatalf("web was rejected: %v", files["web"].Err)
+	}
+	template := getTemplate(t, store, "web")
+	if template.RawTemplate != "{{ .Name }}" || template.CatalogFile != path {
+		t.Errorf("stored template = %q from %q, want the file content from %s", template.RawTemplate, template.CatalogFile, path)
+	}
+
+	// Reloading an unchanged file does not create a revision
+	reload(t, r)
+	if got := getTemplate(t, store, "web"); got.Revision != template.Revision {
+		t.Errorf("unchanged reload stored revision %d, want %d", got.Revision, template.Revision)
+	}
+
+	// Removing the file hands the template over to the API
+	if err := os.Remove(path); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	reload(t, r)
+	template = getTemplate(t, store, "web")
+	if template.CatalogFile != "" || template.RawTemplate != "{{ .Name }}" {
+		t.Errorf("template of a removed file = %q from %q, want the last content managed through the API", template.RawTemplate, template.CatalogFile)
+	}
+}
+
+func TestReloadChecksPartialDependents(t *testing.T) {
+	r, store, dir := newReloader(t)
+	writeFile(t, dir, "labels", "---\ntype: partial\n---\napp={{ .Name }}")
+	writeFile(t, dir, "web", "---\ntype: vm\n---\n{{ include \"labels\" . }}")
+	if files := reload(t, r); files["labels"].Err != nil || files["web"].Err != nil {
+		t.Fatalf("rejected files: labels %v, web %v", files["labels"].Err, files["web"].Err)
+	}
+
+	// A partial still called by a stored template cannot be renamed
+	writeFile(t, dir, "labels", "---\ntype: partial\nname: tags\n---\napp={{ .Name }}")
+	files := reload(t, r)
+	if err := files["labels"].Err; err == nil || !strings.Contains(err.Error(), `is called by templates "web"`) {
+		t.Errorf("renaming a called partial: got error %v", err)
+	}
+	if got := getTemplate(t, store, "labels"); got.Name != "labels" {
+		t.Errorf("rejected rename stored the partial as %q", got.Name)
+	}
+
+	// nor become a regular template
+	writeFile(t, dir, "labels", "---\ntype: vm\n---\napp={{ .Name }}")
+	if files := reload(t, r); files["labels"].Err == nil {
+		t.Errorf("changing the type of a called partial was accepted")
+	}
+
+	// A partial renamed together with the templates calling it is accepted
+	writeFile(t, dir, "labels", "---\ntype: partial\nname: tags\n---\napp={{ .Name }}")
+	writeFile(t, dir, "web", "---\ntype: vm\n---\n{{ include \"tags\" . }}")
+	files = reload(t, r)
+	if files["labels"].Err != nil || files["web"].Err != nil {
+		t.Fatalf("rejected files: labels %v, web %v", files["labels"].Err, files["web"].Err)
+	}
+	if got := getTemplate(t, store, "labels"); got.Name != "tags" {
+		t.Errorf("partial is named %q, want tags", got.Name)
+	}
+
+	// A template calling a partial that does not exist is rejected
+	writeFile(t, dir, "web", "---\ntype: vm\n---\n{{ include \"labels\" . }}")
+	if files := reload(t, r); files["web"].Err == nil {
+		t.Errorf("template calling a missing partial was accepted")
+	}
+}
+
+func TestReloadRetriesFilesDependingOnLaterFiles(t *testing.T) {
+	r, store, dir := newReloader(t)
+	// Files are stored in path order, so outer is tried before the partial it calls
+	writeFile(t, dir, "a-outer", "---\ntype: partial\n---\n[{{ include \"z-inner\" . }}]")
+	writeFile(t, dir, "z-inner", "---\ntype: partial\n---\n{{ .Name }}")
+	files := reload(t, r)
+	if files["a-outer"].Err != nil || files["z-inner"].Err != nil {
+		t.Fatalf("rejected files: a-outer %v, z-inner %v", files["a-outer"].Err, files["z-inner"].Err)
+	}
+	getTemplate(t, store, "a-outer")
+}
@@ -0,0 +1,243 @@
+// Package convert converts templates between their storage and API
+// representations. It sits below the server packages so that the catalog can
+// validate templates loaded from files like the API validates them.
+package convert
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
+	templatev1 "github.com/aa1ex/paas-provider/pkg/api/grpc/template/v1"
+)
+
+// StorageTemplateToProto converts a storage.Template to a templatev1.Template
+func StorageTemplateToProto(template storage.Template) *templatev1.Template {
+	protoTemplate := &templatev1.Template{
+		Id:              template.ID,
+		Name:            template.Name,
+		RawTemplate:     template.RawTemplate,
+		Files:           StorageFilesToProto(template.Files),
+		Revision:        template.Revision,
+		Parameters:      StorageParametersToProto(template.Parameters),
+		OutputFormat:    StorageOutputFormatToProto(template.OutputFormat),
+		NormalizeOutput: template.NormalizeOutput,
+		OutputSchema:    template.OutputSchema,
+		Description:     template.Description,
+		CatalogFile:     template.CatalogFile,
+		ResourceVersion: template.ResourceVersion,
+	}
+
+	// Set the template type
+	switch template.Type {
+	case "vm":
+		protoTemplate.Type = templatev1.Template_TYPE_VM
+	case "kubernetes":
+		protoTemplate.Type = templatev1.Template_TYPE_KUBERNETES
+	case "partial":
+		protoTemplate.Type = templatev1.Template_TYPE_PARTIAL
+	}
+
+	return protoTemplate
+}
+
+// ProtoTemplateToStorage converts a templatev1.Template to a storage.Template
+func ProtoTemplateToStorage(template *templatev1.Template) storage.Template {
+	storageTemplate := storage.Template{
+		ID:              template.Id,
+		Name:            template.Name,
+		RawTemplate:     template.RawTemplate,
+		Files:           ProtoFilesToStorage(template.Files),
+		Revision:        template.Revision,
+		Parameters:      ProtoParametersToStorage(template.Parameters),
+		OutputFormat:    ProtoOutputFormatToStorage(template.OutputFormat),
+		NormalizeOutput: template.NormalizeOutput,
+		OutputSchema:    template.OutputSchema,
+		Description:     template.Description,
+		ResourceVersion: template.ResourceVersion,
+	}
+
+	// Set the template type
+	switch template.Type {
+	case templatev1.Template_TYPE_VM:
+		storageTemplate.Type = "vm"
+	case templatev1.Template_TYPE_KUBERNETES:
+		storageTemplate.Type = "kubernetes"
+	case templatev1.Template_TYPE_PARTIAL:
+		storageTemplate.Type = "partial"
+	}
+
+	return storageTemplate
+}
+
+// StorageTemplateToReference converts a storage.Template to a templatev1.TemplateReference
+func StorageTemplateToReference(template storage.Template) *templatev1.TemplateReference {
+	return &templatev1.TemplateReference{
+		Id:   template.ID,
+		Name: template.Name,
+		Type: StorageTemplateToProto(template).Type,
+	}
+}
+
+// StorageTemplateRevisionToProto converts a storage.TemplateRevision to a templatev1.TemplateRevision
+func StorageTemplateRevisionToProto(revision storage.TemplateRevision) *templatev1.TemplateRevision {
+	protoRevision := &templatev1.TemplateRevision{
+		TemplateId:  revision.TemplateID,
+		Revision:    revision.Revision,
+		Name:        revision.Name,
+		RawTemplate: revision.RawTemplate,
+		Files:       StorageFilesToProto(revision.Files),
+		Parameters:  StorageParametersToProto(revision.Parameters),
+		CreateTime:  timestamppb.New(revision.CreatedAt),
+
+		OutputFormat:    StorageOutputFormatToProto(revision.OutputFormat),
+		NormalizeOutput: revision.NormalizeOutput,
+		OutputSchema:    revision.OutputSchema,
+		Description:     revision.Description,
+	}
+
+	// Set the template type
+	switch revision.Type {
+	case "vm":
+		protoRevision.Type = templatev1.Template_TYPE_VM
+	case "kubernetes":
+		protoRevision.Type = templatev1.Template_TYPE_KUBERNETES
+	case "partial":
+		protoRevision.Type = templatev1.Template_TYPE_PARTIAL
+	}
+
+	return protoRevision
+}
+
+// StorageFilesToProto converts storage.TemplateFile entries to templatev1.TemplateFile
+func StorageFilesToProto(files []storage.TemplateFile) []*templatev1.TemplateFile {
+	protoFiles := make([]*templatev1.TemplateFile, len(files))
+	for i, file := range files {
+		protoFiles[i] = &templatev1.TemplateFile{
+			Name:         file.Name,
+			RawTemplate:  file.RawTemplate,
+			OutputFormat: StorageOutputFormatToProto(file.OutputFormat),
+			OutputSchema: file.OutputSchema,
+		}
+	}
+	return protoFiles
+}
+
+// ProtoFilesToStorage converts templatev1.TemplateFile entries to storage.TemplateFile
+func ProtoFilesToStorage(files []*templatev1.TemplateFile) []storage.TemplateFile {
+	if len(files) == 0 {
+		return nil
+	}
+	storageFiles := make([]storage.TemplateFile, len(files))
+	for i, file := range files {
+		storageFiles[i] = storage.TemplateFile{
+			Name:         file.Name,
+			RawTemplate:  file.RawTemplate,
+			OutputFormat: ProtoOutputFormatToStorage(file.OutputFormat),
+			OutputSchema: file.OutputSchema,
+		}
+	}
+	return storageFiles
+}
+
+// StorageOutputFormatToProto converts a storage output format to a templatev1.OutputFormat
+func StorageOutputFormatToProto(format string) templatev1.OutputFormat {
+	switch format {
+	case "plain":
+		return templatev1.OutputFormat_OUTPUT_FORMAT_PLAIN
+	case "yaml":
+		return templatev1.OutputFormat_OUTPUT_FORMAT_YAML
+	case "json":
+		return templatev1.OutputFormat_OUTPUT_FORMAT_JSON
+	case "toml":
+		return templatev1.OutputFormat_OUTPUT_FORMAT_TOML
+	case "ini":
+		return templatev1.OutputFormat_OUTPUT_FORMAT_INI
+	case "cloud-config":
+		return templatev1.OutputFormat_OUTPUT_FORMAT_CLOUD_CONFIG
+	}
+	return templatev1.OutputFormat_OUTPUT_FORMAT_UNSPECIFIED
+}
+
+// ProtoOutputFormatToStorage converts a templatev1.OutputFormat to a storage output format
+func ProtoOutputFormatToStorage(format templatev1.OutputFormat) string {
+	switch format {
+	case templatev1.OutputFormat_OUTPUT_FORMAT_PLAIN:
+		return "plain"
+	case templatev1.OutputFormat_OUTPUT_FORMAT_YAML:
+		return "yaml"
+	case templatev1.OutputFormat_OUTPUT_FORMAT_JSON:
+		return "json"
+	case templatev1.OutputFormat_OUTPUT_FORMAT_TOML:
+		return "toml"
+	case templatev1.OutputFormat_OUTPUT_FORMAT_INI:
+		return "ini"
+	case templatev1.OutputFormat_OUTPUT_FORMAT_CLOUD_CONFIG:
+		return "cloud-config"
+	}
+	return ""
+}
+
+// StorageParametersToProto converts storage.Parameter declarations to templatev1.Parameter
+func StorageParametersToProto(parameters []storage.Parameter) []*templatev1.Parameter {
+	protoParameters := make([]*templatev1.Parameter, len(parameters))
+	for i, parameter := range parameters {
+		protoParameter := &templatev1.Parameter{
+			Name:          parameter.Name,
+			Required:      parameter.Required,
+			DefaultValue:  parameter.DefaultValue,
+			AllowedValues: parameter.AllowedValues,
+			Min:           parameter.Min,
+			Max:           parameter.Max,
+			Description:   parameter.Description,
+		}
+
+		// Set the parameter type
+		switch parameter.Type {
+		case "string":
+			protoParameter.Type = templatev1.Parameter_TYPE_STRING
+		case "integer":
+			protoParameter.Type = templatev1.Parameter_TYPE_INTEGER
+		case "number":
+			protoParameter.Type = templatev1.Parameter_TYPE_NUMBER
+		case "boolean":
+			protoParameter.Type = templatev1.Parameter_TYPE_BOOLEAN
+		}
+
+		protoParameters[i] = protoParameter
+	}
+	return protoParameters
+}
+
+// ProtoParametersToStorage converts templatev1.Parameter declarations to storage.Parameter
+func ProtoParametersToStorage(parameters []*templatev1.Parameter) []storage.Parameter {
+	if len(parameters) == 0 {
+		return nil
+	}
+	storageParameters := make([]storage.Parameter, len(parameters))
+	for i, parameter := range parameters {
+		storageParameter := storage.Parameter{
+			Name:          parameter.Name,
+			Required:      parameter.Required,
+			DefaultValue:  parameter.DefaultValue,
+			AllowedValues: parameter.AllowedValues,
+			Min:           parameter.Min,
+			Max:           parameter.Max,
+			Description:   parameter.Description,
+		}
+
+		// Set the parameter type
+		switch parameter.Type {
+		case templatev1.Parameter_TYPE_STRING:
+			storageParameter.Type = "string"
+		case templatev1.Parameter_TYPE_INTEGER:
+			storageParameter.Type = "integer"
+		case templatev1.Parameter_TYPE_NUMBER:
+			storageParameter.Type = "number"
+		case templatev1.Parameter_TYPE_BOOLEAN:
+			storageParameter.Type = "boolean"
+		}
+
+		storageParameters[i] = storageParameter
+	}
+	return storageParameters
+}
@@ -0,0 +1,129 @@
+package drift_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aa1ex/paas-provider/internal/drift"
+	"github.com/aa1ex/paas-provider/internal/storage"
+)
+
+// numberedLines returns lines 1 to n, each followed by a line break, with
+// the lines in replaced changed
+func numberedLines(n int, replaced ...int) string {
+	var b strings.Builder
+	for i := 1; i <= n; i++ {
+		line := fmt.Sprint(i)
+		for _, r := range replaced {
+			if r == i {
+				line = "x"
+			}
+		}
+		b.WriteString(line + "\n")
+	}
+	return b.String()
+}
+
+func TestDiffArtifacts(t *testing.T) {
+	tests := []struct {
+		name string
+		from storage.Artifacts
+		to   storage.Artifacts
+		want string
+	}{
+		{
+			name: "unchanged",
+			from: storage.Artifacts{"main": "a\n", "user-data": "b\n"},
+			to:   storage.Artifacts{"main": "a\n", "user-data": "b\n"},
+			want: "",
+		},
+		{
+			name: "added artifact",
+			from: storage.Artifacts{},
+			to:   storage.Artifacts{"user-data": "a\nb\n"},
+			want: "--- /dev/null\n+++ b/user-data\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			name: "removed artifact",
+			from: storage.Artifacts{"user-data": "a\nb\n"},
+			to:   nil,
+			want: "--- a/user-data\n+++ /dev/null\n@@ -1,2 +0,0 @@\n-a\n-b\n",
+		},
+		{
+			name: "added empty artifact",
+			from: storage.Artifacts{},
+			to:   storage.Artifacts{"user-data": ""},
+			want: "--- /dev/null\n+++ b/user-data\n",
+		},
+		{
+			name: "changed line with context",
+			from: storage.Artifacts{"main": numberedLines(9)},
+			to:   storage.Artifacts{"main": numberedLines(9, 5)},
+			want: "--- a/main\n+++ b/main\n@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+x\n 6\n 7\n 8\n",
+		},
+		{
+			name: "added and removed lines",
+			from: storage.Artifacts{"main": "a\nb\nc\n"},
+			to:   storage.Artifacts{"main": "a\nc\nd\n"},
+			want: "--- a/main\n+++ b/main\n@@ -1,3 +1,3 @@\n a\n-b\n c\n+d\n",
+		},
+		{
+			name: "changes close together share a hunk",
+			from: storage.Artifacts{"main": numberedLines(12)},
+			to:   storage.Artifacts{"main": numberedLines(12, 2, 8)},
+			want: "--- a/main\n+++ b/main\n@@ -1,11 +1,11 @@\n 1\n-2\n+x\n 3\n 4\n 5\n 6\n 7\n-8\n+x\n 9\n 10\n 11\n",
+		},
+		{
+			name: "distant changes get separate hunks",
+			from: storage.Artifacts{"main": numberedLines(20)},
+			to:   storage.Artifacts{"main": numberedLines(20, 2, 18)},
+			want: "--- a/main\n+++ b/main\n" +
+				"@@ -1,5 +1,5 @@\n 1\n-2\n+x\n 3\n 4\n 5\n" +
+				"@@ -15,6 +15,6 @@\n 15\n 16\n 17\n-18\n+x\n 19\n 20\n",
+		},
+		{
+			name: "missing line break at the end of a file",
+			from: storage.Artifacts{"main": "a\nb"},
+			to:   storage.Artifacts{"main": "a\nb\n"},
+			want: "--- a/main\n+++ b/main\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+b\n",
+		},
+		{
+			name: "artifacts sorted by name, unchanged ones skipped",
+			from: storage.Artifacts{"main": "a\n", "network": "b\n", "user-data": "c\n"},
+			to:   storage.Artifacts{"main": "a\n", "network": "B\n", "meta-data": "d\n"},
+			want: "--- /dev/null\n+++ b/meta-data\n@@ -0,0 +1 @@\n+d\n" +
+				"--- a/network\n+++ b/network\n@@ -1 +1 @@\n-b\n+B\n" +
+				"--- a/user-data\n+++ /dev/null\n@@ -1 +0,0 @@\n-c\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := drift.DiffArtifacts(tt.from, tt.to); got != tt.want {
+				t.Errorf("got diff\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffArtifactsLargeChange(t *testing.T) {
+	// Inputs differing in more lines than the diff searches through are
+	// diffed as removed and added as a whole
+	var from, to strings.Builder
+	for i := 0; i < 600; i++ {
+		fmt.Fprintf(&from, "a%d\n", i)
+		fmt.Fprintf(&to, "b%d\n", i)
+	}
+	diff := drift.DiffArtifacts(storage.Artifacts{"main": from.String()}, storage.Artifacts{"main": to.String()})
+
+	lines := strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+	if len(lines) != 3+1200 || lines[2] != "@@ -1,600 +1,600 @@" {
+		t.Fatalf("got %d lines with hunk header %q, want 1203 lines with @@ -1,600 +1,600 @@", len(lines), lines[2])
+	}
+	for i, line := range lines[3:] {
+		if want := i < 600; strings.HasPrefix(line, "-") != want {
+			t.Fatalf("line %d of the hunk is %q, want all removed lines before the added ones", i+1, line)
+		}
+	}
+}
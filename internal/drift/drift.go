@@ -0,0 +1,194 @@
+// Package drift detects resources whose stored rendered artifacts no longer
+// match what the current revision of their template produces, for example
+// after a template was updated without re-rendering its resources.
+package drift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"time"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
+	"github.com/aa1ex/paas-provider/internal/tmplproc"
+)
+
+// Filter selects the resources to check. The zero filter selects all resources.
+type Filter struct {
+	VirtualMachineID    string // only this virtual machine
+	KubernetesClusterID string // only this Kubernetes cluster
+	TemplateID          string // only resources using this template
+}
+
+// Result is the drift of one resource
+type Result struct {
+	VirtualMachineID    string // set for virtual machines
+	KubernetesClusterID string // set for Kubernetes clusters
+	Name                string
+	TemplateID          string
+	// TemplateRevision is the revision the stored artifacts were rendered from
+	TemplateRevision int64
+	// CurrentTemplateRevision is the revision rendered for the check, zero if
+	// rendering failed
+	CurrentTemplateRevision int64
+	State                   string // one of the storage.Drift states
+	Diff                    string // unified diff from the stored to the current artifacts
+	Err                     error  // why rendering failed
+}
+
+// Checker re-renders resources in memory and compares the result with their
+// stored rendered artifacts. Each check records the drift state of the
+// resources it looks at.
+type Checker struct {
+	store     storage.Storage
+	processor *tmplproc.TemplateProcessor
+}
+
+// NewChecker creates a drift checker
+func NewChecker(store storage.Storage, processor *tmplproc.TemplateProcessor) *Checker {
+	return &Checker{store: store, processor: processor}
+}
+
+// Check checks the resources selected by a filter, virtual machines first,
+// each sorted by ID. A resource given by ID that does not exist fails with
+// storage.ErrNotFound.
+func (c *Checker) Check(ctx context.Context, filter Filter) ([]Result, error) {
+	var results []Result
+
+	vms, err := c.virtualMachines(filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, vm := range vms {
+		rendered, err := c.processor.ProcessVirtualMachineTemplate(ctx, vm)
+		if errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+		result := Compare(vm.RenderedArtifacts, rendered, err)
+		result.VirtualMachineID, result.Name = vm.ID, vm.Name
+		result.TemplateID, result.TemplateRevision = vm.TemplateID, vm.TemplateRevision
+		results = append(results, result)
+
+		if vm.Drift.State == result.State {
+			continue
+		}
+		log.Printf("Virtual machine %s (%s) is now %s with template %q", vm.ID, vm.Name, result.State, vm.TemplateID)
+		vm.Drift = vm.Drift.WithState(result.State, time.Now())
+		if _, err := c.store.UpdateVirtualMachine(vm); err != nil && !errors.Is(err, storage.ErrConflict) {
+			return nil, fmt.Errorf("failed to store drift of virtual machine %s: %w", vm.ID, err)
+		}
+	}
+
+	clusters, err := c.kubernetesClusters(filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, cluster := range clusters {
+		rendered, err := c.processor.ProcessKubernetesClusterTemplate(ctx, cluster)
+		if errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+		result := Compare(cluster.RenderedArtifacts, rendered, err)
+		result.KubernetesClusterID, result.Name = cluster.ID, cluster.Name
+		result.TemplateID, result.TemplateRevision = cluster.TemplateID, cluster.TemplateRevision
+		results = append(results, result)
+
+		if cluster.Drift.State == result.State {
+			continue
+		}
+		log.Printf("Kubernetes cluster %s (%s) is now %s with template %q", cluster.ID, cluster.Name, result.State, cluster.TemplateID)
+		cluster.Drift = cluster.Drift.WithState(result.State, time.Now())
+		if _, err := c.store.UpdateKubernetesCluster(cluster); err != nil && !errors.Is(err, storage.ErrConflict) {
+			return nil, fmt.Errorf("failed to store drift of Kubernetes cluster %s: %w", cluster.ID, err)
+		}
+	}
+
+	return results, nil
+}
+
+// Run checks all resources every interval until ctx is done
+func (c *Checker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := c.Check(ctx, Filter{}); err != nil && ctx.Err() == nil {
+				log.Printf("Warning: Drift check failed: %v", err)
+			}
+		}
+	}
+}
+
+// Compare compares the stored artifacts of a resource with a fresh render
+func Compare(stored storage.Artifacts, rendered tmplproc.Result, renderErr error) Result {
+	switch {
+	case renderErr != nil:
+		return Result{State: storage.DriftRenderFailed, Err: renderErr}
+	case maps.Equal(stored, rendered.Artifacts):
+		return Result{State: storage.DriftInSync, CurrentTemplateRevision: rendered.Revision}
+	}
+	return Result{
+		State:                   storage.DriftDrifted,
+		CurrentTemplateRevision: rendered.Revision,
+		Diff:                    DiffArtifacts(stored, rendered.Artifacts),
+	}
+}
+
+// virtualMachines returns the virtual machines selected by a filter
+func (c *Checker) virtualMachines(filter Filter) ([]storage.VirtualMachine, error) {
+	if filter.KubernetesClusterID != "" {
+		return nil, nil
+	}
+	if filter.VirtualMachineID != "" {
+		vm, err := c.store.GetVirtualMachine(filter.VirtualMachineID)
+		if err != nil {
+			return nil, err
+		}
+		return matching([]storage.VirtualMachine{vm}, filter.TemplateID, func(vm storage.VirtualMachine) string { return vm.TemplateID }), nil
+	}
+
+	vms, _, err := c.store.ListVirtualMachines(storage.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list virtual machines: %w", err)
+	}
+	return matching(vms, filter.TemplateID, func(vm storage.VirtualMachine) string { return vm.TemplateID }), nil
+}
+
+// kubernetesClusters returns the Kubernetes clusters selected by a filter
+func (c *Checker) kubernetesClusters(filter Filter) ([]storage.KubernetesCluster, error) {
+	if filter.VirtualMachineID != "" {
+		return nil, nil
+	}
+	if filter.KubernetesClusterID != "" {
+		cluster, err := c.store.GetKubernetesCluster(filter.KubernetesClusterID)
+		if err != nil {
+			return nil, err
+		}
+		return matching([]storage.KubernetesCluster{cluster}, filter.TemplateID, func(c storage.KubernetesCluster) string { return c.TemplateID }), nil
+	}
+
+	clusters, _, err := c.store.ListKubernetesClusters(storage.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Kubernetes clusters: %w", err)
+	}
+	return matching(clusters, filter.TemplateID, func(c storage.KubernetesCluster) string { return c.TemplateID }), nil
+}
+
+// matching returns the resources that use a template, or all if templateID is empty
+func matching[T any](resources []T, templateID string, resourceTemplate func(T) string) []T {
+	if templateID == "" {
+		return resources
+	}
+	var matched []T
+	for _, resource := range resources {
+		if resourceTemplate(resource) == templateID {
+			matched = append(matched, resource)
+		}
+	}
+	return matched
+}
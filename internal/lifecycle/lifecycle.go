@@ -0,0 +1,149 @@
+// Package lifecycle enforces the status transitions of virtual machines and
+// Kubernetes clusters and keeps the conditions that explain their status.
+//
+// A resource is created pending and provisioned until it runs. A running
+// resource can be stopped, and a stopped one provisioned again to start it.
+// Running and stopped resources go through updating while they change.
+// Every status but stopped and deleting can fail, and a failed resource can
+// be provisioned, updated or stopped again. Every status but stopping and
+// deleting can be deleted.
+//
+// A running virtual machine can also be restarted, which brings it back to
+// running, or suspended. Like stopping, restarting and suspending cannot be
+// deleted, and like stopped, suspended cannot fail. A suspended virtual
+// machine is provisioned again to resume it, or stopped.
+package lifecycle
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
+)
+
+// ConditionReady is true while a resource is running
+const ConditionReady = "Ready"
+
+// Reasons of status changes
+const (
+	ReasonCreated      = "Created"
+	ReasonProvisioning = "Provisioning"
+	ReasonProvisioned  = "Provisioned"
+	ReasonUpdating     = "Updating"
+	ReasonUpdated      = "Updated"
+	ReasonDeleting     = "Deleting"
+	ReasonFailed       = "Failed"
+	ReasonStarting     = "Starting"
+	ReasonStarted      = "Started"
+	ReasonStopping     = "Stopping"
+	ReasonStopped      = "Stopped"
+	ReasonRestarting   = "Restarting"
+	ReasonRestarted    = "Restarted"
+	ReasonSuspending   = "Suspending"
+	ReasonSuspended    = "Suspended"
+	ReasonRestoring    = "Restoring"
+	ReasonRestored     = "Restored"
+)
+
+// ErrInvalidTransition is returned when a resource cannot change to a status from its current one
+var ErrInvalidTransition = errors.New("status transition is not allowed")
+
+// TransitionError describes a status change that is not allowed. It matches
+// ErrInvalidTransition with errors.Is.
+type TransitionError struct {
+	From string
+	To   string
+}
+
+// Error returns the error message
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("cannot change status from %s to %s", e.From, e.To)
+}
+
+// Is reports whether target is ErrInvalidTransition
+func (e *TransitionError) Is(target error) bool {
+	return target == ErrInvalidTransition
+}
+
+// transitions lists the statuses each status can change to
+var transitions = map[string][]string{
+	storage.StatusPending:      {storage.StatusProvisioning, storage.StatusFailed, storage.StatusDeleting},
+	storage.StatusProvisioning: {storage.StatusRunning, storage.StatusFailed, storage.StatusDeleting},
+	storage.StatusRunning:      {storage.StatusStopping, storage.StatusUpdating, storage.StatusRestarting, storage.StatusSuspending, storage.StatusFailed, storage.StatusDeleting},
+	storage.StatusStopping:     {storage.StatusStopped, storage.StatusFailed},
+	storage.StatusStopped:      {storage.StatusProvisioning, storage.StatusUpdating, storage.StatusDeleting},
+	storage.StatusUpdating:     {storage.StatusRunning, storage.StatusStopped, storage.StatusFailed, storage.StatusDeleting},
+	storage.StatusDeleting:     {storage.StatusFailed},
+	storage.StatusFailed:       {storage.StatusProvisioning, storage.StatusUpdating, storage.StatusStopping, storage.StatusDeleting},
+	storage.StatusRestarting:   {storage.StatusRunning, storage.StatusFailed},
+	storage.StatusSuspending:   {storage.StatusSuspended, storage.StatusFailed},
+	storage.StatusSuspended:    {storage.StatusProvisioning, storage.StatusStopping, storage.StatusDeleting},
+}
+
+// Status returns the status of a resource. Resources stored before statuses
+// existed are running.
+func Status(l storage.Lifecycle) string {
+	if l.Status == "" {
+		return storage.StatusRunning
+	}
+	return l.Status
+}
+
+// Check returns a *TransitionError if a resource cannot change from one status to another
+func Check(from, to string) error {
+	for _, allowed := range transitions[from] {
+		if allowed == to {
+			return nil
+		}
+	}
+	return &TransitionError{From: from, To: to}
+}
+
+// Init puts a new resource in the pending status
+func Init(l *storage.Lifecycle, now time.Time) {
+	l.Status = storage.StatusPending
+	l.Conditions = nil
+	SetCondition(l, storage.Condition{Type: ConditionReady, Reason: ReasonCreated}, now)
+}
+
+// Transition changes the status of a resource and records the change in its
+// Ready condition
+func Transition(l *storage.Lifecycle, to, reason, message string, now time.Time) error {
+	if err := Check(Status(*l), to); err != nil {
+		return err
+	}
+	l.Status = to
+	SetCondition(l, storage.Condition{
+		Type:    ConditionReady,
+		Status:  to == storage.StatusRunning,
+		Reason:  reason,
+		Message: message,
+	}, now)
+	return nil
+}
+
+// SetCondition adds or replaces a condition of a resource. The transition
+// time is kept while the status of the condition stays the same, and the
+// update time while its reason and message do.
+func SetCondition(l *storage.Lifecycle, condition storage.Condition, now time.Time) {
+	// Copy the conditions, which may be shared with the stored resource
+	l.Conditions = append([]storage.Condition(nil), l.Conditions...)
+	for i, existing := range l.Conditions {
+		if existing.Type != condition.Type {
+			continue
+		}
+		condition.LastTransitionTime, condition.LastUpdateTime = existing.LastTransitionTime, existing.LastUpdateTime
+		if existing.Status != condition.Status {
+			condition.LastTransitionTime = now
+		}
+		if existing.Status != condition.Status || existing.Reason != condition.Reason || existing.Message != condition.Message {
+			condition.LastUpdateTime = now
+		}
+		l.Conditions[i] = condition
+		return
+	}
+
+	condition.LastTransitionTime, condition.LastUpdateTime = now, now
+	l.Conditions = append(l.Conditions, condition)
+}
@@ -0,0 +1,163 @@
+package lifecycle_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aa1ex/paas-provider/internal/lifecycle"
+	"github.com/aa1ex/paas-provider/internal/storage"
+)
+
+// statuses are all statuses of resources
+var statuses = []string{
+	storage.StatusPending,
+	storage.StatusProvisioning,
+	storage.StatusRunning,
+	storage.StatusStopping,
+	storage.StatusStopped,
+	storage.StatusUpdating,
+	storage.StatusDeleting,
+	storage.StatusFailed,
+	storage.StatusRestarting,
+	storage.StatusSuspending,
+	storage.StatusSuspended,
+}
+
+func TestCheck(t *testing.T) {
+	// allowed lists every allowed transition, as documented in the package
+	allowed := map[string][]string{
+		storage.StatusPending:      {storage.StatusProvisioning, storage.StatusFailed, storage.StatusDeleting},
+		storage.StatusProvisioning: {storage.StatusRunning, storage.StatusFailed, storage.StatusDeleting},
+		storage.StatusRunning:      {storage.StatusStopping, storage.StatusUpdating, storage.StatusRestarting, storage.StatusSuspending, storage.StatusFailed, storage.StatusDeleting},
+		storage.StatusStopping:     {storage.StatusStopped, storage.StatusFailed},
+		storage.StatusStopped:      {storage.StatusProvisioning, storage.StatusUpdating, storage.StatusDeleting},
+		storage.StatusUpdating:     {storage.StatusRunning, storage.StatusStopped, storage.StatusFailed, storage.StatusDeleting},
+		storage.StatusDeleting:     {storage.StatusFailed},
+		storage.StatusFailed:       {storage.StatusProvisioning, storage.StatusUpdating, storage.StatusStopping, storage.StatusDeleting},
+		storage.StatusRestarting:   {storage.StatusRunning, storage.StatusFailed},
+		storage.StatusSuspending:   {storage.StatusSuspended, storage.StatusFailed},
+		storage.StatusSuspended:    {storage.StatusProvisioning, storage.StatusStopping, storage.StatusDeleting},
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			want := false
+			for _, status := range allowed[from] {
+				want = want || status == to
+			}
+			t.Run(from+" to "+to, func(t *testing.T) {
+				err := lifecycle.Check(from, to)
+				if want {
+					if err != nil {
+						t.Errorf("unexpected error: %v", err)
+					}
+					return
+				}
+				var transitionErr *lifecycle.TransitionError
+				if !errors.As(err, &transitionErr) || transitionErr.From != from || transitionErr.To != to {
+					t.Fatalf("got error %v, want a *TransitionError from %s to %s", err, from, to)
+				}
+				if !errors.Is(err, lifecycle.ErrInvalidTransition) {
+					t.Errorf("error %v does not match ErrInvalidTransition", err)
+				}
+			})
+		}
+	}
+}
+
+func TestTransition(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var l storage.Lifecycle
+	lifecycle.Init(&l, created)
+	if l.Status != storage.StatusPending || len(l.Conditions) != 1 || l.Conditions[0].Reason != lifecycle.ReasonCreated {
+		t.Fatalf("Init = %+v, want pending with a Created Ready condition", l)
+	}
+
+	steps := []struct {
+		to, reason string
+		wantReady  bool
+		wantErr    bool
+	}{
+		{to: storage.StatusRunning, reason: lifecycle.ReasonProvisioned, wantErr: true},
+		{to: storage.StatusProvisioning, reason: lifecycle.ReasonProvisioning},
+		{to: storage.StatusRunning, reason: lifecycle.ReasonProvisioned, wantReady: true},
+		{to: storage.StatusStopped, reason: lifecycle.ReasonStopped, wantErr: true},
+		{to: storage.StatusStopping, reason: lifecycle.ReasonStopping},
+		{to: storage.StatusStopped, reason: lifecycle.ReasonStopped},
+	}
+	for i, step := range steps {
+		now := created.Add(time.Duration(i+1) * time.Minute)
+		before := l
+		err := lifecycle.Transition(&l, step.to, step.reason, "", now)
+		if step.wantErr {
+			if !errors.Is(err, lifecycle.ErrInvalidTransition) {
+				t.Fatalf("Transition(%s to %s) error = %v, want ErrInvalidTransition", before.Status, step.to, err)
+			}
+			if l.Status != before.Status || l.Conditions[0] != before.Conditions[0] {
+				t.Errorf("rejected Transition(%s to %s) changed the lifecycle to %+v", before.Status, step.to, l)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Transition(%s to %s): %v", before.Status, step.to, err)
+		}
+		ready := l.Conditions[0]
+		if l.Status != step.to || ready.Status != step.wantReady || ready.Reason != step.reason || !ready.LastUpdateTime.Equal(now) {
+			t.Errorf("Transition(%s to %s) = %+v, want Ready %v with reason %s updated at %v", before.Status, step.to, l, step.wantReady, step.reason, now)
+		}
+	}
+}
+
+func TestTransitionFromUnsetStatus(t *testing.T) {
+	// Resources stored before statuses existed are running
+	var l storage.Lifecycle
+	if got := lifecycle.Status(l); got != storage.StatusRunning {
+		t.Errorf("Status of an unset lifecycle = %s, want %s", got, storage.StatusRunning)
+	}
+	if err := lifecycle.Transition(&l, storage.StatusStopping, lifecycle.ReasonStopping, "", time.Now()); err != nil {
+		t.Errorf("Transition from an unset status to stopping: %v", err)
+	}
+}
+
+func TestSetCondition(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1, t2, t3 := t0.Add(time.Minute), t0.Add(2*time.Minute), t0.Add(3*time.Minute)
+
+	var l storage.Lifecycle
+	lifecycle.SetCondition(&l, storage.Condition{Type: "Ready", Reason: "A"}, t0)
+	shared := l.Conditions
+
+	tests := []struct {
+		name           string
+		condition      storage.Condition
+		now            time.Time
+		wantTransition time.Time
+		wantUpdate     time.Time
+	}{
+		{name: "unchanged keeps both times", condition: storage.Condition{Type: "Ready", Reason: "A"}, now: t1, wantTransition: t0, wantUpdate: t0},
+		{name: "new reason updates", condition: storage.Condition{Type: "Ready", Reason: "B"}, now: t2, wantTransition: t0, wantUpdate: t2},
+		{name: "new status transitions", condition: storage.Condition{Type: "Ready", Status: true, Reason: "B"}, now: t3, wantTransition: t3, wantUpdate: t3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lifecycle.SetCondition(&l, tt.condition, tt.now)
+			if len(l.Conditions) != 1 {
+				t.Fatalf("got %d conditions, want 1", len(l.Conditions))
+			}
+			got := l.Conditions[0]
+			if !got.LastTransitionTime.Equal(tt.wantTransition) || !got.LastUpdateTime.Equal(tt.wantUpdate) {
+				t.Errorf("times = %v, %v, want %v, %v", got.LastTransitionTime, got.LastUpdateTime, tt.wantTransition, tt.wantUpdate)
+			}
+		})
+	}
+
+	// Other conditions are added, and the conditions seen before are not changed
+	lifecycle.SetCondition(&l, storage.Condition{Type: "Other"}, t3)
+	if len(l.Conditions) != 2 {
+		t.Errorf("got %d conditions, want 2", len(l.Conditions))
+	}
+	if shared[0].Reason != "A" {
+		t.Errorf("SetCondition changed conditions shared with a copy of the lifecycle: %+v", shared)
+	}
+}
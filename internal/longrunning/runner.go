@@ -0,0 +1,419 @@
+// Package longrunning runs the long-running changes to virtual machines and
+// Kubernetes clusters on a bounded pool of workers, and records their state,
+// progress and result as storage operations.
+package longrunning
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/aa1ex/paas-provider/internal/lifecycle"
+	"github.com/aa1ex/paas-provider/internal/server/util"
+	"github.com/aa1ex/paas-provider/internal/storage"
+)
+
+// Result is what a succeeded operation produced
+type Result struct {
+	VirtualMachine    *storage.VirtualMachine
+	KubernetesCluster *storage.KubernetesCluster
+}
+
+// Work is the work of an operation. It reports the percentage of the work
+// done with progress, and returns its result or an error. The code of a
+// connect error is recorded on the operation.
+type Work func(ctx context.Context, progress func(percent int32)) (Result, error)
+
+// Resumer rebuilds the work of an operation that was pending or running when
+// the server stopped, from the operation and the stored resource. It returns
+// an error if the work cannot be resumed.
+type Resumer func(op storage.Operation) (Work, error)
+
+// ErrClosed is returned when an operation is started after the runner closed
+var ErrClosed = errors.New("operation runner is closed")
+
+// task is an operation started by the runner that has not finished yet
+type task struct {
+	cancel context.CancelFunc
+	done   chan struct{} // closed when the operation finished
+}
+
+// Runner runs operations. At most the configured number of workers run at a
+// time; the other operations stay pending until a worker is free.
+type Runner struct {
+	store   storage.Storage
+	ctx     context.Context
+	workers chan struct{}
+	closing chan struct{} // closed when the runner stops taking work
+
+	// mu guards tasks, closed and resumers, and serializes the updates of
+	// operations
+	mu       sync.Mutex
+	tasks    map[string]*task
+	closed   bool
+	resumers map[string]Resumer
+	wg       sync.WaitGroup
+}
+
+// NewRunner creates a runner with the given number of workers. Operations are
+// cancelled when ctx is done.
+func NewRunner(ctx context.Context, store storage.Storage, workers int) *Runner {
+	if workers < 1 {
+		workers = 1
+	}
+	return &Runner{
+		store:    store,
+		ctx:      ctx,
+		workers:  make(chan struct{}, workers),
+		closing:  make(chan struct{}),
+		tasks:    make(map[string]*task),
+		resumers: make(map[string]Resumer),
+	}
+}
+
+// Start stores a new pending operation and runs its work in the background.
+// It returns ErrClosed once the runner closed.
+func (r *Runner) Start(op storage.Operation, work Work) (storage.Operation, error) {
+	op.ID = util.GenerateID()
+	op.State = storage.OperationPending
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return storage.Operation{}, ErrClosed
+	}
+	stored, err := r.store.CreateOperation(op)
+	if err != nil {
+		return storage.Operation{}, err
+	}
+	r.enqueue(stored.ID, work)
+	return stored, nil
+}
+
+// enqueue runs the work of a stored operation in the background. It must be
+// called with mu held while the runner is open.
+func (r *Runner) enqueue(id string, work Work) {
+	ctx, cancel := context.WithCancel(r.ctx)
+	t := &task{cancel: cancel, done: make(chan struct{})}
+	r.tasks[id] = t
+	r.wg.Add(1)
+	go r.run(ctx, id, t, work)
+}
+
+// run waits for a worker and runs the work of an operation. An operation
+// cancelled while pending still runs its work, with a cancelled context, so
+// the work can leave its resource in a consistent status. An operation still
+// pending when the runner closes stays pending, for Recover to resume it.
+func (r *Runner) run(ctx context.Context, id string, t *task, work Work) {
+	defer r.wg.Done()
+	defer func() {
+		r.mu.Lock()
+		delete(r.tasks, id)
+		r.mu.Unlock()
+		t.cancel()
+		close(t.done)
+	}()
+
+	select {
+	case r.workers <- struct{}{}:
+		defer func() { <-r.workers }()
+	case <-ctx.Done():
+	case <-r.closing:
+		return
+	}
+	select {
+	case <-r.closing:
+		return
+	default:
+	}
+
+	r.update(id, func(op *storage.Operation) bool {
+		op.State = storage.OperationRunning
+		return true
+	})
+
+	result, err := work(ctx, func(percent int32) {
+		percent = min(max(percent, 0), 100)
+		r.update(id, func(op *storage.Operation) bool {
+			if op.Progress == percent {
+				return false
+			}
+			op.Progress = percent
+			return true
+		})
+	})
+
+	r.update(id, func(op *storage.Operation) bool {
+		op.EndedAt = time.Now().UTC()
+		switch {
+		case err == nil:
+			op.State = storage.OperationSucceeded
+			op.Progress = 100
+			op.VirtualMachine, op.KubernetesCluster = result.VirtualMachine, result.KubernetesCluster
+			return true
+		case op.CancelRequested:
+			op.State = storage.OperationCancelled
+			op.ErrorCode = connect.CodeCanceled.String()
+		default:
+			op.State = storage.OperationFailed
+			op.ErrorCode = connect.CodeOf(err).String()
+		}
+		op.ErrorMessage = err.Error()
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			op.ErrorMessage = connectErr.Message()
+		}
+		return true
+	})
+}
+
+// update changes a stored operation with change, which reports whether it
+// changed anything. Failures are logged, as the work goes on regardless.
+func (r *Runner) update(id string, change func(op *storage.Operation) bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	op, err := r.store.GetOperation(id)
+	if err == nil && change(&op) {
+		_, err = r.store.UpdateOperation(op)
+	}
+	if err != nil {
+		log.Printf("Warning: Could not update operation %s: %v", id, err)
+	}
+}
+
+// Cancel requests the cancellation of an operation and returns it. The work
+// of the operation decides how quickly it stops. Cancelling an operation that
+// is done has no effect.
+func (r *Runner) Cancel(id string) (storage.Operation, error) {
+	r.mu.Lock()
+	t, running := r.tasks[id]
+	r.mu.Unlock()
+	if running {
+		r.update(id, func(op *storage.Operation) bool {
+			if op.Done() || op.CancelRequested {
+				return false
+			}
+			op.CancelRequested = true
+			return true
+		})
+		t.cancel()
+	}
+	return r.store.GetOperation(id)
+}
+
+// Wait waits until an operation is done, the timeout expires or ctx is done,
+// and returns the operation
+func (r *Runner) Wait(ctx context.Context, id string, timeout time.Duration) (storage.Operation, error) {
+	r.mu.Lock()
+	t, running := r.tasks[id]
+	r.mu.Unlock()
+	if running {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		select {
+		case <-t.done:
+		case <-timer.C:
+		case <-ctx.Done():
+			return storage.Operation{}, ctx.Err()
+		}
+	}
+	return r.store.GetOperation(id)
+}
+
+// Close stops taking work and waits until the running operations finished.
+// Pending operations stay pending, for Recover to resume them when the server
+// starts again. The operations still running after grace are cancelled.
+func (r *Runner) Close(grace time.Duration) {
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.closing)
+	}
+	r.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+	timer := time.NewTimer(grace)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return
+	case <-timer.C:
+	}
+
+	r.mu.Lock()
+	for _, t := range r.tasks {
+		t.cancel()
+	}
+	r.mu.Unlock()
+	<-done
+}
+
+// Resume registers how the operations of the given types are resumed after a
+// server restart
+func (r *Runner) Resume(resume Resumer, types ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, typ := range types {
+		r.resumers[typ] = resume
+	}
+}
+
+// Recover resumes the operations that were pending or running when the server
+// stopped. Their work is lost, so it is rebuilt by the resumer of their type
+// and runs again from the start. The operations that cannot be resumed, such
+// as those whose cancellation was requested, fail instead: their resources
+// are moved from the transitional status the operation left them in to the
+// failed status, from which they can be provisioned, updated or deleted
+// again.
+func (r *Runner) Recover() error {
+	ops, _, err := r.store.ListOperations(storage.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list operations: %w", err)
+	}
+	for _, op := range ops {
+		if op.Done() {
+			continue
+		}
+		work, err := r.resumable(op)
+		if err == nil {
+			if err := r.requeue(op, work); err != nil {
+				return fmt.Errorf("failed to resume operation %s: %w", op.ID, err)
+			}
+			log.Printf("Operation %s (%s) was resumed after a server restart", op.ID, op.Type)
+			continue
+		}
+
+		log.Printf("Warning: Operation %s (%s) cannot be resumed: %v", op.ID, op.Type, err)
+		if err := r.failResource(op); err != nil {
+			return fmt.Errorf("failed to fail the resource of operation %s: %w", op.ID, err)
+		}
+		op.State = storage.OperationFailed
+		op.ErrorCode = connect.CodeAborted.String()
+		op.ErrorMessage = interruptedMessage
+		op.EndedAt = time.Now().UTC()
+		if _, err := r.store.UpdateOperation(op); err != nil {
+			return fmt.Errorf("failed to fail operation %s: %w", op.ID, err)
+		}
+		log.Printf("Operation %s (%s) was interrupted by a server restart", op.ID, op.Type)
+	}
+	return nil
+}
+
+// resumable rebuilds the work of an interrupted operation with the resumer of
+// its type
+func (r *Runner) resumable(op storage.Operation) (Work, error) {
+	if op.CancelRequested {
+		return nil, errors.New("its cancellation was requested")
+	}
+	r.mu.Lock()
+	resume, ok := r.resumers[op.Type]
+	r.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("operations of type %q cannot be resumed", op.Type)
+	}
+	return resume(op)
+}
+
+// requeue stores an interrupted operation as pending again and runs its work
+// in the background
+func (r *Runner) requeue(op storage.Operation, work Work) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return ErrClosed
+	}
+	op.State = storage.OperationPending
+	op.Progress = 0
+	if _, err := r.store.UpdateOperation(op); err != nil {
+		return err
+	}
+	r.enqueue(op.ID, work)
+	return nil
+}
+
+// interruptedMessage explains why an operation and its resource failed
+const interruptedMessage = "interrupted by a server restart"
+
+// failResource moves the resource of an interrupted operation to the failed
+// status. Resources that are gone or already in a stable status, because the
+// work finished just before the server stopped, are left alone.
+func (r *Runner) failResource(op storage.Operation) error {
+	switch {
+	case op.VirtualMachineID != "":
+		vm, err := r.store.GetVirtualMachine(op.VirtualMachineID)
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !failInterrupted(&vm.Lifecycle) {
+			return nil
+		}
+		_, err = r.store.UpdateVirtualMachine(vm)
+		return err
+	case op.KubernetesClusterID != "":
+		cluster, err := r.store.GetKubernetesCluster(op.KubernetesClusterID)
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !failInterrupted(&cluster.Lifecycle) {
+			return nil
+		}
+		_, err = r.store.UpdateKubernetesCluster(cluster)
+		return err
+	}
+	return nil
+}
+
+// failInterrupted moves a resource in a transitional status to the failed
+// status, and reports whether it did
+func failInterrupted(l *storage.Lifecycle) bool {
+	switch lifecycle.Status(*l) {
+	case storage.StatusRunning, storage.StatusStopped, storage.StatusSuspended, storage.StatusFailed:
+		return false
+	}
+	return lifecycle.Transition(l, storage.StatusFailed, lifecycle.ReasonFailed, interruptedMessage, time.Now()) == nil
+}
+
+// Prune deletes the operations that finished more than retention ago, every
+// interval until ctx is done
+func (r *Runner) Prune(ctx context.Context, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		ops, _, err := r.store.ListOperations(storage.ListOptions{})
+		if err != nil {
+			log.Printf("Warning: Could not prune operations: %v", err)
+			continue
+		}
+		cutoff := time.Now().Add(-retention)
+		for _, op := range ops {
+			if !op.Done() || op.EndedAt.After(cutoff) {
+				continue
+			}
+			if err := r.store.DeleteOperation(op.ID); err != nil && !errors.Is(err, storage.ErrNotFound) {
+				log.Printf("Warning: Could not prune operation %s: %v", op.ID, err)
+			}
+		}
+	}
+}
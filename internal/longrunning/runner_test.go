@@ -0,0 +1,473 @@
+package longrunning_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/aa1ex/paas-provider/internal/lifecycle"
+	"github.com/aa1ex/paas-provider/internal/longrunning"
+	"github.com/aa1ex/paas-provider/internal/storage"
+)
+
+// newRunner creates a runner on an empty memory storage, closed when the test
+// ends
+func newRunner(t *testing.T, workers int) (*longrunning.Runner, storage.Storage) {
+	t.Helper()
+	s := storage.NewMemoryStorage()
+	r := longrunning.NewRunner(context.Background(), s, workers)
+	t.Cleanup(func() { r.Close(0) })
+	return r, s
+}
+
+// blockingWork returns work that runs until release is closed or its context
+// is done
+func blockingWork(release <-chan struct{}) longrunning.Work {
+	return func(ctx context.Context, _ func(int32)) (longrunning.Result, error) {
+		select {
+		case <-release:
+			return longrunning.Result{}, nil
+		case <-ctx.Done():
+			return longrunning.Result{}, ctx.Err()
+		}
+	}
+}
+
+// waitState waits until an operation is in state
+func waitState(t *testing.T, s storage.Storage, id, state string) storage.Operation {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for {
+		op, err := s.GetOperation(id)
+		if err != nil {
+			t.Fatalf("GetOperation(%s): %v", id, err)
+		}
+		if op.State == state {
+			return op
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("operation %s is %s, want %s", id, op.State, state)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestRunnerBoundsWorkers(t *testing.T) {
+	r, s := newRunner(t, 2)
+
+	release := make(chan struct{})
+	var running, most atomic.Int32
+	work := func(ctx context.Context, progress func(int32)) (longrunning.Result, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			m := most.Load()
+			if n <= m || most.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		return blockingWork(release)(ctx, progress)
+	}
+
+	var ids []string
+	for i := 0; i < 5; i++ {
+		op, err := r.Start(storage.Operation{Type: storage.OperationCreateVirtualMachine}, work)
+		if err != nil {
+			t.Fatalf("Start: %v", err)
+		}
+		ids = append(ids, op.ID)
+	}
+
+	// Two operations run, the others wait for a worker
+	countStates := func() map[string]int {
+		ops, _, err := s.ListOperations(storage.ListOptions{})
+		if err != nil {
+			t.Fatalf("ListOperations: %v", err)
+		}
+		states := make(map[string]int)
+		for _, op := range ops {
+			states[op.State]++
+		}
+		return states
+	}
+	deadline := time.Now().Add(time.Second)
+	for countStates()[storage.OperationRunning] < 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("operations are %v, want 2 running", countStates())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if states := countStates(); states[storage.OperationRunning] != 2 || states[storage.OperationPending] != 3 {
+		t.Errorf("operations are %v, want 2 running and 3 pending", states)
+	}
+
+	close(release)
+	for _, id := range ids {
+		op, err := r.Wait(context.Background(), id, time.Second)
+		if err != nil {
+			t.Fatalf("Wait(%s): %v", id, err)
+		}
+		if op.State != storage.OperationSucceeded || op.Progress != 100 {
+			t.Errorf("operation %s is %s at %d%%, want %s at 100%%", id, op.State, op.Progress, storage.OperationSucceeded)
+		}
+	}
+	if got := most.Load(); got != 2 {
+		t.Errorf("%d operations ran at a time, want 2", got)
+	}
+}
+
+func TestRunnerCancel(t *testing.T) {
+	r, s := newRunner(t, 1)
+
+	op, err := r.Start(storage.Operation{Type: storage.OperationCreateVirtualMachine}, blockingWork(nil))
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	waitState(t, s, op.ID, storage.OperationRunning)
+
+	if op, err = r.Cancel(op.ID); err != nil {
+		t.Fatalf("Cancel: %v", err)
+	}
+	if !op.CancelRequested {
+		t.Errorf("Cancel returned an operation without the cancellation requested")
+	}
+	op, err = r.Wait(context.Background(), op.ID, time.Second)
+	if err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if op.State != storage.OperationCancelled || op.ErrorCode != connect.CodeCanceled.String() {
+		t.Errorf("cancelled operation is %s with code %q, want %s with code %q", op.State, op.ErrorCode, storage.OperationCancelled, connect.CodeCanceled)
+	}
+
+	// Cancelling an operation that is done has no effect
+	again, err := r.Cancel(op.ID)
+	if err != nil {
+		t.Fatalf("Cancel(done): %v", err)
+	}
+	if again.ResourceVersion != op.ResourceVersion {
+		t.Errorf("Cancel changed a done operation: %+v", again)
+	}
+	if _, err := r.Cancel("missing"); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("Cancel(missing) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestRunnerCancelPending(t *testing.T) {
+	r, s := newRunner(t, 1)
+
+	release := make(chan struct{})
+	defer close(release)
+	running, err := r.Start(storage.Operation{Type: storage.OperationCreateVirtualMachine}, blockingWork(release))
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	waitState(t, s, running.ID, storage.OperationRunning)
+
+	// The pending operation still runs its work, with a cancelled context
+	var ran atomic.Bool
+	op, err := r.Start(storage.Operation{Type: storage.OperationCreateVirtualMachine}, func(ctx context.Context, _ func(int32)) (longrunning.Result, error) {
+		ran.Store(true)
+		return longrunning.Result{}, ctx.Err()
+	})
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if _, err := r.Cancel(op.ID); err != nil {
+		t.Fatalf("Cancel: %v", err)
+	}
+	waitState(t, s, op.ID, storage.OperationCancelled)
+	if !ran.Load() {
+		t.Errorf("the work of a cancelled pending operation did not run")
+	}
+}
+
+func TestRunnerWait(t *testing.T) {
+	r, _ := newRunner(t, 1)
+
+	release := make(chan struct{})
+	op, err := r.Start(storage.Operation{Type: storage.OperationCreateVirtualMachine}, func(ctx context.Context, progress func(int32)) (longrunning.Result, error) {
+		progress(40)
+		return blockingWork(release)(ctx, progress)
+	})
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	// Waiting returns the operation as it is once the timeout expires
+	op, err = r.Wait(context.Background(), op.ID, 20*time.Millisecond)
+	if err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if op.Done() {
+		t.Errorf("Wait returned a done operation before its work finished: %+v", op)
+	}
+
+	// or ctx is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := r.Wait(ctx, op.ID, time.Second); !errors.Is(err, context.Canceled) {
+		t.Errorf("Wait with a done context error = %v, want %v", err, context.Canceled)
+	}
+
+	close(release)
+	if op, err = r.Wait(context.Background(), op.ID, time.Second); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if op.State != storage.OperationSucceeded {
+		t.Errorf("operation is %s, want %s", op.State, storage.OperationSucceeded)
+	}
+
+	// Waiting for a done operation returns it at once
+	if op, err = r.Wait(context.Background(), op.ID, time.Hour); err != nil || op.State != storage.OperationSucceeded {
+		t.Errorf("Wait(done) = %s, %v, want %s", op.State, err, storage.OperationSucceeded)
+	}
+	if _, err := r.Wait(context.Background(), "missing", time.Second); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("Wait(missing) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestRunnerCloseDrains(t *testing.T) {
+	s := storage.NewMemoryStorage()
+	r := longrunning.NewRunner(context.Background(), s, 1)
+
+	release := make(chan struct{})
+	running, err := r.Start(storage.Operation{Type: storage.OperationCreateVirtualMachine}, blockingWork(release))
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	waitState(t, s, running.ID, storage.OperationRunning)
+	var ran atomic.Bool
+	pending, err := r.Start(storage.Operation{Type: storage.OperationCreateVirtualMachine}, func(context.Context, func(int32)) (longrunning.Result, error) {
+		ran.Store(true)
+		return longrunning.Result{}, nil
+	})
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	var closed sync.WaitGroup
+	closed.Add(1)
+	go func() {
+		defer closed.Done()
+		r.Close(time.Second)
+	}()
+
+	// The runner stops taking work while the running operation finishes
+	deadline := time.Now().Add(time.Second)
+	for {
+		if _, err := r.Start(storage.Operation{Type: storage.OperationCreateVirtualMachine}, blockingWork(nil)); errors.Is(err, longrunning.ErrClosed) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Start after Close did not return ErrClosed")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	close(release)
+	closed.Wait()
+
+	if op, _ := s.GetOperation(running.ID); op.State != storage.OperationSucceeded {
+		t.Errorf("running operation is %s after Close, want %s", op.State, storage.OperationSucceeded)
+	}
+	if op, _ := s.GetOperation(pending.ID); op.State != storage.OperationPending || ran.Load() {
+		t.Errorf("pending operation is %s after Close and its work ran: %v, want it left %s", op.State, ran.Load(), storage.OperationPending)
+	}
+}
+
+func TestRunnerCloseCancelsAfterGrace(t *testing.T) {
+	s := storage.NewMemoryStorage()
+	r := longrunning.NewRunner(context.Background(), s, 1)
+
+	op, err := r.Start(storage.Operation{Type: storage.OperationCreateVirtualMachine}, blockingWork(nil))
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	waitState(t, s, op.ID, storage.OperationRunning)
+
+	start := time.Now()
+	r.Close(20 * time.Millisecond)
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("Close returned after %v, before the grace period", elapsed)
+	}
+	if op, _ = s.GetOperation(op.ID); op.State != storage.OperationFailed {
+		t.Errorf("operation is %s after the grace period, want %s", op.State, storage.OperationFailed)
+	}
+}
+
+func TestRunnerRecover(t *testing.T) {
+	tests := []struct {
+		name            string
+		op              storage.Operation
+		vmStatus        string
+		wantState       string
+		wantVMStatus    string
+		wantErrorCode   connect.Code
+		wantResumedWork bool
+	}{
+		{
+			name:            "running operation is resumed",
+			op:              storage.Operation{Type: "resumable", State: storage.OperationRunning, Progress: 50},
+			vmStatus:        storage.StatusProvisioning,
+			wantState:       storage.OperationSucceeded,
+			wantVMStatus:    storage.StatusProvisioning,
+			wantResumedWork: true,
+		},
+		{
+			name:            "pending operation is resumed",
+			op:              storage.Operation{Type: "resumable", State: storage.OperationPending},
+			vmStatus:        storage.StatusProvisioning,
+			wantState:       storage.OperationSucceeded,
+			wantVMStatus:    storage.StatusProvisioning,
+			wantResumedWork: true,
+		},
+		{
+			name:          "operation without a resumer fails",
+			op:            storage.Operation{Type: "unknown", State: storage.OperationRunning},
+			vmStatus:      storage.StatusProvisioning,
+			wantState:     storage.OperationFailed,
+			wantVMStatus:  storage.StatusFailed,
+			wantErrorCode: connect.CodeAborted,
+		},
+		{
+			name:          "operation the resumer rejects fails",
+			op:            storage.Operation{Type: "rejected", State: storage.OperationRunning},
+			vmStatus:      storage.StatusProvisioning,
+			wantState:     storage.OperationFailed,
+			wantVMStatus:  storage.StatusFailed,
+			wantErrorCode: connect.CodeAborted,
+		},
+		{
+			name:          "operation whose cancellation was requested fails",
+			op:            storage.Operation{Type: "resumable", State: storage.OperationRunning, CancelRequested: true},
+			vmStatus:      storage.StatusProvisioning,
+			wantState:     storage.OperationFailed,
+			wantVMStatus:  storage.StatusFailed,
+			wantErrorCode: connect.CodeAborted,
+		},
+		{
+			name:          "resource in a stable status is left alone",
+			op:            storage.Operation{Type: "unknown", State: storage.OperationRunning},
+			vmStatus:      storage.StatusRunning,
+			wantState:     storage.OperationFailed,
+			wantVMStatus:  storage.StatusRunning,
+			wantErrorCode: connect.CodeAborted,
+		},
+		{
+			name:         "done operation is left alone",
+			op:           storage.Operation{Type: "resumable", State: storage.OperationSucceeded},
+			vmStatus:     storage.StatusProvisioning,
+			wantState:    storage.OperationSucceeded,
+			wantVMStatus: storage.StatusProvisioning,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, s := newRunner(t, 1)
+
+			vm := storage.VirtualMachine{ID: "vm1", Name: "web"}
+			lifecycle.Init(&vm.Lifecycle, time.Now())
+			if err := lifecycle.Transition(&vm.Lifecycle, storage.StatusProvisioning, lifecycle.ReasonProvisioning, "", time.Now()); err != nil {
+				t.Fatalf("Transition: %v", err)
+			}
+			if tt.vmStatus == storage.StatusRunning {
+				if err := lifecycle.Transition(&vm.Lifecycle, storage.StatusRunning, lifecycle.ReasonProvisioned, "", time.Now()); err != nil {
+					t.Fatalf("Transition: %v", err)
+				}
+			}
+			if _, err := s.CreateVirtualMachine(vm); err != nil {
+				t.Fatalf("CreateVirtualMachine: %v", err)
+			}
+			tt.op.ID, tt.op.VirtualMachineID = "op1", vm.ID
+			if _, err := s.CreateOperation(tt.op); err != nil {
+				t.Fatalf("CreateOperation: %v", err)
+			}
+
+			var resumed atomic.Bool
+			r.Resume(func(op storage.Operation) (longrunning.Work, error) {
+				return func(context.Context, func(int32)) (longrunning.Result, error) {
+					resumed.Store(true)
+					return longrunning.Result{}, nil
+				}, nil
+			}, "resumable")
+			r.Resume(func(op storage.Operation) (longrunning.Work, error) {
+				return nil, errors.New("cannot resume")
+			}, "rejected")
+
+			if err := r.Recover(); err != nil {
+				t.Fatalf("Recover: %v", err)
+			}
+			op, err := r.Wait(context.Background(), tt.op.ID, time.Second)
+			if err != nil {
+				t.Fatalf("Wait: %v", err)
+			}
+			if op.State != tt.wantState {
+				t.Errorf("operation is %s, want %s", op.State, tt.wantState)
+			}
+			if tt.wantErrorCode != 0 && op.ErrorCode != tt.wantErrorCode.String() {
+				t.Errorf("operation error code = %q, want %q", op.ErrorCode, tt.wantErrorCode)
+			}
+			if resumed.Load() != tt.wantResumedWork {
+				t.Errorf("resumed work ran: %v, want %v", resumed.Load(), tt.wantResumedWork)
+			}
+			if vm, _ := s.GetVirtualMachine(vm.ID); vm.Status != tt.wantVMStatus {
+				t.Errorf("virtual machine is %s, want %s", vm.Status, tt.wantVMStatus)
+			}
+		})
+	}
+}
+
+func TestRunnerPrune(t *testing.T) {
+	r, s := newRunner(t, 1)
+
+	now := time.Now().UTC()
+	for _, op := range []storage.Operation{
+		{ID: "old", State: storage.OperationSucceeded, EndedAt: now.Add(-2 * time.Hour)},
+		{ID: "old-failed", State: storage.OperationFailed, EndedAt: now.Add(-2 * time.Hour)},
+		{ID: "recent", State: storage.OperationSucceeded, EndedAt: now},
+		{ID: "running", State: storage.OperationRunning},
+	} {
+		if _, err := s.CreateOperation(op); err != nil {
+			t.Fatalf("CreateOperation(%s): %v", op.ID, err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.Prune(ctx, time.Hour, 5*time.Millisecond)
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		ops, _, err := s.ListOperations(storage.ListOptions{OrderBy: "id"})
+		if err != nil {
+			t.Fatalf("ListOperations: %v", err)
+		}
+		if len(ops) == 2 {
+			if ops[0].ID != "recent" || ops[1].ID != "running" {
+				t.Errorf("operations after pruning = %s, %s, want recent, running", ops[0].ID, ops[1].ID)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d operations left, want 2", len(ops))
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// Pruning stops with ctx
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("Prune did not return after ctx was done")
+	}
+}
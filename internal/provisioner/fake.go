@@ -0,0 +1,207 @@
+package provisioner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
+)
+
+// fakeSteps is the number of progress reports of a fake operation
+const fakeSteps = 10
+
+// ErrInjectedFailure is returned by the fake driver for the operations it is configured to fail
+var ErrInjectedFailure = errors.New("injected failure")
+
+// Fake is an in-process driver that provisions nothing. Each operation takes
+// the configured latency and then fails at random with the failure rate.
+// Every operation but deletion on a resource whose name matches one of the
+// fail patterns always fails, while deleting it succeeds so it can be cleaned
+// up. A forced stop skips the latency. This lets the lifecycle of resources
+// be exercised without a real infrastructure.
+type Fake struct {
+	latency     time.Duration
+	failureRate float64
+	failNames   []string
+
+	mu     sync.Mutex
+	random *rand.Rand
+	states map[string]string // status of the resources it provisioned, by kind and ID
+}
+
+// NewFake creates a fake driver
+func NewFake(config DriverConfig) (*Fake, error) {
+	if config.Latency < 0 {
+		return nil, fmt.Errorf("latency must not be negative, got %s", config.Latency)
+	}
+	if config.FailureRate < 0 || config.FailureRate > 1 {
+		return nil, fmt.Errorf("failure rate must be between 0 and 1, got %v", config.FailureRate)
+	}
+	for _, pattern := range config.FailNames {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid fail name pattern %q: %w", pattern, err)
+		}
+	}
+	return &Fake{
+		latency:     config.Latency,
+		failureRate: config.FailureRate,
+		failNames:   config.FailNames,
+		random:      rand.New(rand.NewSource(time.Now().UnixNano())),
+		states:      make(map[string]string),
+	}, nil
+}
+
+// CreateVirtualMachine provisions a virtual machine
+func (f *Fake) CreateVirtualMachine(ctx context.Context, vm storage.VirtualMachine) error {
+	return f.apply(ctx, "vm/"+vm.ID, vm.Name, storage.StatusRunning)
+}
+
+// UpdateVirtualMachine applies the changes of a virtual machine
+func (f *Fake) UpdateVirtualMachine(ctx context.Context, vm storage.VirtualMachine) error {
+	return f.apply(ctx, "vm/"+vm.ID, vm.Name, storage.StatusRunning)
+}
+
+// DeleteVirtualMachine removes a virtual machine
+func (f *Fake) DeleteVirtualMachine(ctx context.Context, vm storage.VirtualMachine) error {
+	return f.apply(ctx, "vm/"+vm.ID, "", "")
+}
+
+// VirtualMachineStatus returns the status of a virtual machine
+func (f *Fake) VirtualMachineStatus(ctx context.Context, vm storage.VirtualMachine) (Status, error) {
+	return f.status("vm/"+vm.ID, vm.Name), nil
+}
+
+// StartVirtualMachine powers a virtual machine on
+func (f *Fake) StartVirtualMachine(ctx context.Context, vm storage.VirtualMachine) error {
+	return f.apply(ctx, "vm/"+vm.ID, vm.Name, storage.StatusRunning)
+}
+
+// StopVirtualMachine powers a virtual machine off
+func (f *Fake) StopVirtualMachine(ctx context.Context, vm storage.VirtualMachine, force bool) error {
+	if !force {
+		if err := f.wait(ctx); err != nil {
+			return err
+		}
+	}
+	return f.record("vm/"+vm.ID, vm.Name, storage.StatusStopped)
+}
+
+// RestartVirtualMachine restarts a virtual machine
+func (f *Fake) RestartVirtualMachine(ctx context.Context, vm storage.VirtualMachine) error {
+	return f.apply(ctx, "vm/"+vm.ID, vm.Name, storage.StatusRunning)
+}
+
+// SuspendVirtualMachine suspends a virtual machine
+func (f *Fake) SuspendVirtualMachine(ctx context.Context, vm storage.VirtualMachine) error {
+	return f.apply(ctx, "vm/"+vm.ID, vm.Name, storage.StatusSuspended)
+}
+
+// CreateKubernetesCluster provisions a Kubernetes cluster
+func (f *Fake) CreateKubernetesCluster(ctx context.Context, cluster storage.KubernetesCluster) error {
+	return f.apply(ctx, "cluster/"+cluster.ID, cluster.Name, storage.StatusRunning)
+}
+
+// UpdateKubernetesCluster applies the changes of a Kubernetes cluster
+func (f *Fake) UpdateKubernetesCluster(ctx context.Context, cluster storage.KubernetesCluster) error {
+	return f.apply(ctx, "cluster/"+cluster.ID, cluster.Name, storage.StatusRunning)
+}
+
+// DeleteKubernetesCluster removes a Kubernetes cluster
+func (f *Fake) DeleteKubernetesCluster(ctx context.Context, cluster storage.KubernetesCluster) error {
+	return f.apply(ctx, "cluster/"+cluster.ID, "", "")
+}
+
+// KubernetesClusterStatus returns the status of a Kubernetes cluster
+func (f *Fake) KubernetesClusterStatus(ctx context.Context, cluster storage.KubernetesCluster) (Status, error) {
+	return f.status("cluster/"+cluster.ID, cluster.Name), nil
+}
+
+// apply waits for the latency and then records the state a resource reached
+func (f *Fake) apply(ctx context.Context, key, name, state string) error {
+	if err := f.wait(ctx); err != nil {
+		return err
+	}
+	return f.record(key, name, state)
+}
+
+// wait waits for the latency, reporting progress along the way
+func (f *Fake) wait(ctx context.Context) error {
+	for step := int32(0); step < fakeSteps; step++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		ReportProgress(ctx, step*100/fakeSteps)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(f.latency / fakeSteps):
+		}
+	}
+	return nil
+}
+
+// record records the state a resource reached, or forgets the resource if
+// state is empty. Fail patterns are matched against name, which is empty for
+// deletions. A failed operation leaves the resource failed.
+func (f *Fake) record(key, name, state string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.failure(name); err != nil {
+		f.states[key] = storage.StatusFailed
+		return err
+	}
+	if state == "" {
+		delete(f.states, key)
+	} else {
+		f.states[key] = state
+	}
+	return nil
+}
+
+// status returns the recorded state of a resource. The fake driver keeps no
+// state across restarts, so resources it does not know are running unless
+// their name matches a fail pattern.
+func (f *Fake) status(key, name string) Status {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.failsName(name) {
+		return Status{State: storage.StatusFailed, Message: fmt.Sprintf("%s: name %q matches a fail pattern", ErrInjectedFailure, name)}
+	}
+	state, ok := f.states[key]
+	if !ok {
+		state = storage.StatusRunning
+	}
+	return Status{State: state}
+}
+
+// failure returns the injected failure of an operation on a resource, if any.
+// The caller must hold f.mu.
+func (f *Fake) failure(name string) error {
+	if f.failsName(name) {
+		return fmt.Errorf("%w: name %q matches a fail pattern", ErrInjectedFailure, name)
+	}
+	if f.failureRate > 0 && f.random.Float64() < f.failureRate {
+		return fmt.Errorf("%w: random failure with rate %v", ErrInjectedFailure, f.failureRate)
+	}
+	return nil
+}
+
+// failsName reports whether a resource name matches a fail pattern
+func (f *Fake) failsName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, pattern := range f.failNames {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
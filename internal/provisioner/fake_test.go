@@ -0,0 +1,113 @@
+package provisioner_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aa1ex/paas-provider/internal/provisioner"
+	"github.com/aa1ex/paas-provider/internal/storage"
+)
+
+func TestFakeFailures(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     provisioner.DriverConfig
+		vmName     string
+		wantCreate error
+		wantDelete error
+		wantStatus string
+	}{
+		{
+			name:       "no failures",
+			config:     provisioner.DriverConfig{},
+			vmName:     "web",
+			wantStatus: storage.StatusRunning,
+		},
+		{
+			name:       "name matching a fail pattern",
+			config:     provisioner.DriverConfig{FailNames: []string{"bad-*"}},
+			vmName:     "bad-web",
+			wantCreate: provisioner.ErrInjectedFailure,
+			wantStatus: storage.StatusFailed,
+		},
+		{
+			name:       "name matching no fail pattern",
+			config:     provisioner.DriverConfig{FailNames: []string{"bad-*"}},
+			vmName:     "web-bad",
+			wantStatus: storage.StatusRunning,
+		},
+		{
+			name:       "failure rate of 1",
+			config:     provisioner.DriverConfig{FailureRate: 1},
+			vmName:     "web",
+			wantCreate: provisioner.ErrInjectedFailure,
+			wantDelete: provisioner.ErrInjectedFailure,
+			wantStatus: storage.StatusFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := provisioner.NewFake(tt.config)
+			if err != nil {
+				t.Fatalf("NewFake: %v", err)
+			}
+			vm := storage.VirtualMachine{ID: "vm1", Name: tt.vmName}
+
+			if err := f.CreateVirtualMachine(context.Background(), vm); !errors.Is(err, tt.wantCreate) {
+				t.Errorf("CreateVirtualMachine error = %v, want %v", err, tt.wantCreate)
+			}
+			status, err := f.VirtualMachineStatus(context.Background(), vm)
+			if err != nil {
+				t.Fatalf("VirtualMachineStatus: %v", err)
+			}
+			if status.State != tt.wantStatus {
+				t.Errorf("status = %s, want %s", status.State, tt.wantStatus)
+			}
+
+			// Deleting a resource matching a fail pattern succeeds, so it can
+			// be cleaned up
+			if err := f.DeleteVirtualMachine(context.Background(), vm); !errors.Is(err, tt.wantDelete) {
+				t.Errorf("DeleteVirtualMachine error = %v, want %v", err, tt.wantDelete)
+			}
+		})
+	}
+}
+
+func TestFakeLatency(t *testing.T) {
+	f, err := provisioner.NewFake(provisioner.DriverConfig{Latency: time.Hour})
+	if err != nil {
+		t.Fatalf("NewFake: %v", err)
+	}
+	vm := storage.VirtualMachine{ID: "vm1", Name: "web"}
+
+	// A forced stop skips the latency
+	if err := f.StopVirtualMachine(context.Background(), vm, true); err != nil {
+		t.Fatalf("forced StopVirtualMachine: %v", err)
+	}
+	if status, _ := f.VirtualMachineStatus(context.Background(), vm); status.State != storage.StatusStopped {
+		t.Errorf("status after a forced stop = %s, want %s", status.State, storage.StatusStopped)
+	}
+
+	// Other operations stop early when ctx is done
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := f.StartVirtualMachine(ctx, vm); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("StartVirtualMachine error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestNewFakeRejectsInvalidSettings(t *testing.T) {
+	for _, config := range []provisioner.DriverConfig{
+		{Latency: -time.Second},
+		{FailureRate: -0.1},
+		{FailureRate: 1.1},
+		{FailNames: []string{"["}},
+	} {
+		if _, err := provisioner.NewFake(config); err == nil {
+			t.Errorf("NewFake(%+v) succeeded, want an error", config)
+		}
+	}
+}
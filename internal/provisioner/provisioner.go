@@ -0,0 +1,220 @@
+// Package provisioner acts on rendered virtual machines and Kubernetes
+// clusters in an infrastructure. Drivers implement Provisioner, and a
+// Registry selects the driver of each resource by rules on its template or
+// region.
+package provisioner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
+)
+
+var (
+	// ErrProvisioning is returned when a driver fails to act on a resource
+	ErrProvisioning = errors.New("provisioning failed")
+	// ErrUnknownDriver is returned when a resource or rule names a driver that is not configured
+	ErrUnknownDriver = errors.New("unknown provisioner driver")
+)
+
+// Provisioner creates, updates and deletes resources in an infrastructure,
+// and powers virtual machines on and off.
+// Calls return once the infrastructure reached the requested state or failed,
+// report their progress with ReportProgress and stop early when ctx is done.
+type Provisioner interface {
+	CreateVirtualMachine(ctx context.Context, vm storage.VirtualMachine) error
+	UpdateVirtualMachine(ctx context.Context, vm storage.VirtualMachine) error
+	DeleteVirtualMachine(ctx context.Context, vm storage.VirtualMachine) error
+	VirtualMachineStatus(ctx context.Context, vm storage.VirtualMachine) (Status, error)
+	StartVirtualMachine(ctx context.Context, vm storage.VirtualMachine) error
+	// StopVirtualMachine shuts a virtual machine down, or powers it off at
+	// once if force is set
+	StopVirtualMachine(ctx context.Context, vm storage.VirtualMachine, force bool) error
+	RestartVirtualMachine(ctx context.Context, vm storage.VirtualMachine) error
+	SuspendVirtualMachine(ctx context.Context, vm storage.VirtualMachine) error
+
+	CreateKubernetesCluster(ctx context.Context, cluster storage.KubernetesCluster) error
+	UpdateKubernetesCluster(ctx context.Context, cluster storage.KubernetesCluster) error
+	DeleteKubernetesCluster(ctx context.Context, cluster storage.KubernetesCluster) error
+	KubernetesClusterStatus(ctx context.Context, cluster storage.KubernetesCluster) (Status, error)
+}
+
+// Status is the state of a resource as seen by its driver
+type Status struct {
+	State   string // storage.StatusRunning, storage.StatusStopped, storage.StatusSuspended or storage.StatusFailed
+	Message string
+}
+
+// Error is a failed driver operation. It matches ErrProvisioning with errors.Is.
+type Error struct {
+	Driver string
+	Op     string // the action, e.g. "create" or "stop"
+	Err    error
+}
+
+// Error returns the error message
+func (e *Error) Error() string {
+	return fmt.Sprintf("provisioner %q failed to %s: %v", e.Driver, e.Op, e.Err)
+}
+
+// Is reports whether target is ErrProvisioning
+func (e *Error) Is(target error) bool {
+	return target == ErrProvisioning
+}
+
+// Unwrap returns the error of the driver
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Wrap returns the error of a driver operation as an *Error, or nil if err is nil
+func Wrap(driver, op string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Driver: driver, Op: op, Err: err}
+}
+
+// progressKey is the context key of the progress callback of an operation
+type progressKey struct{}
+
+// WithProgress returns a context that passes the progress a driver reports
+// with ReportProgress to report
+func WithProgress(ctx context.Context, report func(percent int32)) context.Context {
+	return context.WithValue(ctx, progressKey{}, report)
+}
+
+// ReportProgress reports the percentage of an operation a driver completed
+func ReportProgress(ctx context.Context, percent int32) {
+	if report, ok := ctx.Value(progressKey{}).(func(int32)); ok {
+		report(percent)
+	}
+}
+
+// Config configures the drivers of a registry and how resources select them
+type Config struct {
+	Default string                  // driver of resources no rule matches, optional with a single driver
+	Drivers map[string]DriverConfig // by name
+	Rules   []Rule                  // the first matching rule selects the driver
+}
+
+// DriverConfig configures a driver. Type selects the implementation, and the
+// other fields are the settings of the implementations.
+type DriverConfig struct {
+	Type string
+
+	// Settings of the fake driver
+	Latency     time.Duration // how long each operation takes
+	FailureRate float64       `mapstructure:"failure_rate"` // share of operations that fail, from 0 to 1
+	FailNames   []string      `mapstructure:"fail_names"`   // glob patterns of resource names whose operations always fail
+}
+
+// Rule selects a driver for the resources matching all of its set fields
+type Rule struct {
+	Driver     string
+	TemplateID string `mapstructure:"template_id"`
+	Region     string // only matches Kubernetes clusters
+}
+
+// Factory creates a driver from its configuration
+type Factory func(config DriverConfig) (Provisioner, error)
+
+// factories are the driver implementations by type
+var factories = map[string]Factory{
+	"fake": func(config DriverConfig) (Provisioner, error) { return NewFake(config) },
+}
+
+// DefaultDriver is the driver of a registry configured without drivers: a fake
+// driver that provisions at once and never fails
+const DefaultDriver = "fake"
+
+// Registry holds the configured drivers
+type Registry struct {
+	drivers map[string]Provisioner
+	def     string
+	rules   []Rule
+}
+
+// NewRegistry creates the drivers of a configuration
+func NewRegistry(config Config) (*Registry, error) {
+	r := &Registry{
+		drivers: make(map[string]Provisioner, len(config.Drivers)),
+		def:     config.Default,
+		rules:   config.Rules,
+	}
+	if len(config.Drivers) == 0 {
+		config.Drivers = map[string]DriverConfig{DefaultDriver: {Type: "fake"}}
+	}
+	if r.def == "" && len(config.Drivers) == 1 {
+		for name := range config.Drivers {
+			r.def = name
+		}
+	}
+
+	for name, driverConfig := range config.Drivers {
+		factory, ok := factories[driverConfig.Type]
+		if !ok {
+			return nil, fmt.Errorf("driver %q has unknown type %q, must be %s", name, driverConfig.Type, strings.Join(driverTypes(), " or "))
+		}
+		driver, err := factory(driverConfig)
+		if err != nil {
+			return nil, fmt.Errorf("driver %q: %w", name, err)
+		}
+		r.drivers[name] = driver
+	}
+
+	if _, ok := r.drivers[r.def]; !ok {
+		return nil, fmt.Errorf("%w: default driver %q", ErrUnknownDriver, r.def)
+	}
+	for i, rule := range r.rules {
+		if _, ok := r.drivers[rule.Driver]; !ok {
+			return nil, fmt.Errorf("%w: driver %q of rule %d", ErrUnknownDriver, rule.Driver, i+1)
+		}
+	}
+	return r, nil
+}
+
+// ForVirtualMachine returns the driver of a virtual machine and its name: the
+// driver that provisioned it, or the one selected by the rules for a new one
+func (r *Registry) ForVirtualMachine(vm storage.VirtualMachine) (string, Provisioner, error) {
+	return r.driver(vm.Provisioner, vm.TemplateID, "")
+}
+
+// ForKubernetesCluster returns the driver of a Kubernetes cluster and its
+// name: the driver that provisioned it, or the one selected by the rules for
+// a new one
+func (r *Registry) ForKubernetesCluster(cluster storage.KubernetesCluster) (string, Provisioner, error) {
+	return r.driver(cluster.Provisioner, cluster.TemplateID, cluster.Region)
+}
+
+func (r *Registry) driver(name, templateID, region string) (string, Provisioner, error) {
+	if name == "" {
+		name = r.def
+		for _, rule := range r.rules {
+			if (rule.TemplateID == "" || rule.TemplateID == templateID) && (rule.Region == "" || rule.Region == region) {
+				name = rule.Driver
+				break
+			}
+		}
+	}
+	driver, ok := r.drivers[name]
+	if !ok {
+		return "", nil, fmt.Errorf("%w: %q", ErrUnknownDriver, name)
+	}
+	return name, driver, nil
+}
+
+// driverTypes returns the known driver types, sorted
+func driverTypes() []string {
+	types := make([]string, 0, len(factories))
+	for t := range factories {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
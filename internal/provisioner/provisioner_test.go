@@ -0,0 +1,151 @@
+package provisioner_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aa1ex/paas-provider/internal/provisioner"
+	"github.com/aa1ex/paas-provider/internal/storage"
+)
+
+func TestNewRegistry(t *testing.T) {
+	fake := provisioner.DriverConfig{Type: "fake"}
+	tests := []struct {
+		name        string
+		config      provisioner.Config
+		wantDefault string
+		wantErr     error
+	}{
+		{
+			name:        "no drivers",
+			config:      provisioner.Config{},
+			wantDefault: provisioner.DefaultDriver,
+		},
+		{
+			name:        "single driver is the default",
+			config:      provisioner.Config{Drivers: map[string]provisioner.DriverConfig{"lab": fake}},
+			wantDefault: "lab",
+		},
+		{
+			name:        "configured default",
+			config:      provisioner.Config{Default: "b", Drivers: map[string]provisioner.DriverConfig{"a": fake, "b": fake}},
+			wantDefault: "b",
+		},
+		{
+			name:    "unknown type",
+			config:  provisioner.Config{Drivers: map[string]provisioner.DriverConfig{"cloud": {Type: "cloud"}}},
+			wantErr: errAny,
+		},
+		{
+			name:    "invalid driver settings",
+			config:  provisioner.Config{Drivers: map[string]provisioner.DriverConfig{"lab": {Type: "fake", FailureRate: 2}}},
+			wantErr: errAny,
+		},
+		{
+			name:    "unknown default",
+			config:  provisioner.Config{Default: "c", Drivers: map[string]provisioner.DriverConfig{"a": fake, "b": fake}},
+			wantErr: provisioner.ErrUnknownDriver,
+		},
+		{
+			name:    "no default with several drivers",
+			config:  provisioner.Config{Drivers: map[string]provisioner.DriverConfig{"a": fake, "b": fake}},
+			wantErr: provisioner.ErrUnknownDriver,
+		},
+		{
+			name: "unknown rule driver",
+			config: provisioner.Config{
+				Drivers: map[string]provisioner.DriverConfig{"a": fake},
+				Rules:   []provisioner.Rule{{Driver: "a", Region: "eu-1"}, {Driver: "b", TemplateID: "t1"}},
+			},
+			wantErr: provisioner.ErrUnknownDriver,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := provisioner.NewRegistry(tt.config)
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tt.wantErr == errAny && err == nil, tt.wantErr != nil && tt.wantErr != errAny && !errors.Is(err, tt.wantErr):
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			case tt.wantErr != nil:
+				return
+			}
+			name, _, err := r.ForVirtualMachine(storage.VirtualMachine{})
+			if err != nil {
+				t.Fatalf("ForVirtualMachine: %v", err)
+			}
+			if name != tt.wantDefault {
+				t.Errorf("default driver = %q, want %q", name, tt.wantDefault)
+			}
+		})
+	}
+}
+
+func TestRegistryRules(t *testing.T) {
+	fake := provisioner.DriverConfig{Type: "fake"}
+	r, err := provisioner.NewRegistry(provisioner.Config{
+		Default: "a",
+		Drivers: map[string]provisioner.DriverConfig{"a": fake, "b": fake, "c": fake},
+		Rules: []provisioner.Rule{
+			{Driver: "b", TemplateID: "t1"},
+			{Driver: "c", Region: "eu-1"},
+			{Driver: "b", Region: "eu-1", TemplateID: "t2"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+
+	vmTests := []struct {
+		name    string
+		vm      storage.VirtualMachine
+		want    string
+		wantErr error
+	}{
+		{name: "template rule", vm: storage.VirtualMachine{TemplateID: "t1"}, want: "b"},
+		{name: "no matching rule", vm: storage.VirtualMachine{TemplateID: "t3"}, want: "a"},
+		{name: "region rules never match virtual machines", vm: storage.VirtualMachine{TemplateID: "t2"}, want: "a"},
+		{name: "driver that provisioned it", vm: storage.VirtualMachine{TemplateID: "t1", Provisioner: "c"}, want: "c"},
+		{name: "driver no longer configured", vm: storage.VirtualMachine{Provisioner: "gone"}, wantErr: provisioner.ErrUnknownDriver},
+	}
+	for _, tt := range vmTests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, driver, err := r.ForVirtualMachine(tt.vm)
+			checkDriver(t, name, driver, err, tt.want, tt.wantErr)
+		})
+	}
+
+	clusterTests := []struct {
+		name    string
+		cluster storage.KubernetesCluster
+		want    string
+	}{
+		{name: "first matching rule wins", cluster: storage.KubernetesCluster{TemplateID: "t1", Region: "eu-1"}, want: "b"},
+		{name: "region rule", cluster: storage.KubernetesCluster{TemplateID: "t2", Region: "eu-1"}, want: "c"},
+		{name: "no matching rule", cluster: storage.KubernetesCluster{TemplateID: "t2", Region: "us-1"}, want: "a"},
+	}
+	for _, tt := range clusterTests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, driver, err := r.ForKubernetesCluster(tt.cluster)
+			checkDriver(t, name, driver, err, tt.want, nil)
+		})
+	}
+}
+
+// errAny matches any error in the tests
+var errAny = errors.New("any error")
+
+// checkDriver checks the driver a registry selected against the wanted driver or error
+func checkDriver(t *testing.T, name string, driver provisioner.Provisioner, err error, want string, wantErr error) {
+	t.Helper()
+	switch {
+	case wantErr != nil && !errors.Is(err, wantErr):
+		t.Fatalf("got error %v, want %v", err, wantErr)
+	case wantErr == nil && err != nil:
+		t.Fatalf("unexpected error: %v", err)
+	case wantErr == nil && (name != want || driver == nil):
+		t.Errorf("got driver %q, want %q", name, want)
+	}
+}
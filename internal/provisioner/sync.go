@@ -0,0 +1,106 @@
+package provisioner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aa1ex/paas-provider/internal/lifecycle"
+	"github.com/aa1ex/paas-provider/internal/storage"
+)
+
+// Syncer asks the drivers for the status of running resources and moves the
+// resources a driver reports failed to the failed status. Resources in other
+// statuses are being acted on, stopped or already failed, and are skipped.
+type Syncer struct {
+	store    storage.Storage
+	registry *Registry
+}
+
+// NewSyncer creates a status syncer
+func NewSyncer(store storage.Storage, registry *Registry) *Syncer {
+	return &Syncer{store: store, registry: registry}
+}
+
+// Sync checks the status of all resources once. Resources whose driver fails
+// to report a status are logged and skipped.
+func (s *Syncer) Sync(ctx context.Context) error {
+	vms, _, err := s.store.ListVirtualMachines(storage.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list virtual machines: %w", err)
+	}
+	for _, vm := range vms {
+		if lifecycle.Status(vm.Lifecycle) != storage.StatusRunning {
+			continue
+		}
+		_, driver, err := s.registry.ForVirtualMachine(vm)
+		if err == nil {
+			var status Status
+			status, err = driver.VirtualMachineStatus(ctx, vm)
+			if err == nil && fail(&vm.Lifecycle, status) {
+				log.Printf("Virtual machine %s (%s) failed: %s", vm.ID, vm.Name, status.Message)
+				_, err = s.store.UpdateVirtualMachine(vm)
+			}
+		}
+		if err != nil && !errors.Is(err, storage.ErrConflict) {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Printf("Warning: Could not sync the status of virtual machine %s: %v", vm.ID, err)
+		}
+	}
+
+	clusters, _, err := s.store.ListKubernetesClusters(storage.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list Kubernetes clusters: %w", err)
+	}
+	for _, cluster := range clusters {
+		if lifecycle.Status(cluster.Lifecycle) != storage.StatusRunning {
+			continue
+		}
+		_, driver, err := s.registry.ForKubernetesCluster(cluster)
+		if err == nil {
+			var status Status
+			status, err = driver.KubernetesClusterStatus(ctx, cluster)
+			if err == nil && fail(&cluster.Lifecycle, status) {
+				log.Printf("Kubernetes cluster %s (%s) failed: %s", cluster.ID, cluster.Name, status.Message)
+				_, err = s.store.UpdateKubernetesCluster(cluster)
+			}
+		}
+		if err != nil && !errors.Is(err, storage.ErrConflict) {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Printf("Warning: Could not sync the status of Kubernetes cluster %s: %v", cluster.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// Run syncs the status of all resources every interval until ctx is done
+func (s *Syncer) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Sync(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("Warning: Status sync failed: %v", err)
+			}
+		}
+	}
+}
+
+// fail moves a resource to the failed status if its driver reports it failed,
+// and reports whether it did
+func fail(l *storage.Lifecycle, status Status) bool {
+	if status.State != storage.StatusFailed {
+		return false
+	}
+	return lifecycle.Transition(l, storage.StatusFailed, lifecycle.ReasonFailed, status.Message, time.Now()) == nil
+}
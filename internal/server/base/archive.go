@@ -0,0 +1,99 @@
+package base
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
+)
+
+// ArchiveFormat is the format of an archive of rendered artifacts
+type ArchiveFormat int
+
+const (
+	// ArchiveTarGz is a gzip-compressed tar archive
+	ArchiveTarGz ArchiveFormat = iota
+	// ArchiveZip is a zip archive
+	ArchiveZip
+)
+
+// archiveModTime is the modification time of all archive entries. Resources do
+// not record when they were rendered, and the earliest time zip can store keeps
+// archives of the same artifacts identical.
+var archiveModTime = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Archive is an archive of rendered artifacts
+type Archive struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+// ArchiveArtifacts packs rendered artifacts into an archive named after a
+// resource, with the entries sorted by name
+func ArchiveArtifacts(name string, artifacts storage.Artifacts, format ArchiveFormat) (Archive, error) {
+	names := make([]string, 0, len(artifacts))
+	for artifact := range artifacts {
+		names = append(names, artifact)
+	}
+	sort.Strings(names)
+
+	// Resource names are free text, keep the file name portable
+	name = strings.Map(func(r rune) rune {
+		if r < 0x80 && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '.' || r == '_' || r == '-') {
+			return r
+		}
+		return '_'
+	}, name)
+
+	var buf bytes.Buffer
+	switch format {
+	case ArchiveZip:
+		w := zip.NewWriter(&buf)
+		for _, artifact := range names {
+			f, err := w.CreateHeader(&zip.FileHeader{Name: artifact, Method: zip.Deflate, Modified: archiveModTime})
+			if err != nil {
+				return Archive{}, fmt.Errorf("failed to add %q to archive: %w", artifact, err)
+			}
+			if _, err := f.Write([]byte(artifacts[artifact])); err != nil {
+				return Archive{}, fmt.Errorf("failed to add %q to archive: %w", artifact, err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			return Archive{}, fmt.Errorf("failed to write archive: %w", err)
+		}
+		return Archive{FileName: name + ".zip", ContentType: "application/zip", Content: buf.Bytes()}, nil
+
+	default:
+		gz := gzip.NewWriter(&buf)
+		w := tar.NewWriter(gz)
+		for _, artifact := range names {
+			header := &tar.Header{
+				Name:    artifact,
+				Mode:    0o644,
+				Size:    int64(len(artifacts[artifact])),
+				ModTime: archiveModTime,
+			}
+			if err := w.WriteHeader(header); err != nil {
+				return Archive{}, fmt.Errorf("failed to add %q to archive: %w", artifact, err)
+			}
+			if _, err := w.Write([]byte(artifacts[artifact])); err != nil {
+				return Archive{}, fmt.Errorf("failed to add %q to archive: %w", artifact, err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			return Archive{}, fmt.Errorf("failed to write archive: %w", err)
+		}
+		if err := gz.Close(); err != nil {
+			return Archive{}, fmt.Errorf("failed to write archive: %w", err)
+		}
+		return Archive{FileName: name + ".tar.gz", ContentType: "application/gzip", Content: buf.Bytes()}, nil
+	}
+}
@@ -1,98 +1,325 @@
 package base
 
 import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/aa1ex/paas-provider/internal/lifecycle"
 	"github.com/aa1ex/paas-provider/internal/storage"
 	k8sv1 "github.com/aa1ex/paas-provider/pkg/api/grpc/kubernetes_cluster/v1"
-	templatev1 "github.com/aa1ex/paas-provider/pkg/api/grpc/template/v1"
+	operationsv1 "github.com/aa1ex/paas-provider/pkg/api/grpc/operations/v1"
 	vmv1 "github.com/aa1ex/paas-provider/pkg/api/grpc/virtual_machine/v1"
 )
 
-// ConvertStorageTemplateToProto converts a storage.Template to a templatev1.Template
-func ConvertStorageTemplateToProto(template storage.Template) *templatev1.Template {
-	protoTemplate := &templatev1.Template{
-		Id:          template.ID,
-		Name:        template.Name,
-		RawTemplate: template.RawTemplate,
+// ConvertStorageVMToProto converts a storage.VirtualMachine to a vmv1.VirtualMachine
+func ConvertStorageVMToProto(vm storage.VirtualMachine) *vmv1.VirtualMachine {
+	return &vmv1.VirtualMachine{
+		Id:                vm.ID,
+		Name:              vm.Name,
+		Cpu:               vm.CPU,
+		Memory:            vm.Memory,
+		Os:                vm.OS,
+		TemplateId:        vm.TemplateID,
+		TemplateRevision:  vm.TemplateRevision,
+		Parameters:        vm.Parameters,
+		RenderedArtifacts: vm.RenderedArtifacts,
+		ResourceVersion:   vm.ResourceVersion,
+		DriftStatus:       ConvertStorageDriftToVMProto(vm.Drift.State),
+		DriftStatusTime:   ConvertTimeToProto(vm.Drift.Since),
+		Status:            ConvertStorageStatusToVMProto(lifecycle.Status(vm.Lifecycle)),
+		Conditions:        ConvertStorageConditionsToVMProto(vm.Conditions),
+		CreateTime:        ConvertTimeToProto(vm.CreatedAt),
+		UpdateTime:        ConvertTimeToProto(vm.UpdatedAt),
+		Provisioner:       vm.Provisioner,
+		Events:            ConvertStorageEventsToVMProto(vm.Events),
 	}
+}
 
-	// Set the template type
-	switch template.Type {
-	case "vm":
-		protoTemplate.Type = templatev1.Template_TYPE_VM
-	case "kubernetes":
-		protoTemplate.Type = templatev1.Template_TYPE_KUBERNETES
+// ConvertStorageVMSnapshotToProto converts a storage.VirtualMachineSnapshot to a vmv1.VirtualMachineSnapshot
+func ConvertStorageVMSnapshotToProto(snapshot storage.VirtualMachineSnapshot) *vmv1.VirtualMachineSnapshot {
+	return &vmv1.VirtualMachineSnapshot{
+		Id:                snapshot.ID,
+		VirtualMachineId:  snapshot.VirtualMachineID,
+		Name:              snapshot.Name,
+		Cpu:               snapshot.CPU,
+		Memory:            snapshot.Memory,
+		Os:                snapshot.OS,
+		TemplateId:        snapshot.TemplateID,
+		TemplateRevision:  snapshot.TemplateRevision,
+		Parameters:        snapshot.Parameters,
+		RenderedArtifacts: snapshot.RenderedArtifacts,
+		CreateTime:        ConvertTimeToProto(snapshot.CreatedAt),
 	}
-
-	return protoTemplate
 }
 
-// ConvertProtoTemplateToStorage converts a templatev1.Template to a storage.Template
-func ConvertProtoTemplateToStorage(template *templatev1.Template) storage.Template {
-	storageTemplate := storage.Template{
-		ID:          template.Id,
-		Name:        template.Name,
-		RawTemplate: template.RawTemplate,
+// ConvertStorageStatusToVMProto converts a storage lifecycle status to a vmv1.VirtualMachine_Status
+func ConvertStorageStatusToVMProto(status string) vmv1.VirtualMachine_Status {
+	switch status {
+	case storage.StatusPending:
+		return vmv1.VirtualMachine_STATUS_PENDING
+	case storage.StatusProvisioning:
+		return vmv1.VirtualMachine_STATUS_PROVISIONING
+	case storage.StatusRunning:
+		return vmv1.VirtualMachine_STATUS_RUNNING
+	case storage.StatusStopping:
+		return vmv1.VirtualMachine_STATUS_STOPPING
+	case storage.StatusStopped:
+		return vmv1.VirtualMachine_STATUS_STOPPED
+	case storage.StatusUpdating:
+		return vmv1.VirtualMachine_STATUS_UPDATING
+	case storage.StatusDeleting:
+		return vmv1.VirtualMachine_STATUS_DELETING
+	case storage.StatusFailed:
+		return vmv1.VirtualMachine_STATUS_FAILED
+	case storage.StatusRestarting:
+		return vmv1.VirtualMachine_STATUS_RESTARTING
+	case storage.StatusSuspending:
+		return vmv1.VirtualMachine_STATUS_SUSPENDING
+	case storage.StatusSuspended:
+		return vmv1.VirtualMachine_STATUS_SUSPENDED
 	}
+	return vmv1.VirtualMachine_STATUS_UNSPECIFIED
+}
 
-	// Set the template type
-	switch template.Type {
-	case templatev1.Template_TYPE_VM:
-		storageTemplate.Type = "vm"
-	case templatev1.Template_TYPE_KUBERNETES:
-		storageTemplate.Type = "kubernetes"
+// ConvertStorageConditionsToVMProto converts storage conditions to vmv1.Condition
+func ConvertStorageConditionsToVMProto(conditions []storage.Condition) []*vmv1.Condition {
+	protoConditions := make([]*vmv1.Condition, len(conditions))
+	for i, condition := range conditions {
+		protoConditions[i] = &vmv1.Condition{
+			Type:               condition.Type,
+			Status:             condition.Status,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: ConvertTimeToProto(condition.LastTransitionTime),
+			LastUpdateTime:     ConvertTimeToProto(condition.LastUpdateTime),
+		}
 	}
+	return protoConditions
+}
 
-	return storageTemplate
+// ConvertStorageEventsToVMProto converts storage events to vmv1.Event
+func ConvertStorageEventsToVMProto(events []storage.Event) []*vmv1.Event {
+	protoEvents := make([]*vmv1.Event, len(events))
+	for i, event := range events {
+		protoEvents[i] = &vmv1.Event{
+			Action:    event.Action,
+			Succeeded: event.Succeeded,
+			Message:   event.Message,
+			Time:      ConvertTimeToProto(event.Time),
+		}
+	}
+	return protoEvents
 }
 
-// ConvertStorageVMToProto converts a storage.VirtualMachine to a vmv1.VirtualMachine
-func ConvertStorageVMToProto(vm storage.VirtualMachine) *vmv1.VirtualMachine {
-	return &vmv1.VirtualMachine{
-		Id:               vm.ID,
-		Name:             vm.Name,
-		Cpu:              vm.CPU,
-		Memory:           vm.Memory,
-		Os:               vm.OS,
-		TemplateId:       vm.TemplateID,
-		RenderedTemplate: vm.RenderedTemplate,
+// ConvertStorageDriftToVMProto converts a storage drift state to a vmv1.VirtualMachine_DriftStatus
+func ConvertStorageDriftToVMProto(state string) vmv1.VirtualMachine_DriftStatus {
+	switch state {
+	case storage.DriftInSync:
+		return vmv1.VirtualMachine_DRIFT_STATUS_IN_SYNC
+	case storage.DriftDrifted:
+		return vmv1.VirtualMachine_DRIFT_STATUS_DRIFTED
+	case storage.DriftRenderFailed:
+		return vmv1.VirtualMachine_DRIFT_STATUS_RENDER_FAILED
 	}
+	return vmv1.VirtualMachine_DRIFT_STATUS_UNSPECIFIED
 }
 
 // ConvertProtoVMToStorage converts a vmv1.VirtualMachine to a storage.VirtualMachine
 func ConvertProtoVMToStorage(vm *vmv1.VirtualMachine) storage.VirtualMachine {
 	return storage.VirtualMachine{
-		ID:               vm.Id,
-		Name:             vm.Name,
-		CPU:              vm.Cpu,
-		Memory:           vm.Memory,
-		OS:               vm.Os,
-		TemplateID:       vm.TemplateId,
-		RenderedTemplate: vm.RenderedTemplate,
+		ID:                vm.Id,
+		Name:              vm.Name,
+		CPU:               vm.Cpu,
+		Memory:            vm.Memory,
+		OS:                vm.Os,
+		TemplateID:        vm.TemplateId,
+		TemplateRevision:  vm.TemplateRevision,
+		Parameters:        vm.Parameters,
+		RenderedArtifacts: vm.RenderedArtifacts,
+		ResourceVersion:   vm.ResourceVersion,
 	}
 }
 
 // ConvertStorageK8sToProto converts a storage.KubernetesCluster to a k8sv1.KubernetesCluster
 func ConvertStorageK8sToProto(cluster storage.KubernetesCluster) *k8sv1.KubernetesCluster {
 	return &k8sv1.KubernetesCluster{
-		Id:               cluster.ID,
-		Name:             cluster.Name,
-		Region:           cluster.Region,
-		NodeCount:        cluster.NodeCount,
-		Version:          cluster.Version,
-		TemplateId:       cluster.TemplateID,
-		RenderedTemplate: cluster.RenderedTemplate,
+		Id:                cluster.ID,
+		Name:              cluster.Name,
+		Region:            cluster.Region,
+		NodeCount:         cluster.NodeCount,
+		Version:           cluster.Version,
+		TemplateId:        cluster.TemplateID,
+		TemplateRevision:  cluster.TemplateRevision,
+		Parameters:        cluster.Parameters,
+		RenderedArtifacts: cluster.RenderedArtifacts,
+		ResourceVersion:   cluster.ResourceVersion,
+		DriftStatus:       ConvertStorageDriftToK8sProto(cluster.Drift.State),
+		DriftStatusTime:   ConvertTimeToProto(cluster.Drift.Since),
+		Status:            ConvertStorageStatusToK8sProto(lifecycle.Status(cluster.Lifecycle)),
+		Conditions:        ConvertStorageConditionsToK8sProto(cluster.Conditions),
+		CreateTime:        ConvertTimeToProto(cluster.CreatedAt),
+		UpdateTime:        ConvertTimeToProto(cluster.UpdatedAt),
+		Provisioner:       cluster.Provisioner,
+	}
+}
+
+// ConvertStorageStatusToK8sProto converts a storage lifecycle status to a k8sv1.KubernetesCluster_Status
+func ConvertStorageStatusToK8sProto(status string) k8sv1.KubernetesCluster_Status {
+	switch status {
+	case storage.StatusPending:
+		return k8sv1.KubernetesCluster_STATUS_PENDING
+	case storage.StatusProvisioning:
+		return k8sv1.KubernetesCluster_STATUS_PROVISIONING
+	case storage.StatusRunning:
+		return k8sv1.KubernetesCluster_STATUS_RUNNING
+	case storage.StatusStopping:
+		return k8sv1.KubernetesCluster_STATUS_STOPPING
+	case storage.StatusStopped:
+		return k8sv1.KubernetesCluster_STATUS_STOPPED
+	case storage.StatusUpdating:
+		return k8sv1.KubernetesCluster_STATUS_UPDATING
+	case storage.StatusDeleting:
+		return k8sv1.KubernetesCluster_STATUS_DELETING
+	case storage.StatusFailed:
+		return k8sv1.KubernetesCluster_STATUS_FAILED
 	}
+	return k8sv1.KubernetesCluster_STATUS_UNSPECIFIED
+}
+
+// ConvertStorageConditionsToK8sProto converts storage conditions to k8sv1.Condition
+func ConvertStorageConditionsToK8sProto(conditions []storage.Condition) []*k8sv1.Condition {
+	protoConditions := make([]*k8sv1.Condition, len(conditions))
+	for i, condition := range conditions {
+		protoConditions[i] = &k8sv1.Condition{
+			Type:               condition.Type,
+			Status:             condition.Status,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: ConvertTimeToProto(condition.LastTransitionTime),
+			LastUpdateTime:     ConvertTimeToProto(condition.LastUpdateTime),
+		}
+	}
+	return protoConditions
+}
+
+// ConvertStorageDriftToK8sProto converts a storage drift state to a k8sv1.KubernetesCluster_DriftStatus
+func ConvertStorageDriftToK8sProto(state string) k8sv1.KubernetesCluster_DriftStatus {
+	switch state {
+	case storage.DriftInSync:
+		return k8sv1.KubernetesCluster_DRIFT_STATUS_IN_SYNC
+	case storage.DriftDrifted:
+		return k8sv1.KubernetesCluster_DRIFT_STATUS_DRIFTED
+	case storage.DriftRenderFailed:
+		return k8sv1.KubernetesCluster_DRIFT_STATUS_RENDER_FAILED
+	}
+	return k8sv1.KubernetesCluster_DRIFT_STATUS_UNSPECIFIED
+}
+
+// ConvertTimeToProto converts a time to a timestamp, leaving the zero time unset
+func ConvertTimeToProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
 }
 
 // ConvertProtoK8sToStorage converts a k8sv1.KubernetesCluster to a storage.KubernetesCluster
 func ConvertProtoK8sToStorage(cluster *k8sv1.KubernetesCluster) storage.KubernetesCluster {
 	return storage.KubernetesCluster{
-		ID:               cluster.Id,
-		Name:             cluster.Name,
-		Region:           cluster.Region,
-		NodeCount:        cluster.NodeCount,
-		Version:          cluster.Version,
-		TemplateID:       cluster.TemplateId,
-		RenderedTemplate: cluster.RenderedTemplate,
+		ID:                cluster.Id,
+		Name:              cluster.Name,
+		Region:            cluster.Region,
+		NodeCount:         cluster.NodeCount,
+		Version:           cluster.Version,
+		TemplateID:        cluster.TemplateId,
+		TemplateRevision:  cluster.TemplateRevision,
+		Parameters:        cluster.Parameters,
+		RenderedArtifacts: cluster.RenderedArtifacts,
+		ResourceVersion:   cluster.ResourceVersion,
+	}
+}
+
+// ConvertStorageOperationToProto converts a storage.Operation to an operationsv1.Operation
+func ConvertStorageOperationToProto(op storage.Operation) *operationsv1.Operation {
+	protoOp := &operationsv1.Operation{
+		Id:              op.ID,
+		Type:            ConvertStorageOperationTypeToProto(op.Type),
+		State:           ConvertStorageOperationStateToProto(op.State),
+		Done:            op.Done(),
+		ProgressPercent: op.Progress,
+		CancelRequested: op.CancelRequested,
+		CreateTime:      ConvertTimeToProto(op.CreatedAt),
+		UpdateTime:      ConvertTimeToProto(op.UpdatedAt),
+		EndTime:         ConvertTimeToProto(op.EndedAt),
+	}
+
+	switch {
+	case op.VirtualMachineID != "":
+		protoOp.Resource = &operationsv1.Operation_VirtualMachineId{VirtualMachineId: op.VirtualMachineID}
+	case op.KubernetesClusterID != "":
+		protoOp.Resource = &operationsv1.Operation_KubernetesClusterId{KubernetesClusterId: op.KubernetesClusterID}
+	}
+
+	switch {
+	case op.VirtualMachine != nil:
+		protoOp.Result = &operationsv1.Operation_VirtualMachine{VirtualMachine: ConvertStorageVMToProto(*op.VirtualMachine)}
+	case op.KubernetesCluster != nil:
+		protoOp.Result = &operationsv1.Operation_KubernetesCluster{KubernetesCluster: ConvertStorageK8sToProto(*op.KubernetesCluster)}
+	}
+
+	if op.ErrorCode != "" {
+		protoOp.Error = &operationsv1.OperationError{
+			Code:    op.ErrorCode,
+			Message: op.ErrorMessage,
+		}
+	}
+
+	return protoOp
+}
+
+// ConvertStorageOperationTypeToProto converts a storage operation type to an operationsv1.Operation_Type
+func ConvertStorageOperationTypeToProto(operationType string) operationsv1.Operation_Type {
+	switch operationType {
+	case storage.OperationCreateVirtualMachine:
+		return operationsv1.Operation_TYPE_CREATE_VIRTUAL_MACHINE
+	case storage.OperationUpdateVirtualMachine:
+		return operationsv1.Operation_TYPE_UPDATE_VIRTUAL_MACHINE
+	case storage.OperationDeleteVirtualMachine:
+		return operationsv1.Operation_TYPE_DELETE_VIRTUAL_MACHINE
+	case storage.OperationCreateKubernetesCluster:
+		return operationsv1.Operation_TYPE_CREATE_KUBERNETES_CLUSTER
+	case storage.OperationUpdateKubernetesCluster:
+		return operationsv1.Operation_TYPE_UPDATE_KUBERNETES_CLUSTER
+	case storage.OperationDeleteKubernetesCluster:
+		return operationsv1.Operation_TYPE_DELETE_KUBERNETES_CLUSTER
+	case storage.OperationStartVirtualMachine:
+		return operationsv1.Operation_TYPE_START_VIRTUAL_MACHINE
+	case storage.OperationStopVirtualMachine:
+		return operationsv1.Operation_TYPE_STOP_VIRTUAL_MACHINE
+	case storage.OperationRestartVirtualMachine:
+		return operationsv1.Operation_TYPE_RESTART_VIRTUAL_MACHINE
+	case storage.OperationSuspendVirtualMachine:
+		return operationsv1.Operation_TYPE_SUSPEND_VIRTUAL_MACHINE
+	case storage.OperationRestoreVirtualMachine:
+		return operationsv1.Operation_TYPE_RESTORE_VIRTUAL_MACHINE
+	}
+	return operationsv1.Operation_TYPE_UNSPECIFIED
+}
+
+// ConvertStorageOperationStateToProto converts a storage operation state to an operationsv1.Operation_State
+func ConvertStorageOperationStateToProto(state string) operationsv1.Operation_State {
+	switch state {
+	case storage.OperationPending:
+		return operationsv1.Operation_STATE_PENDING
+	case storage.OperationRunning:
+		return operationsv1.Operation_STATE_RUNNING
+	case storage.OperationSucceeded:
+		return operationsv1.Operation_STATE_SUCCEEDED
+	case storage.OperationFailed:
+		return operationsv1.Operation_STATE_FAILED
+	case storage.OperationCancelled:
+		return operationsv1.Operation_STATE_CANCELLED
 	}
+	return operationsv1.Operation_STATE_UNSPECIFIED
 }
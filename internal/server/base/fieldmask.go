@@ -0,0 +1,96 @@
+package base
+
+import (
+	"github.com/aa1ex/paas-provider/internal/storage"
+	"github.com/aa1ex/paas-provider/internal/validation"
+)
+
+// MergeTemplate copies the fields covered by the update mask from src onto dst.
+// The resource version is taken from src when set, so that stale updates are still rejected.
+func MergeTemplate(dst, src storage.Template, paths []string) storage.Template {
+	if validation.InFieldMask(paths, "name") {
+		dst.Name = src.Name
+	}
+	if validation.InFieldMask(paths, "type") {
+		dst.Type = src.Type
+	}
+	if validation.InFieldMask(paths, "description") {
+		dst.Description = src.Description
+	}
+	if validation.InFieldMask(paths, "raw_template") {
+		dst.RawTemplate = src.RawTemplate
+	}
+	if validation.InFieldMask(paths, "parameters") {
+		dst.Parameters = src.Parameters
+	}
+	if validation.InFieldMask(paths, "files") {
+		dst.Files = src.Files
+	}
+	if validation.InFieldMask(paths, "output_format") {
+		dst.OutputFormat = src.OutputFormat
+	}
+	if validation.InFieldMask(paths, "normalize_output") {
+		dst.NormalizeOutput = src.NormalizeOutput
+	}
+	if validation.InFieldMask(paths, "output_schema") {
+		dst.OutputSchema = src.OutputSchema
+	}
+	if src.ResourceVersion != 0 {
+		dst.ResourceVersion = src.ResourceVersion
+	}
+	return dst
+}
+
+// MergeVirtualMachine copies the fields covered by the update mask from src onto dst.
+// The resource version is taken from src when set, so that stale updates are still rejected.
+func MergeVirtualMachine(dst, src storage.VirtualMachine, paths []string) storage.VirtualMachine {
+	if validation.InFieldMask(paths, "name") {
+		dst.Name = src.Name
+	}
+	if validation.InFieldMask(paths, "cpu") {
+		dst.CPU = src.CPU
+	}
+	if validation.InFieldMask(paths, "memory") {
+		dst.Memory = src.Memory
+	}
+	if validation.InFieldMask(paths, "os") {
+		dst.OS = src.OS
+	}
+	if validation.InFieldMask(paths, "template_id") {
+		dst.TemplateID = src.TemplateID
+	}
+	if validation.InFieldMask(paths, "parameters") {
+		dst.Parameters = src.Parameters
+	}
+	if src.ResourceVersion != 0 {
+		dst.ResourceVersion = src.ResourceVersion
+	}
+	return dst
+}
+
+// MergeKubernetesCluster copies the fields covered by the update mask from src onto dst.
+// The resource version is taken from src when set, so that stale updates are still rejected.
+func MergeKubernetesCluster(dst, src storage.KubernetesCluster, paths []string) storage.KubernetesCluster {
+	if validation.InFieldMask(paths, "name") {
+		dst.Name = src.Name
+	}
+	if validation.InFieldMask(paths, "region") {
+		dst.Region = src.Region
+	}
+	if validation.InFieldMask(paths, "node_count") {
+		dst.NodeCount = src.NodeCount
+	}
+	if validation.InFieldMask(paths, "version") {
+		dst.Version = src.Version
+	}
+	if validation.InFieldMask(paths, "template_id") {
+		dst.TemplateID = src.TemplateID
+	}
+	if validation.InFieldMask(paths, "parameters") {
+		dst.Parameters = src.Parameters
+	}
+	if src.ResourceVersion != 0 {
+		dst.ResourceVersion = src.ResourceVersion
+	}
+	return dst
+}
@@ -1,10 +1,14 @@
 package base
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"connectrpc.com/connect"
 
+	"github.com/aa1ex/paas-provider/internal/lifecycle"
+	"github.com/aa1ex/paas-provider/internal/provisioner"
 	"github.com/aa1ex/paas-provider/internal/storage"
 	"github.com/aa1ex/paas-provider/internal/tmplproc"
 	"github.com/aa1ex/paas-provider/internal/validation"
@@ -12,12 +16,12 @@ import (
 
 // Service is a base service that provides common functionality
 type Service struct {
-	Storage   *storage.Storage
+	Storage   storage.Storage
 	Processor *tmplproc.TemplateProcessor
 }
 
 // NewService creates a new base service
-func NewService(storage *storage.Storage, processor *tmplproc.TemplateProcessor) *Service {
+func NewService(storage storage.Storage, processor *tmplproc.TemplateProcessor) *Service {
 	return &Service{
 		Storage:   storage,
 		Processor: processor,
@@ -34,13 +38,61 @@ func (s *Service) HandleValidationErrors(errors validation.Errors) error {
 
 // HandleStorageError converts a storage error to a connect error
 func (s *Service) HandleStorageError(err error) error {
-	if err == storage.ErrNotFound {
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
 		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, storage.ErrAlreadyExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, storage.ErrConflict):
+		return connect.NewError(connect.CodeAborted, err)
+	case errors.Is(err, storage.ErrInUse):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, storage.ErrInvalidQuery):
+		return connect.NewError(connect.CodeInvalidArgument, err)
 	}
 	return connect.NewError(connect.CodeInternal, fmt.Errorf("storage error: %w", err))
 }
 
+// HandleLifecycleError converts a lifecycle error to a connect error
+func (s *Service) HandleLifecycleError(err error) error {
+	if errors.Is(err, lifecycle.ErrInvalidTransition) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("lifecycle error: %w", err))
+}
+
+// HandleProvisionerError converts a provisioner error to a connect error
+func (s *Service) HandleProvisionerError(err error) error {
+	switch {
+	case errors.Is(err, provisioner.ErrUnknownDriver):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, provisioner.ErrProvisioning):
+		return connect.NewError(connect.CodeUnavailable, err)
+	}
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("provisioner error: %w", err))
+}
+
 // HandleTemplateProcessorError converts a template processor error to a connect error
 func (s *Service) HandleTemplateProcessorError(err error) error {
+	var validationErrors validation.Errors
+	if errors.As(err, &validationErrors) {
+		return s.HandleValidationErrors(validationErrors)
+	}
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, tmplproc.ErrRenderTimeout):
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	case errors.Is(err, tmplproc.ErrOutputTooLarge):
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	case errors.Is(err, tmplproc.ErrInvalidOutput):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, tmplproc.ErrNestingTooDeep),
+		errors.Is(err, tmplproc.ErrMissingPartial),
+		errors.Is(err, tmplproc.ErrPartialCycle):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	}
 	return connect.NewError(connect.CodeInternal, fmt.Errorf("template processing error: %w", err))
 }
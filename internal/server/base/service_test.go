@@ -0,0 +1,32 @@
+package base_test
+
+import (
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/aa1ex/paas-provider/internal/server/base"
+	"github.com/aa1ex/paas-provider/internal/tmplproc"
+)
+
+func TestHandleTemplateProcessorErrorLimits(t *testing.T) {
+	tests := []struct {
+		err  error
+		want connect.Code
+	}{
+		{tmplproc.ErrRenderTimeout, connect.CodeDeadlineExceeded},
+		{tmplproc.ErrOutputTooLarge, connect.CodeResourceExhausted},
+		{tmplproc.ErrNestingTooDeep, connect.CodeFailedPrecondition},
+	}
+
+	s := &base.Service{}
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			err := s.HandleTemplateProcessorError(fmt.Errorf("artifact %q: %w", "main", tt.err))
+			if got := connect.CodeOf(err); got != tt.want {
+				t.Errorf("got code %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
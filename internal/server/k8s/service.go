@@ -2,9 +2,16 @@ package k8s
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
 
 	"connectrpc.com/connect"
 
+	"github.com/aa1ex/paas-provider/internal/lifecycle"
+	"github.com/aa1ex/paas-provider/internal/longrunning"
+	"github.com/aa1ex/paas-provider/internal/provisioner"
 	"github.com/aa1ex/paas-provider/internal/server/base"
 	"github.com/aa1ex/paas-provider/internal/server/util"
 	"github.com/aa1ex/paas-provider/internal/storage"
@@ -14,19 +21,32 @@ import (
 	"github.com/aa1ex/paas-provider/pkg/api/grpc/kubernetes_cluster/v1/kubernetes_clusterv1connect"
 )
 
+// kubeconfigArtifact is the artifact returned as the kubeconfig of a cluster
+const kubeconfigArtifact = "kubeconfig"
+
 type Service struct {
 	*base.Service
 	kubernetes_clusterv1connect.UnimplementedKubernetesClusterServiceHandler
+	Provisioners *provisioner.Registry
+	Operations   *longrunning.Runner
 }
 
-func NewService(storage *storage.Storage, processor *tmplproc.TemplateProcessor) *Service {
-	return &Service{
-		Service: base.NewService(storage, processor),
+func NewService(store storage.Storage, processor *tmplproc.TemplateProcessor, provisioners *provisioner.Registry, operations *longrunning.Runner) *Service {
+	s := &Service{
+		Service:      base.NewService(store, processor),
+		Provisioners: provisioners,
+		Operations:   operations,
 	}
+	operations.Resume(s.resume,
+		storage.OperationCreateKubernetesCluster,
+		storage.OperationUpdateKubernetesCluster,
+		storage.OperationDeleteKubernetesCluster,
+	)
+	return s
 }
 
 // CreateKubernetesCluster creates a new Kubernetes cluster
-func (s *Service) CreateKubernetesCluster(_ context.Context, req *connect.Request[v1.CreateKubernetesClusterRequest]) (*connect.Response[v1.CreateKubernetesClusterResponse], error) {
+func (s *Service) CreateKubernetesCluster(ctx context.Context, req *connect.Request[v1.CreateKubernetesClusterRequest]) (*connect.Response[v1.CreateKubernetesClusterResponse], error) {
 	// Validate the request
 	errors := validation.ValidateCreateKubernetesClusterRequest(req.Msg)
 	if err := s.HandleValidationErrors(errors); err != nil {
@@ -36,24 +56,62 @@ func (s *Service) CreateKubernetesCluster(_ context.Context, req *connect.Reques
 	// Convert proto cluster to storage cluster
 	cluster := base.ConvertProtoK8sToStorage(req.Msg.KubernetesCluster)
 
-	// Generate ID
-	cluster.ID = util.GenerateID()
-
 	// Process the template
-	renderedTemplate, err := s.Processor.ProcessKubernetesClusterTemplate(cluster)
+	result, err := s.Processor.ProcessKubernetesClusterTemplate(ctx, cluster)
 	if err != nil {
 		return nil, s.HandleTemplateProcessorError(err)
 	}
 
-	// Set the rendered template
-	cluster.RenderedTemplate = renderedTemplate
+	// Set the rendered template and the template revision it came from,
+	// which the resource is now in sync with
+	cluster.RenderedArtifacts = result.Artifacts
+	cluster.TemplateRevision = result.Revision
+	cluster.Drift = cluster.Drift.WithState(storage.DriftInSync, time.Now())
+
+	// Select the driver that provisions the Kubernetes cluster
+	name, driver, err := s.Provisioners.ForKubernetesCluster(cluster)
+	if err != nil {
+		return nil, s.HandleProvisionerError(err)
+	}
+	cluster.Provisioner = name
+
+	// Start the lifecycle of the Kubernetes cluster
+	lifecycle.Init(&cluster.Lifecycle, time.Now())
+
+	// Stop here if the request only validates
+	if req.Msg.ValidateOnly {
+		return connect.NewResponse(&v1.CreateKubernetesClusterResponse{
+			KubernetesCluster: base.ConvertStorageK8sToProto(cluster),
+		}), nil
+	}
+
+	// Generate ID
+	cluster.ID = util.GenerateID()
 
-	// Store the Kubernetes cluster
-	createdCluster := s.Storage.CreateKubernetesCluster(cluster)
+	// Store the Kubernetes cluster while it is provisioned
+	if err := lifecycle.Transition(&cluster.Lifecycle, storage.StatusProvisioning, lifecycle.ReasonProvisioning, "", time.Now()); err != nil {
+		return nil, s.HandleLifecycleError(err)
+	}
+	createdCluster, err := s.Storage.CreateKubernetesCluster(cluster)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Provision the Kubernetes cluster in the background
+	op, err := s.Operations.Start(storage.Operation{
+		Type:                storage.OperationCreateKubernetesCluster,
+		KubernetesClusterID: createdCluster.ID,
+		Event:               storage.EventCreate,
+		TargetStatus:        storage.StatusRunning,
+	}, s.work(createdCluster, storage.EventCreate, storage.StatusRunning, lifecycle.ReasonProvisioned, driver.CreateKubernetesCluster))
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
 
 	// Return the response
 	return connect.NewResponse(&v1.CreateKubernetesClusterResponse{
 		KubernetesCluster: base.ConvertStorageK8sToProto(createdCluster),
+		OperationId:       op.ID,
 	}), nil
 }
 
@@ -80,10 +138,24 @@ func (s *Service) GetKubernetesCluster(_ context.Context, req *connect.Request[v
 	}), nil
 }
 
-// ListKubernetesClusters retrieves all Kubernetes clusters
-func (s *Service) ListKubernetesClusters(_ context.Context, _ *connect.Request[v1.ListKubernetesClustersRequest]) (*connect.Response[v1.ListKubernetesClustersResponse], error) {
-	// Get all Kubernetes clusters from storage
-	clusters := s.Storage.ListKubernetesClusters()
+// ListKubernetesClusters retrieves a page of Kubernetes clusters
+func (s *Service) ListKubernetesClusters(_ context.Context, req *connect.Request[v1.ListKubernetesClustersRequest]) (*connect.Response[v1.ListKubernetesClustersResponse], error) {
+	// Validate the request
+	errors := validation.ValidateListKubernetesClustersRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Get the requested page of Kubernetes clusters from storage
+	clusters, nextPageToken, err := s.Storage.ListKubernetesClusters(storage.ListOptions{
+		Filter:    req.Msg.Filter,
+		OrderBy:   req.Msg.OrderBy,
+		PageSize:  req.Msg.PageSize,
+		PageToken: req.Msg.PageToken,
+	})
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
 
 	// Convert storage clusters to proto clusters
 	protoClusters := make([]*v1.KubernetesCluster, len(clusters))
@@ -94,33 +166,47 @@ func (s *Service) ListKubernetesClusters(_ context.Context, _ *connect.Request[v
 	// Return the response
 	return connect.NewResponse(&v1.ListKubernetesClustersResponse{
 		KubernetesClusters: protoClusters,
+		NextPageToken:      nextPageToken,
 	}), nil
 }
 
 // UpdateKubernetesCluster updates an existing Kubernetes cluster
-func (s *Service) UpdateKubernetesCluster(_ context.Context, req *connect.Request[v1.UpdateKubernetesClusterRequest]) (*connect.Response[v1.UpdateKubernetesClusterResponse], error) {
+func (s *Service) UpdateKubernetesCluster(ctx context.Context, req *connect.Request[v1.UpdateKubernetesClusterRequest]) (*connect.Response[v1.UpdateKubernetesClusterResponse], error) {
 	// Validate the request
 	errors := validation.ValidateUpdateKubernetesClusterRequest(req.Msg)
 	if err := s.HandleValidationErrors(errors); err != nil {
 		return nil, err
 	}
 
-	// Convert proto cluster to storage cluster
-	cluster := base.ConvertProtoK8sToStorage(req.Msg.KubernetesCluster)
+	// Get the stored Kubernetes cluster
+	storedCluster, err := s.Storage.GetKubernetesCluster(req.Msg.KubernetesCluster.Id)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
 
-	// Process the template
-	renderedTemplate, err := s.Processor.ProcessKubernetesClusterTemplate(cluster)
+	// Merge the fields covered by the update mask onto the stored cluster
+	cluster := base.MergeKubernetesCluster(storedCluster, base.ConvertProtoK8sToStorage(req.Msg.KubernetesCluster), req.Msg.GetUpdateMask().GetPaths())
+
+	// Check that the Kubernetes cluster can be updated and render it
+	previousStatus, driver, err := s.prepareUpdate(ctx, &cluster)
 	if err != nil {
-		return nil, s.HandleTemplateProcessorError(err)
+		return nil, err
 	}
 
-	// Set the rendered template
-	cluster.RenderedTemplate = renderedTemplate
+	// Stop here if the request only validates
+	if req.Msg.ValidateOnly {
+		if err := storage.CheckResourceVersion(storedCluster.ResourceVersion, cluster.ResourceVersion); err != nil {
+			return nil, s.HandleStorageError(err)
+		}
+		return connect.NewResponse(&v1.UpdateKubernetesClusterResponse{
+			KubernetesCluster: base.ConvertStorageK8sToProto(cluster),
+		}), nil
+	}
 
-	// Update the Kubernetes cluster in storage
-	updatedCluster, err := s.Storage.UpdateKubernetesCluster(cluster)
+	// Update the Kubernetes cluster in storage and apply the change in the background
+	updatedCluster, op, err := s.startUpdate(cluster, previousStatus, driver)
 	if err != nil {
-		return nil, s.HandleStorageError(err)
+		return nil, err
 	}
 
 	// Convert storage cluster to proto cluster
@@ -129,9 +215,77 @@ func (s *Service) UpdateKubernetesCluster(_ context.Context, req *connect.Reques
 	// Return the response
 	return connect.NewResponse(&v1.UpdateKubernetesClusterResponse{
 		KubernetesCluster: protoCluster,
+		OperationId:       op.ID,
 	}), nil
 }
 
+// Rerender renders a stored Kubernetes cluster with the current revision of
+// its template and applies the result like an update. Errors are returned as
+// connect errors.
+func (s *Service) Rerender(ctx context.Context, cluster storage.KubernetesCluster) (storage.KubernetesCluster, storage.Operation, error) {
+	previousStatus, driver, err := s.prepareUpdate(ctx, &cluster)
+	if err != nil {
+		return storage.KubernetesCluster{}, storage.Operation{}, err
+	}
+	return s.startUpdate(cluster, previousStatus, driver)
+}
+
+// prepareUpdate moves a Kubernetes cluster to the updating status, if it can
+// be updated in its current status, and renders it. It returns the status the
+// cluster was in and the driver that applies the change. Errors are returned
+// as connect errors.
+func (s *Service) prepareUpdate(ctx context.Context, cluster *storage.KubernetesCluster) (string, provisioner.Provisioner, error) {
+	// Check that the Kubernetes cluster can be updated in its current status
+	previousStatus := lifecycle.Status(cluster.Lifecycle)
+	if err := lifecycle.Transition(&cluster.Lifecycle, storage.StatusUpdating, lifecycle.ReasonUpdating, "", time.Now()); err != nil {
+		return "", nil, s.HandleLifecycleError(err)
+	}
+
+	// Get the driver that provisioned the Kubernetes cluster
+	_, driver, err := s.Provisioners.ForKubernetesCluster(*cluster)
+	if err != nil {
+		return "", nil, s.HandleProvisionerError(err)
+	}
+
+	// Process the template
+	result, err := s.Processor.ProcessKubernetesClusterTemplate(ctx, *cluster)
+	if err != nil {
+		return "", nil, s.HandleTemplateProcessorError(err)
+	}
+
+	// Set the rendered template and the template revision it came from,
+	// which the resource is now in sync with
+	cluster.RenderedArtifacts = result.Artifacts
+	cluster.TemplateRevision = result.Revision
+	cluster.Drift = cluster.Drift.WithState(storage.DriftInSync, time.Now())
+	return previousStatus, driver, nil
+}
+
+// startUpdate stores a Kubernetes cluster that is updating and applies the
+// change with its driver in the background. Once applied, the cluster runs
+// again, or stays stopped if it was. Errors are returned as connect errors.
+func (s *Service) startUpdate(cluster storage.KubernetesCluster, previousStatus string, driver provisioner.Provisioner) (storage.KubernetesCluster, storage.Operation, error) {
+	cluster, err := s.Storage.UpdateKubernetesCluster(cluster)
+	if err != nil {
+		return storage.KubernetesCluster{}, storage.Operation{}, s.HandleStorageError(err)
+	}
+
+	next := storage.StatusRunning
+	if previousStatus == storage.StatusStopped {
+		next = storage.StatusStopped
+	}
+	op, err := s.Operations.Start(storage.Operation{
+		Type:                storage.OperationUpdateKubernetesCluster,
+		KubernetesClusterID: cluster.ID,
+		Event:               storage.EventUpdate,
+		TargetStatus:        next,
+	}, s.work(cluster, storage.EventUpdate, next, lifecycle.ReasonUpdated, driver.UpdateKubernetesCluster))
+	if err != nil {
+		return storage.KubernetesCluster{}, storage.Operation{}, s.HandleStorageError(err)
+	}
+	return cluster, op, nil
+}
+
 // DeleteKubernetesCluster deletes a Kubernetes cluster by ID
 func (s *Service) DeleteKubernetesCluster(_ context.Context, req *connect.Request[v1.DeleteKubernetesClusterRequest]) (*connect.Response[v1.DeleteKubernetesClusterResponse], error) {
 	// Validate the request
@@ -140,18 +294,154 @@ func (s *Service) DeleteKubernetesCluster(_ context.Context, req *connect.Reques
 		return nil, err
 	}
 
-	// Delete the Kubernetes cluster from storage
-	err := s.Storage.DeleteKubernetesCluster(req.Msg.Id)
+	// Get the Kubernetes cluster and the driver that provisioned it
+	cluster, err := s.Storage.GetKubernetesCluster(req.Msg.Id)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+	_, driver, err := s.Provisioners.ForKubernetesCluster(cluster)
+	if err != nil {
+		return nil, s.HandleProvisionerError(err)
+	}
+
+	// Store the Kubernetes cluster while it is deleted, if it can be deleted
+	// in its current status
+	if err := lifecycle.Transition(&cluster.Lifecycle, storage.StatusDeleting, lifecycle.ReasonDeleting, "", time.Now()); err != nil {
+		return nil, s.HandleLifecycleError(err)
+	}
+	if req.Msg.ResourceVersion != 0 {
+		cluster.ResourceVersion = req.Msg.ResourceVersion
+	}
+	cluster, err = s.Storage.UpdateKubernetesCluster(cluster)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Remove the Kubernetes cluster from its infrastructure and then from
+	// storage in the background. On failure it is kept in the failed status
+	// and can be deleted again.
+	op, err := s.Operations.Start(storage.Operation{
+		Type:                storage.OperationDeleteKubernetesCluster,
+		KubernetesClusterID: cluster.ID,
+		Event:               storage.EventDelete,
+	}, s.deleteWork(cluster, driver))
 	if err != nil {
 		return nil, s.HandleStorageError(err)
 	}
 
 	// Return the response
 	return connect.NewResponse(&v1.DeleteKubernetesClusterResponse{
-		Success: true,
+		Success:     true,
+		OperationId: op.ID,
 	}), nil
 }
 
+// work applies a change to a stored Kubernetes cluster with apply and then
+// finishes it, moving the cluster to next with reason. Driver errors are
+// wrapped with event, the storage.Event* name of the change.
+func (s *Service) work(cluster storage.KubernetesCluster, event, next, reason string, apply func(ctx context.Context, cluster storage.KubernetesCluster) error) longrunning.Work {
+	return func(ctx context.Context, progress func(int32)) (longrunning.Result, error) {
+		err := apply(provisioner.WithProgress(ctx, progress), cluster)
+		cluster, err := s.finish(cluster, provisioner.Wrap(cluster.Provisioner, event, err), next, reason)
+		return longrunning.Result{KubernetesCluster: &cluster}, err
+	}
+}
+
+// deleteWork removes a stored Kubernetes cluster from its infrastructure and
+// then from storage
+func (s *Service) deleteWork(cluster storage.KubernetesCluster, driver provisioner.Provisioner) longrunning.Work {
+	return func(ctx context.Context, progress func(int32)) (longrunning.Result, error) {
+		if err := driver.DeleteKubernetesCluster(provisioner.WithProgress(ctx, progress), cluster); err != nil {
+			_, err = s.finish(cluster, provisioner.Wrap(cluster.Provisioner, storage.EventDelete, err), "", "")
+			return longrunning.Result{}, err
+		}
+		// The resource version was checked when the deletion started
+		if err := s.Storage.DeleteKubernetesCluster(cluster.ID, 0); err != nil {
+			return longrunning.Result{}, s.HandleStorageError(err)
+		}
+		return longrunning.Result{}, nil
+	}
+}
+
+// resume rebuilds the work of an operation on a Kubernetes cluster that was
+// interrupted by a server restart. The cluster must still be in the status
+// the operation moved it to.
+func (s *Service) resume(op storage.Operation) (longrunning.Work, error) {
+	cluster, err := s.Storage.GetKubernetesCluster(op.KubernetesClusterID)
+	if err != nil {
+		return nil, err
+	}
+	_, driver, err := s.Provisioners.ForKubernetesCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	// The status of the cluster while the operation runs, the reason it
+	// moves on with and the driver call applying the change
+	var status, reason string
+	var apply func(ctx context.Context, cluster storage.KubernetesCluster) error
+	switch op.Type {
+	case storage.OperationCreateKubernetesCluster:
+		status, reason, apply = storage.StatusProvisioning, lifecycle.ReasonProvisioned, driver.CreateKubernetesCluster
+	case storage.OperationUpdateKubernetesCluster:
+		status, reason, apply = storage.StatusUpdating, lifecycle.ReasonUpdated, driver.UpdateKubernetesCluster
+	case storage.OperationDeleteKubernetesCluster:
+		status = storage.StatusDeleting
+	default:
+		return nil, fmt.Errorf("operations of type %q cannot be resumed", op.Type)
+	}
+	if current := lifecycle.Status(cluster.Lifecycle); current != status {
+		return nil, fmt.Errorf("cluster is %s, not %s", current, status)
+	}
+
+	if op.Type == storage.OperationDeleteKubernetesCluster {
+		return s.deleteWork(cluster, driver), nil
+	}
+	if op.Event == "" || op.TargetStatus == "" {
+		return nil, fmt.Errorf("operation %s does not record how to resume it", op.ID)
+	}
+	return s.work(cluster, op.Event, op.TargetStatus, reason, apply), nil
+}
+
+// finish ends a driver operation on a stored Kubernetes cluster. It moves the
+// cluster to next with reason, or to the failed status if the operation
+// failed with opErr, and stores it. The returned error is opErr or a failure
+// to store the status, as a connect error.
+func (s *Service) finish(cluster storage.KubernetesCluster, opErr error, next, reason string) (storage.KubernetesCluster, error) {
+	message := ""
+	if opErr != nil {
+		log.Printf("Warning: Kubernetes cluster %s (%s): %v", cluster.ID, cluster.Name, opErr)
+		next, reason, message = storage.StatusFailed, lifecycle.ReasonFailed, opErr.Error()
+	}
+
+	for {
+		status := cluster.Status
+		if err := lifecycle.Transition(&cluster.Lifecycle, next, reason, message, time.Now()); err != nil {
+			return storage.KubernetesCluster{}, s.HandleLifecycleError(err)
+		}
+		stored, err := s.Storage.UpdateKubernetesCluster(cluster)
+		if err == nil && opErr != nil {
+			return stored, s.HandleProvisionerError(opErr)
+		}
+		if err == nil {
+			return stored, nil
+		}
+		if !errors.Is(err, storage.ErrConflict) {
+			return storage.KubernetesCluster{}, s.HandleStorageError(err)
+		}
+
+		// The cluster was stored meanwhile, e.g. by a drift check or a
+		// re-render. Retry on the new version unless its status changed too.
+		cluster, err = s.Storage.GetKubernetesCluster(cluster.ID)
+		if err != nil {
+			return storage.KubernetesCluster{}, s.HandleStorageError(err)
+		}
+		if cluster.Status != status {
+			return storage.KubernetesCluster{}, s.HandleLifecycleError(&lifecycle.TransitionError{From: cluster.Status, To: next})
+		}
+	}
+}
+
 // TODO: Implement GetKubernetesClusterKubeconfig after code generation
 // This method should be implemented after running the protobuf compiler to generate
 // the Go code from the updated proto file. The implementation should:
@@ -172,9 +462,71 @@ func (s *Service) GetKubernetesClusterKubeconfig(_ context.Context, req *connect
 		return nil, s.HandleStorageError(err)
 	}
 
-	// Return the rendered template as the kubeconfig
-	// Note: This assumes that the rendered template is the kubeconfig or contains it
+	// Return the kubeconfig artifact, or the main artifact for templates without one
+	kubeconfig, ok := cluster.RenderedArtifacts[kubeconfigArtifact]
+	if !ok {
+		kubeconfig = cluster.RenderedArtifacts[storage.MainArtifact]
+	}
 	return connect.NewResponse(&v1.GetKubernetesClusterKubeconfigResponse{
-		Kubeconfig: cluster.RenderedTemplate,
+		Kubeconfig: kubeconfig,
+	}), nil
+}
+
+// GetRenderedArtifact returns one rendered artifact of a Kubernetes cluster
+func (s *Service) GetRenderedArtifact(_ context.Context, req *connect.Request[v1.GetRenderedArtifactRequest]) (*connect.Response[v1.GetRenderedArtifactResponse], error) {
+	// Validate the request
+	errors := validation.ValidateKubernetesClusterGetRenderedArtifactRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Get the Kubernetes cluster from storage
+	cluster, err := s.Storage.GetKubernetesCluster(req.Msg.Id)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Look up the artifact
+	content, ok := cluster.RenderedArtifacts[req.Msg.Name]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Kubernetes cluster %q has no artifact %q", cluster.Name, req.Msg.Name))
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.GetRenderedArtifactResponse{
+		Name:    req.Msg.Name,
+		Content: content,
+	}), nil
+}
+
+// ExportRenderedArtifacts returns all rendered artifacts of a Kubernetes cluster as an archive
+func (s *Service) ExportRenderedArtifacts(_ context.Context, req *connect.Request[v1.ExportRenderedArtifactsRequest]) (*connect.Response[v1.ExportRenderedArtifactsResponse], error) {
+	// Validate the request
+	errors := validation.ValidateKubernetesClusterExportRenderedArtifactsRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Get the Kubernetes cluster from storage
+	cluster, err := s.Storage.GetKubernetesCluster(req.Msg.Id)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Pack the artifacts
+	format := base.ArchiveTarGz
+	if req.Msg.Format == v1.ExportRenderedArtifactsRequest_FORMAT_ZIP {
+		format = base.ArchiveZip
+	}
+	archive, err := base.ArchiveArtifacts(cluster.Name, cluster.RenderedArtifacts, format)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.ExportRenderedArtifactsResponse{
+		FileName:    archive.FileName,
+		ContentType: archive.ContentType,
+		Archive:     archive.Content,
 	}), nil
 }
@@ -0,0 +1,127 @@
+package operations
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"github.com/aa1ex/paas-provider/internal/longrunning"
+	"github.com/aa1ex/paas-provider/internal/server/base"
+	"github.com/aa1ex/paas-provider/internal/storage"
+	"github.com/aa1ex/paas-provider/internal/validation"
+	v1 "github.com/aa1ex/paas-provider/pkg/api/grpc/operations/v1"
+	"github.com/aa1ex/paas-provider/pkg/api/grpc/operations/v1/operationsv1connect"
+)
+
+type Service struct {
+	*base.Service
+	operationsv1connect.UnimplementedOperationServiceHandler
+	Runner *longrunning.Runner
+}
+
+func NewService(storage storage.Storage, runner *longrunning.Runner) *Service {
+	return &Service{
+		Service: base.NewService(storage, nil),
+		Runner:  runner,
+	}
+}
+
+// GetOperation retrieves an operation by ID
+func (s *Service) GetOperation(_ context.Context, req *connect.Request[v1.GetOperationRequest]) (*connect.Response[v1.GetOperationResponse], error) {
+	// Validate the request
+	errors := validation.ValidateGetOperationRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Get the operation from storage
+	op, err := s.Storage.GetOperation(req.Msg.Id)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.GetOperationResponse{
+		Operation: base.ConvertStorageOperationToProto(op),
+	}), nil
+}
+
+// ListOperations retrieves a page of operations
+func (s *Service) ListOperations(_ context.Context, req *connect.Request[v1.ListOperationsRequest]) (*connect.Response[v1.ListOperationsResponse], error) {
+	// Validate the request
+	errors := validation.ValidateListOperationsRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Get the requested page of operations from storage
+	ops, nextPageToken, err := s.Storage.ListOperations(storage.ListOptions{
+		Filter:    req.Msg.Filter,
+		OrderBy:   req.Msg.OrderBy,
+		PageSize:  req.Msg.PageSize,
+		PageToken: req.Msg.PageToken,
+	})
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Convert storage operations to proto operations
+	protoOps := make([]*v1.Operation, len(ops))
+	for i, op := range ops {
+		protoOps[i] = base.ConvertStorageOperationToProto(op)
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.ListOperationsResponse{
+		Operations:    protoOps,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// CancelOperation requests the cancellation of an operation
+func (s *Service) CancelOperation(_ context.Context, req *connect.Request[v1.CancelOperationRequest]) (*connect.Response[v1.CancelOperationResponse], error) {
+	// Validate the request
+	errors := validation.ValidateCancelOperationRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Cancel the operation
+	op, err := s.Runner.Cancel(req.Msg.Id)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.CancelOperationResponse{
+		Operation: base.ConvertStorageOperationToProto(op),
+	}), nil
+}
+
+// WaitOperation waits until an operation is done or the timeout expires
+func (s *Service) WaitOperation(ctx context.Context, req *connect.Request[v1.WaitOperationRequest]) (*connect.Response[v1.WaitOperationResponse], error) {
+	// Validate the request
+	errors := validation.ValidateWaitOperationRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Wait for the operation
+	timeout := validation.MaxOperationWaitTimeout
+	if req.Msg.Timeout != nil {
+		timeout = req.Msg.Timeout.AsDuration()
+	}
+	op, err := s.Runner.Wait(ctx, req.Msg.Id, timeout)
+	if err != nil && ctx.Err() != nil {
+		// Connect reports context errors with their own code
+		return nil, err
+	}
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.WaitOperationResponse{
+		Operation: base.ConvertStorageOperationToProto(op),
+	}), nil
+}
@@ -0,0 +1,166 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/aa1ex/paas-provider/internal/drift"
+	"github.com/aa1ex/paas-provider/internal/storage"
+	"github.com/aa1ex/paas-provider/internal/tmplproc"
+	v1 "github.com/aa1ex/paas-provider/pkg/api/grpc/template/v1"
+)
+
+// VirtualMachineRenderer applies a new render of a stored virtual machine to
+// its infrastructure, like an update of the virtual machine
+type VirtualMachineRenderer interface {
+	Rerender(ctx context.Context, vm storage.VirtualMachine) (storage.VirtualMachine, storage.Operation, error)
+}
+
+// KubernetesClusterRenderer applies a new render of a stored Kubernetes
+// cluster to its infrastructure, like an update of the cluster
+type KubernetesClusterRenderer interface {
+	Rerender(ctx context.Context, cluster storage.KubernetesCluster) (storage.KubernetesCluster, storage.Operation, error)
+}
+
+// rerenderResources re-renders the resources that use a template, or the
+// templates calling it if it is a partial, with their current revisions.
+// Resources whose artifacts change are updated like through their own service,
+// so the change goes through their status checks and is applied by their
+// driver in an operation. A resource that cannot be re-rendered or updated is
+// reported in its result and keeps its rendered artifacts. With dryRun nothing
+// is stored, and the results of changed resources carry a diff of their
+// artifacts. The returned error is only set if the resources could not be
+// listed or ctx is done, with the results of the resources handled so far.
+func (s *Service) rerenderResources(ctx context.Context, template storage.Template, dryRun bool) ([]*v1.RerenderResult, error) {
+	templateIDs := map[string]bool{template.ID: true}
+	dependents, err := s.Processor.Dependents(template)
+	if err != nil {
+		return nil, err
+	}
+	for _, dependent := range dependents {
+		templateIDs[dependent.ID] = true
+	}
+
+	var results []*v1.RerenderResult
+
+	vms, _, err := s.Storage.ListVirtualMachines(storage.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list virtual machines: %w", err)
+	}
+	for _, vm := range vms {
+		if !templateIDs[vm.TemplateID] {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+		result := &v1.RerenderResult{
+			Resource:                 &v1.RerenderResult_VirtualMachineId{VirtualMachineId: vm.ID},
+			Name:                     vm.Name,
+			TemplateId:               vm.TemplateID,
+			PreviousTemplateRevision: vm.TemplateRevision,
+		}
+		results = append(results, result)
+
+		rendered, err := s.Processor.ProcessVirtualMachineTemplate(ctx, vm)
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		recordRerender(result, vm.RenderedArtifacts, rendered, dryRun)
+		switch {
+		case dryRun || !needsStore(result, vm.Drift):
+		case result.Changed:
+			updated, op, err := s.VirtualMachines.Rerender(ctx, vm)
+			if err != nil {
+				result.Error = connectMessage(err)
+				continue
+			}
+			result.TemplateRevision, result.OperationId = updated.TemplateRevision, op.ID
+		default:
+			// Only the template revision or the drift status is behind
+			vm.TemplateRevision = rendered.Revision
+			vm.Drift = vm.Drift.WithState(storage.DriftInSync, time.Now())
+			if _, err := s.Storage.UpdateVirtualMachine(vm); err != nil {
+				result.Error = fmt.Sprintf("failed to store virtual machine: %v", err)
+			}
+		}
+	}
+
+	clusters, _, err := s.Storage.ListKubernetesClusters(storage.ListOptions{})
+	if err != nil {
+		return results, fmt.Errorf("failed to list Kubernetes clusters: %w", err)
+	}
+	for _, cluster := range clusters {
+		if !templateIDs[cluster.TemplateID] {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+		result := &v1.RerenderResult{
+			Resource:                 &v1.RerenderResult_KubernetesClusterId{KubernetesClusterId: cluster.ID},
+			Name:                     cluster.Name,
+			TemplateId:               cluster.TemplateID,
+			PreviousTemplateRevision: cluster.TemplateRevision,
+		}
+		results = append(results, result)
+
+		rendered, err := s.Processor.ProcessKubernetesClusterTemplate(ctx, cluster)
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		recordRerender(result, cluster.RenderedArtifacts, rendered, dryRun)
+		switch {
+		case dryRun || !needsStore(result, cluster.Drift):
+		case result.Changed:
+			updated, op, err := s.KubernetesClusters.Rerender(ctx, cluster)
+			if err != nil {
+				result.Error = connectMessage(err)
+				continue
+			}
+			result.TemplateRevision, result.OperationId = updated.TemplateRevision, op.ID
+		default:
+			// Only the template revision or the drift status is behind
+			cluster.TemplateRevision = rendered.Revision
+			cluster.Drift = cluster.Drift.WithState(storage.DriftInSync, time.Now())
+			if _, err := s.Storage.UpdateKubernetesCluster(cluster); err != nil {
+				result.Error = fmt.Sprintf("failed to store Kubernetes cluster: %v", err)
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// connectMessage returns the message of an error, without the code prefix of
+// connect errors
+func connectMessage(err error) string {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr.Message()
+	}
+	return err.Error()
+}
+
+// recordRerender records a successful render in a result, with a diff of the
+// artifacts for dry runs
+func recordRerender(result *v1.RerenderResult, stored storage.Artifacts, rendered tmplproc.Result, dryRun bool) {
+	result.TemplateRevision = rendered.Revision
+	result.Changed = !maps.Equal(stored, rendered.Artifacts)
+	if dryRun && result.Changed {
+		result.Diff = drift.DiffArtifacts(stored, rendered.Artifacts)
+	}
+}
+
+// needsStore reports whether a re-rendered resource differs from its stored
+// version, in its artifacts, template revision or drift state
+func needsStore(result *v1.RerenderResult, drift storage.Drift) bool {
+	return result.Changed || result.PreviousTemplateRevision != result.TemplateRevision || drift.State != storage.DriftInSync
+}
@@ -2,9 +2,16 @@ package template
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
 
 	"connectrpc.com/connect"
 
+	"github.com/aa1ex/paas-provider/internal/catalog"
+	"github.com/aa1ex/paas-provider/internal/convert"
+	"github.com/aa1ex/paas-provider/internal/drift"
 	"github.com/aa1ex/paas-provider/internal/server/base"
 	"github.com/aa1ex/paas-provider/internal/server/util"
 	"github.com/aa1ex/paas-provider/internal/storage"
@@ -17,11 +24,19 @@ import (
 type Service struct {
 	*base.Service
 	templatev1connect.UnimplementedTemplateServiceHandler
+	Catalog            *catalog.Reloader
+	Drift              *drift.Checker
+	VirtualMachines    VirtualMachineRenderer
+	KubernetesClusters KubernetesClusterRenderer
 }
 
-func NewService(storage *storage.Storage, processor *tmplproc.TemplateProcessor) *Service {
+func NewService(storage storage.Storage, processor *tmplproc.TemplateProcessor, reloader *catalog.Reloader, vms VirtualMachineRenderer, clusters KubernetesClusterRenderer) *Service {
 	return &Service{
-		Service: base.NewService(storage, processor),
+		Service:            base.NewService(storage, processor),
+		Catalog:            reloader,
+		Drift:              drift.NewChecker(storage, processor),
+		VirtualMachines:    vms,
+		KubernetesClusters: clusters,
 	}
 }
 
@@ -33,13 +48,31 @@ func (s *Service) CreateTemplate(_ context.Context, req *connect.Request[v1.Crea
 	}
 
 	// Convert proto template to storage template
-	template := base.ConvertProtoTemplateToStorage(req.Msg.Template)
+	template := convert.ProtoTemplateToStorage(req.Msg.Template)
+
+	// Check that the template only references fields its resources provide
+	if err := s.HandleValidationErrors(tmplproc.ValidateTemplate(template)); err != nil {
+		return nil, err
+	}
+
+	// Check the partials the template calls
+	if err := s.checkPartials(nil, template); err != nil {
+		return nil, err
+	}
+
+	// Stop here if the request only validates
+	if req.Msg.ValidateOnly {
+		return connect.NewResponse(&v1.CreateTemplateResponse{}), nil
+	}
 
 	// Generate ID
 	template.ID = util.GenerateID()
 
 	// Store the template
-	createdTemplate := s.Storage.CreateTemplate(template)
+	createdTemplate, err := s.Storage.CreateTemplate(template)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
 
 	// Return the response
 	return connect.NewResponse(&v1.CreateTemplateResponse{
@@ -61,7 +94,7 @@ func (s *Service) GetTemplate(_ context.Context, req *connect.Request[v1.GetTemp
 	}
 
 	// Convert storage template to proto template
-	protoTemplate := base.ConvertStorageTemplateToProto(template)
+	protoTemplate := convert.StorageTemplateToProto(template)
 
 	// Return the response
 	return connect.NewResponse(&v1.GetTemplateResponse{
@@ -83,46 +116,99 @@ func (s *Service) ListTemplates(_ context.Context, req *connect.Request[v1.ListT
 		templateType = "vm"
 	case v1.Template_TYPE_KUBERNETES:
 		templateType = "kubernetes"
+	case v1.Template_TYPE_PARTIAL:
+		templateType = "partial"
 	}
 
-	// Get templates from storage
-	templates := s.Storage.ListTemplates(templateType)
+	// Get the requested page of templates from storage
+	templates, nextPageToken, err := s.Storage.ListTemplates(templateType, storage.ListOptions{
+		Filter:    req.Msg.Filter,
+		OrderBy:   req.Msg.OrderBy,
+		PageSize:  req.Msg.PageSize,
+		PageToken: req.Msg.PageToken,
+	})
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
 
 	// Convert storage templates to proto templates
 	protoTemplates := make([]*v1.Template, len(templates))
 	for i, template := range templates {
-		protoTemplates[i] = base.ConvertStorageTemplateToProto(template)
+		protoTemplates[i] = convert.StorageTemplateToProto(template)
 	}
 
 	// Return the response
 	return connect.NewResponse(&v1.ListTemplatesResponse{
-		Templates: protoTemplates,
+		Templates:     protoTemplates,
+		NextPageToken: nextPageToken,
 	}), nil
 }
 
-func (s *Service) UpdateTemplate(_ context.Context, req *connect.Request[v1.UpdateTemplateRequest]) (*connect.Response[v1.UpdateTemplateResponse], error) {
+func (s *Service) UpdateTemplate(ctx context.Context, req *connect.Request[v1.UpdateTemplateRequest]) (*connect.Response[v1.UpdateTemplateResponse], error) {
 	// Validate the request
 	errors := validation.ValidateUpdateTemplateRequest(req.Msg)
 	if err := s.HandleValidationErrors(errors); err != nil {
 		return nil, err
 	}
 
-	// Convert proto template to storage template
-	template := base.ConvertProtoTemplateToStorage(req.Msg.Template)
+	// Get the stored template
+	storedTemplate, err := s.Storage.GetTemplate(req.Msg.Template.Id)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+	if err := checkNotFromCatalog(storedTemplate); err != nil {
+		return nil, err
+	}
+
+	// Merge the fields covered by the update mask onto the stored template
+	template := base.MergeTemplate(storedTemplate, convert.ProtoTemplateToStorage(req.Msg.Template), req.Msg.GetUpdateMask().GetPaths())
+
+	// Check that the template only references fields its resources provide
+	if err := s.HandleValidationErrors(tmplproc.ValidateTemplate(template)); err != nil {
+		return nil, err
+	}
+
+	// Check the partials the template calls, and that no template calls it
+	// if it is a partial that is renamed or becomes a regular template
+	if err := s.checkPartials(&storedTemplate, template); err != nil {
+		return nil, err
+	}
+
+	// Stop here if the request only validates
+	if req.Msg.ValidateOnly {
+		if err := storage.CheckResourceVersion(storedTemplate.ResourceVersion, template.ResourceVersion); err != nil {
+			return nil, s.HandleStorageError(err)
+		}
+		return connect.NewResponse(&v1.UpdateTemplateResponse{
+			Template: convert.StorageTemplateToProto(template),
+		}), nil
+	}
 
 	// Update the template in storage
 	updatedTemplate, err := s.Storage.UpdateTemplate(template)
 	if err != nil {
 		return nil, s.HandleStorageError(err)
 	}
+	s.Processor.Invalidate(updatedTemplate.ID)
 
 	// Convert storage template to proto template
-	protoTemplate := base.ConvertStorageTemplateToProto(updatedTemplate)
+	protoTemplate := convert.StorageTemplateToProto(updatedTemplate)
+	response := &v1.UpdateTemplateResponse{
+		Template: protoTemplate,
+	}
+
+	// Re-render the resources that use the template if requested. The template
+	// is stored by now, so a failure is reported in the response.
+	if req.Msg.RerenderDependents {
+		response.Rerendered, err = s.rerenderResources(ctx, updatedTemplate, false)
+		if err != nil {
+			log.Printf("Warning: Could not re-render the resources of template %s: %v", updatedTemplate.ID, err)
+			response.RerenderError = err.Error()
+		}
+	}
 
 	// Return the response
-	return connect.NewResponse(&v1.UpdateTemplateResponse{
-		Template: protoTemplate,
-	}), nil
+	return connect.NewResponse(response), nil
 }
 
 func (s *Service) DeleteTemplate(_ context.Context, req *connect.Request[v1.DeleteTemplateRequest]) (*connect.Response[v1.DeleteTemplateResponse], error) {
@@ -132,14 +218,406 @@ func (s *Service) DeleteTemplate(_ context.Context, req *connect.Request[v1.Dele
 		return nil, err
 	}
 
-	// Delete the template from storage
-	err := s.Storage.DeleteTemplate(req.Msg.Id)
+	// Get the stored template
+	template, err := s.Storage.GetTemplate(req.Msg.Id)
 	if err != nil {
 		return nil, s.HandleStorageError(err)
 	}
+	if err := checkNotFromCatalog(template); err != nil {
+		return nil, err
+	}
+
+	// Partials that other templates call can only be deleted with force
+	if !req.Msg.Force {
+		if err := s.checkNoDependents(template); err != nil {
+			return nil, err
+		}
+	}
+
+	// Delete the template from storage, together with its dependents if forced
+	err = s.Storage.DeleteTemplate(req.Msg.Id, req.Msg.ResourceVersion, req.Msg.Force)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+	s.Processor.Invalidate(req.Msg.Id)
 
 	// Return the response
 	return connect.NewResponse(&v1.DeleteTemplateResponse{
 		Success: true,
 	}), nil
 }
+
+func (s *Service) ListTemplateRevisions(_ context.Context, req *connect.Request[v1.ListTemplateRevisionsRequest]) (*connect.Response[v1.ListTemplateRevisionsResponse], error) {
+	// Validate the request
+	errors := validation.ValidateListTemplateRevisionsRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Get the revisions from storage
+	revisions, err := s.Storage.ListTemplateRevisions(req.Msg.TemplateId)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Convert storage revisions to proto revisions
+	protoRevisions := make([]*v1.TemplateRevision, len(revisions))
+	for i, revision := range revisions {
+		protoRevisions[i] = convert.StorageTemplateRevisionToProto(revision)
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.ListTemplateRevisionsResponse{
+		Revisions: protoRevisions,
+	}), nil
+}
+
+func (s *Service) GetTemplateRevision(_ context.Context, req *connect.Request[v1.GetTemplateRevisionRequest]) (*connect.Response[v1.GetTemplateRevisionResponse], error) {
+	// Validate the request
+	errors := validation.ValidateGetTemplateRevisionRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Get the revision from storage
+	revision, err := s.Storage.GetTemplateRevision(req.Msg.TemplateId, req.Msg.Revision)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.GetTemplateRevisionResponse{
+		Revision: convert.StorageTemplateRevisionToProto(revision),
+	}), nil
+}
+
+func (s *Service) RollbackTemplate(_ context.Context, req *connect.Request[v1.RollbackTemplateRequest]) (*connect.Response[v1.RollbackTemplateResponse], error) {
+	// Validate the request
+	errors := validation.ValidateRollbackTemplateRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Get the stored template and the revision to roll back to
+	template, err := s.Storage.GetTemplate(req.Msg.TemplateId)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+	if err := checkNotFromCatalog(template); err != nil {
+		return nil, err
+	}
+	revision, err := s.Storage.GetTemplateRevision(req.Msg.TemplateId, req.Msg.Revision)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Restore the whole content of the revision, keeping the identity and
+	// versions of the stored template; storage records it as a new revision
+	storedTemplate := template
+	template = revision.Template()
+	template.ID = storedTemplate.ID
+	template.Revision = storedTemplate.Revision
+	template.ResourceVersion = storedTemplate.ResourceVersion
+	if req.Msg.ResourceVersion != 0 {
+		template.ResourceVersion = req.Msg.ResourceVersion
+	}
+
+	// Check the revision as an update would: it may reference fields or call
+	// partials that are no longer valid
+	if err := s.HandleValidationErrors(tmplproc.ValidateTemplate(template)); err != nil {
+		return nil, err
+	}
+	if err := s.checkPartials(&storedTemplate, template); err != nil {
+		return nil, err
+	}
+
+	// Update the template in storage
+	updatedTemplate, err := s.Storage.UpdateTemplate(template)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+	s.Processor.Invalidate(updatedTemplate.ID)
+
+	// Return the response
+	return connect.NewResponse(&v1.RollbackTemplateResponse{
+		Template: convert.StorageTemplateToProto(updatedTemplate),
+	}), nil
+}
+
+func (s *Service) DescribeTemplate(_ context.Context, req *connect.Request[v1.DescribeTemplateRequest]) (*connect.Response[v1.DescribeTemplateResponse], error) {
+	// Validate the request
+	errors := validation.ValidateDescribeTemplateRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Get the template, from storage if an ID was given
+	template := storage.Template{RawTemplate: req.Msg.GetRawTemplate()}
+	if id := req.Msg.GetId(); id != "" {
+		var err error
+		template, err = s.Storage.GetTemplate(id)
+		if err != nil {
+			return nil, s.HandleStorageError(err)
+		}
+	}
+
+	// Load the partials it calls
+	partials, err := s.Processor.Dependencies(template)
+	if err != nil {
+		return nil, s.HandleTemplateProcessorError(err)
+	}
+
+	// Walk the parse trees of the template files and the partials
+	description, err := tmplproc.DescribeTemplate(template, partials)
+	if err != nil {
+		return nil, s.HandleValidationErrors(validation.Errors{{Field: "raw_template", Message: err.Error()}})
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.DescribeTemplateResponse{
+		Fields:    description.Fields,
+		Variables: description.Variables,
+		Functions: description.Functions,
+	}), nil
+}
+
+func (s *Service) RenderTemplate(ctx context.Context, req *connect.Request[v1.RenderTemplateRequest]) (*connect.Response[v1.RenderTemplateResponse], error) {
+	// Validate the request
+	errors := validation.ValidateRenderTemplateRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Render the template for the requested resource without storing anything
+	switch resource := req.Msg.Resource.(type) {
+	case *v1.RenderTemplateRequest_VirtualMachineId:
+		vm, err := s.Storage.GetVirtualMachine(resource.VirtualMachineId)
+		if err != nil {
+			return nil, s.HandleStorageError(err)
+		}
+		return s.renderVirtualMachine(ctx, vm, req.Msg)
+	case *v1.RenderTemplateRequest_KubernetesClusterId:
+		cluster, err := s.Storage.GetKubernetesCluster(resource.KubernetesClusterId)
+		if err != nil {
+			return nil, s.HandleStorageError(err)
+		}
+		return s.renderKubernetesCluster(ctx, cluster, req.Msg)
+	case *v1.RenderTemplateRequest_VirtualMachine:
+		return s.renderVirtualMachine(ctx, base.ConvertProtoVMToStorage(resource.VirtualMachine), req.Msg)
+	default:
+		return s.renderKubernetesCluster(ctx, base.ConvertProtoK8sToStorage(req.Msg.GetKubernetesCluster()), req.Msg)
+	}
+}
+
+// renderVirtualMachine renders the requested template revision for a virtual machine
+func (s *Service) renderVirtualMachine(ctx context.Context, vm storage.VirtualMachine, req *v1.RenderTemplateRequest) (*connect.Response[v1.RenderTemplateResponse], error) {
+	if req.TemplateId != "" {
+		vm.TemplateID = req.TemplateId
+	}
+
+	result, err := s.Processor.ProcessVirtualMachineTemplateRevision(ctx, vm, req.Revision)
+	if err != nil {
+		return nil, s.HandleTemplateProcessorError(err)
+	}
+
+	return connect.NewResponse(&v1.RenderTemplateResponse{
+		RenderedArtifacts: result.Artifacts,
+		TemplateRevision:  result.Revision,
+	}), nil
+}
+
+// renderKubernetesCluster renders the requested template revision for a Kubernetes cluster
+func (s *Service) renderKubernetesCluster(ctx context.Context, cluster storage.KubernetesCluster, req *v1.RenderTemplateRequest) (*connect.Response[v1.RenderTemplateResponse], error) {
+	if req.TemplateId != "" {
+		cluster.TemplateID = req.TemplateId
+	}
+
+	result, err := s.Processor.ProcessKubernetesClusterTemplateRevision(ctx, cluster, req.Revision)
+	if err != nil {
+		return nil, s.HandleTemplateProcessorError(err)
+	}
+
+	return connect.NewResponse(&v1.RenderTemplateResponse{
+		RenderedArtifacts: result.Artifacts,
+		TemplateRevision:  result.Revision,
+	}), nil
+}
+
+func (s *Service) GetTemplateDependencies(_ context.Context, req *connect.Request[v1.GetTemplateDependenciesRequest]) (*connect.Response[v1.GetTemplateDependenciesResponse], error) {
+	// Validate the request
+	errors := validation.ValidateGetTemplateDependenciesRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Get the template from storage
+	template, err := s.Storage.GetTemplate(req.Msg.Id)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Resolve the partials the template calls and the templates that call it
+	dependencies, err := s.Processor.Dependencies(template)
+	if err != nil {
+		return nil, s.HandleTemplateProcessorError(err)
+	}
+	dependents, err := s.Processor.Dependents(template)
+	if err != nil {
+		return nil, s.HandleTemplateProcessorError(err)
+	}
+
+	// Convert storage templates to references
+	response := &v1.GetTemplateDependenciesResponse{
+		Dependencies: make([]*v1.TemplateReference, len(dependencies)),
+		Dependents:   make([]*v1.TemplateReference, len(dependents)),
+	}
+	for i, dependency := range dependencies {
+		response.Dependencies[i] = convert.StorageTemplateToReference(dependency)
+	}
+	for i, dependent := range dependents {
+		response.Dependents[i] = convert.StorageTemplateToReference(dependent)
+	}
+
+	// Return the response
+	return connect.NewResponse(response), nil
+}
+
+func (s *Service) GetTemplateCatalogStatus(_ context.Context, _ *connect.Request[v1.GetTemplateCatalogStatusRequest]) (*connect.Response[v1.GetTemplateCatalogStatusResponse], error) {
+	// Get the state of the catalog after its last reload
+	status := s.Catalog.Status()
+
+	// Convert the status to proto
+	response := &v1.GetTemplateCatalogStatusResponse{
+		Dir:            status.Dir,
+		Watching:       status.Watching,
+		LastReloadTime: base.ConvertTimeToProto(status.LastReload),
+		Files:          make([]*v1.TemplateCatalogFile, len(status.Files)),
+	}
+	if status.Err != nil {
+		response.Error = status.Err.Error()
+	}
+	for i, file := range status.Files {
+		response.Files[i] = &v1.TemplateCatalogFile{
+			Path:       file.Path,
+			TemplateId: file.TemplateID,
+			Revision:   file.Revision,
+			LoadTime:   base.ConvertTimeToProto(file.LoadedAt),
+		}
+		if file.Err != nil {
+			response.Files[i].Error = file.Err.Error()
+		}
+	}
+
+	// Return the response
+	return connect.NewResponse(response), nil
+}
+
+func (s *Service) RerenderResources(ctx context.Context, req *connect.Request[v1.RerenderResourcesRequest]) (*connect.Response[v1.RerenderResourcesResponse], error) {
+	// Validate the request
+	errors := validation.ValidateRerenderResourcesRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Get the template from storage
+	template, err := s.Storage.GetTemplate(req.Msg.TemplateId)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Re-render the resources that use the template
+	results, err := s.rerenderResources(ctx, template, req.Msg.DryRun)
+	if err != nil {
+		return nil, s.HandleTemplateProcessorError(err)
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.RerenderResourcesResponse{
+		Results: results,
+	}), nil
+}
+
+func (s *Service) GetDrift(ctx context.Context, req *connect.Request[v1.GetDriftRequest]) (*connect.Response[v1.GetDriftResponse], error) {
+	// Validate the request
+	errors := validation.ValidateGetDriftRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Re-render the selected resources and compare them with the stored artifacts
+	results, err := s.Drift.Check(ctx, drift.Filter{
+		VirtualMachineID:    req.Msg.GetVirtualMachineId(),
+		KubernetesClusterID: req.Msg.GetKubernetesClusterId(),
+		TemplateID:          req.Msg.TemplateId,
+	})
+	if err != nil {
+		return nil, s.HandleTemplateProcessorError(err)
+	}
+
+	// Convert the results to proto
+	response := &v1.GetDriftResponse{
+		Results: make([]*v1.DriftResult, len(results)),
+	}
+	for i, result := range results {
+		response.Results[i] = &v1.DriftResult{
+			Name:                    result.Name,
+			TemplateId:              result.TemplateID,
+			TemplateRevision:        result.TemplateRevision,
+			CurrentTemplateRevision: result.CurrentTemplateRevision,
+			Drifted:                 result.State == storage.DriftDrifted,
+			Diff:                    result.Diff,
+		}
+		if result.VirtualMachineID != "" {
+			response.Results[i].Resource = &v1.DriftResult_VirtualMachineId{VirtualMachineId: result.VirtualMachineID}
+		} else {
+			response.Results[i].Resource = &v1.DriftResult_KubernetesClusterId{KubernetesClusterId: result.KubernetesClusterID}
+		}
+		if result.Err != nil {
+			response.Results[i].Error = result.Err.Error()
+		}
+	}
+
+	// Return the response
+	return connect.NewResponse(response), nil
+}
+
+// checkPartials checks the partials a template calls. If stored is a partial
+// that is renamed or becomes a regular template, it also checks that no other
+// template calls it.
+func (s *Service) checkPartials(stored *storage.Template, template storage.Template) error {
+	if err := s.Processor.ValidatePartials(template); err != nil {
+		return s.HandleTemplateProcessorError(err)
+	}
+	if stored != nil && stored.Type == "partial" && (template.Type != "partial" || template.Name != stored.Name) {
+		return s.checkNoDependents(*stored)
+	}
+	return nil
+}
+
+// checkNotFromCatalog fails with FailedPrecondition if a template is loaded
+// from a catalog file, which a reload would restore
+func checkNotFromCatalog(template storage.Template) error {
+	if template.CatalogFile == "" {
+		return nil
+	}
+	return connect.NewError(connect.CodeFailedPrecondition,
+		fmt.Errorf("template %q is loaded from the catalog file %s, change the file instead", template.ID, template.CatalogFile))
+}
+
+// checkNoDependents fails with FailedPrecondition if other templates call a partial
+func (s *Service) checkNoDependents(partial storage.Template) error {
+	dependents, err := s.Processor.Dependents(partial)
+	if err != nil {
+		return s.HandleTemplateProcessorError(err)
+	}
+	if len(dependents) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(dependents))
+	for i, dependent := range dependents {
+		names[i] = strconv.Quote(dependent.Name)
+	}
+	return connect.NewError(connect.CodeFailedPrecondition,
+		fmt.Errorf("partial %q is called by templates %s", partial.Name, strings.Join(names, ", ")))
+}
@@ -0,0 +1,108 @@
+package template_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/aa1ex/paas-provider/internal/server/template"
+	"github.com/aa1ex/paas-provider/internal/storage"
+	"github.com/aa1ex/paas-provider/internal/tmplproc"
+	v1 "github.com/aa1ex/paas-provider/pkg/api/grpc/template/v1"
+)
+
+func TestRollbackTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		revision string // raw template of the revision rolled back to
+		wantCode connect.Code
+	}{
+		{name: "valid revision", revision: "{{ .Name }}: {{ .CPU }}"},
+		{name: "revision referencing a field the resources do not supply", revision: "{{ .Region }}", wantCode: connect.CodeInvalidArgument},
+		{name: "revision calling a deleted partial", revision: `{{ include "gone" . }}`, wantCode: connect.CodeInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := storage.NewMemoryStorage()
+			s := template.NewService(store, tmplproc.NewTemplateProcessor(store, tmplproc.DefaultLimits), nil, nil, nil)
+
+			// Store the revision as it was accepted in the past, then a current one
+			stored, err := store.CreateTemplate(storage.Template{ID: "vm", Name: "vm", Type: "vm", RawTemplate: tt.revision})
+			if err != nil {
+				t.Fatalf("CreateTemplate: %v", err)
+			}
+			stored.RawTemplate = "{{ .Name }}"
+			if stored, err = store.UpdateTemplate(stored); err != nil {
+				t.Fatalf("UpdateTemplate: %v", err)
+			}
+
+			resp, err := s.RollbackTemplate(context.Background(), connect.NewRequest(&v1.RollbackTemplateRequest{TemplateId: "vm", Revision: 1}))
+			if tt.wantCode != 0 {
+				if code := connect.CodeOf(err); code != tt.wantCode {
+					t.Fatalf("got error %v with code %v, want %v", err, code, tt.wantCode)
+				}
+				if got, _ := store.GetTemplate("vm"); got.Revision != stored.Revision || got.RawTemplate != stored.RawTemplate {
+					t.Errorf("refused rollback stored revision %d: %q", got.Revision, got.RawTemplate)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := resp.Msg.Template; got.Revision != 3 || got.RawTemplate != tt.revision {
+				t.Errorf("rolled back to revision %d: %q, want revision 3: %q", got.Revision, got.RawTemplate, tt.revision)
+			}
+		})
+	}
+}
+
+func TestCatalogTemplatesAreReadOnly(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(s *template.Service) error
+	}{
+		{
+			name: "update",
+			call: func(s *template.Service) error {
+				_, err := s.UpdateTemplate(context.Background(), connect.NewRequest(&v1.UpdateTemplateRequest{
+					Template: &v1.Template{Id: "vm", Name: "vm", Type: v1.Template_TYPE_VM, RawTemplate: "{{ .CPU }}"},
+				}))
+				return err
+			},
+		},
+		{
+			name: "delete",
+			call: func(s *template.Service) error {
+				_, err := s.DeleteTemplate(context.Background(), connect.NewRequest(&v1.DeleteTemplateRequest{Id: "vm"}))
+				return err
+			},
+		},
+		{
+			name: "rollback",
+			call: func(s *template.Service) error {
+				_, err := s.RollbackTemplate(context.Background(), connect.NewRequest(&v1.RollbackTemplateRequest{TemplateId: "vm", Revision: 1}))
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := storage.NewMemoryStorage()
+			s := template.NewService(store, tmplproc.NewTemplateProcessor(store, tmplproc.DefaultLimits), nil, nil, nil)
+			stored, err := store.CreateTemplate(storage.Template{ID: "vm", Name: "vm", Type: "vm", RawTemplate: "{{ .Name }}", CatalogFile: "templates/vm.tmpl"})
+			if err != nil {
+				t.Fatalf("CreateTemplate: %v", err)
+			}
+
+			if code := connect.CodeOf(tt.call(s)); code != connect.CodeFailedPrecondition {
+				t.Fatalf("got code %v, want %v", code, connect.CodeFailedPrecondition)
+			}
+			if got, err := store.GetTemplate("vm"); err != nil || got.Revision != stored.Revision {
+				t.Errorf("refused call changed the template: revision %d, %v", got.Revision, err)
+			}
+		})
+	}
+}
@@ -2,9 +2,16 @@ package vm
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
 
 	"connectrpc.com/connect"
 
+	"github.com/aa1ex/paas-provider/internal/lifecycle"
+	"github.com/aa1ex/paas-provider/internal/longrunning"
+	"github.com/aa1ex/paas-provider/internal/provisioner"
 	"github.com/aa1ex/paas-provider/internal/server/base"
 	"github.com/aa1ex/paas-provider/internal/server/util"
 	"github.com/aa1ex/paas-provider/internal/storage"
@@ -17,16 +24,31 @@ import (
 type Service struct {
 	*base.Service
 	virtual_machinev1connect.UnimplementedVirtualMachineServiceHandler
+	Provisioners *provisioner.Registry
+	Operations   *longrunning.Runner
 }
 
-func NewService(storage *storage.Storage, processor *tmplproc.TemplateProcessor) *Service {
-	return &Service{
-		Service: base.NewService(storage, processor),
+func NewService(store storage.Storage, processor *tmplproc.TemplateProcessor, provisioners *provisioner.Registry, operations *longrunning.Runner) *Service {
+	s := &Service{
+		Service:      base.NewService(store, processor),
+		Provisioners: provisioners,
+		Operations:   operations,
 	}
+	operations.Resume(s.resume,
+		storage.OperationCreateVirtualMachine,
+		storage.OperationUpdateVirtualMachine,
+		storage.OperationDeleteVirtualMachine,
+		storage.OperationStartVirtualMachine,
+		storage.OperationStopVirtualMachine,
+		storage.OperationRestartVirtualMachine,
+		storage.OperationSuspendVirtualMachine,
+		storage.OperationRestoreVirtualMachine,
+	)
+	return s
 }
 
 // CreateVirtualMachine creates a new virtual machine
-func (s *Service) CreateVirtualMachine(_ context.Context, req *connect.Request[v1.CreateVirtualMachineRequest]) (*connect.Response[v1.CreateVirtualMachineResponse], error) {
+func (s *Service) CreateVirtualMachine(ctx context.Context, req *connect.Request[v1.CreateVirtualMachineRequest]) (*connect.Response[v1.CreateVirtualMachineResponse], error) {
 	// Validate the request
 	errors := validation.ValidateCreateVirtualMachineRequest(req.Msg)
 	if err := s.HandleValidationErrors(errors); err != nil {
@@ -36,24 +58,62 @@ func (s *Service) CreateVirtualMachine(_ context.Context, req *connect.Request[v
 	// Convert proto VM to storage VM
 	vm := base.ConvertProtoVMToStorage(req.Msg.VirtualMachine)
 
-	// Generate ID
-	vm.ID = util.GenerateID()
-
 	// Process the template
-	renderedTemplate, err := s.Processor.ProcessVirtualMachineTemplate(vm)
+	result, err := s.Processor.ProcessVirtualMachineTemplate(ctx, vm)
 	if err != nil {
 		return nil, s.HandleTemplateProcessorError(err)
 	}
 
-	// Set the rendered template
-	vm.RenderedTemplate = renderedTemplate
+	// Set the rendered template and the template revision it came from,
+	// which the resource is now in sync with
+	vm.RenderedArtifacts = result.Artifacts
+	vm.TemplateRevision = result.Revision
+	vm.Drift = vm.Drift.WithState(storage.DriftInSync, time.Now())
+
+	// Select the driver that provisions the virtual machine
+	name, driver, err := s.Provisioners.ForVirtualMachine(vm)
+	if err != nil {
+		return nil, s.HandleProvisionerError(err)
+	}
+	vm.Provisioner = name
+
+	// Start the lifecycle of the virtual machine
+	lifecycle.Init(&vm.Lifecycle, time.Now())
+
+	// Stop here if the request only validates
+	if req.Msg.ValidateOnly {
+		return connect.NewResponse(&v1.CreateVirtualMachineResponse{
+			VirtualMachine: base.ConvertStorageVMToProto(vm),
+		}), nil
+	}
+
+	// Generate ID
+	vm.ID = util.GenerateID()
+
+	// Store the virtual machine while it is provisioned
+	if err := lifecycle.Transition(&vm.Lifecycle, storage.StatusProvisioning, lifecycle.ReasonProvisioning, "", time.Now()); err != nil {
+		return nil, s.HandleLifecycleError(err)
+	}
+	createdVM, err := s.Storage.CreateVirtualMachine(vm)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
 
-	// Store the virtual machine
-	createdVM := s.Storage.CreateVirtualMachine(vm)
+	// Provision the virtual machine in the background
+	op, err := s.Operations.Start(storage.Operation{
+		Type:             storage.OperationCreateVirtualMachine,
+		VirtualMachineID: createdVM.ID,
+		Event:            storage.EventCreate,
+		TargetStatus:     storage.StatusRunning,
+	}, s.work(createdVM, storage.EventCreate, storage.StatusRunning, lifecycle.ReasonProvisioned, driver.CreateVirtualMachine))
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
 
 	// Return the response
 	return connect.NewResponse(&v1.CreateVirtualMachineResponse{
 		VirtualMachine: base.ConvertStorageVMToProto(createdVM),
+		OperationId:    op.ID,
 	}), nil
 }
 
@@ -80,10 +140,24 @@ func (s *Service) GetVirtualMachine(_ context.Context, req *connect.Request[v1.G
 	}), nil
 }
 
-// ListVirtualMachines retrieves all virtual machines
-func (s *Service) ListVirtualMachines(_ context.Context, _ *connect.Request[v1.ListVirtualMachinesRequest]) (*connect.Response[v1.ListVirtualMachinesResponse], error) {
-	// Get all virtual machines from storage
-	vms := s.Storage.ListVirtualMachines()
+// ListVirtualMachines retrieves a page of virtual machines
+func (s *Service) ListVirtualMachines(_ context.Context, req *connect.Request[v1.ListVirtualMachinesRequest]) (*connect.Response[v1.ListVirtualMachinesResponse], error) {
+	// Validate the request
+	errors := validation.ValidateListVirtualMachinesRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Get the requested page of virtual machines from storage
+	vms, nextPageToken, err := s.Storage.ListVirtualMachines(storage.ListOptions{
+		Filter:    req.Msg.Filter,
+		OrderBy:   req.Msg.OrderBy,
+		PageSize:  req.Msg.PageSize,
+		PageToken: req.Msg.PageToken,
+	})
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
 
 	// Convert storage VMs to proto VMs
 	protoVMs := make([]*v1.VirtualMachine, len(vms))
@@ -94,33 +168,47 @@ func (s *Service) ListVirtualMachines(_ context.Context, _ *connect.Request[v1.L
 	// Return the response
 	return connect.NewResponse(&v1.ListVirtualMachinesResponse{
 		VirtualMachines: protoVMs,
+		NextPageToken:   nextPageToken,
 	}), nil
 }
 
 // UpdateVirtualMachine updates an existing virtual machine
-func (s *Service) UpdateVirtualMachine(_ context.Context, req *connect.Request[v1.UpdateVirtualMachineRequest]) (*connect.Response[v1.UpdateVirtualMachineResponse], error) {
+func (s *Service) UpdateVirtualMachine(ctx context.Context, req *connect.Request[v1.UpdateVirtualMachineRequest]) (*connect.Response[v1.UpdateVirtualMachineResponse], error) {
 	// Validate the request
 	errors := validation.ValidateUpdateVirtualMachineRequest(req.Msg)
 	if err := s.HandleValidationErrors(errors); err != nil {
 		return nil, err
 	}
 
-	// Convert proto VM to storage VM
-	vm := base.ConvertProtoVMToStorage(req.Msg.VirtualMachine)
+	// Get the stored virtual machine
+	storedVM, err := s.Storage.GetVirtualMachine(req.Msg.VirtualMachine.Id)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
 
-	// Process the template
-	renderedTemplate, err := s.Processor.ProcessVirtualMachineTemplate(vm)
+	// Merge the fields covered by the update mask onto the stored VM
+	vm := base.MergeVirtualMachine(storedVM, base.ConvertProtoVMToStorage(req.Msg.VirtualMachine), req.Msg.GetUpdateMask().GetPaths())
+
+	// Check that the virtual machine can be updated and render it
+	previousStatus, driver, err := s.prepareUpdate(ctx, &vm)
 	if err != nil {
-		return nil, s.HandleTemplateProcessorError(err)
+		return nil, err
 	}
 
-	// Set the rendered template
-	vm.RenderedTemplate = renderedTemplate
+	// Stop here if the request only validates
+	if req.Msg.ValidateOnly {
+		if err := storage.CheckResourceVersion(storedVM.ResourceVersion, vm.ResourceVersion); err != nil {
+			return nil, s.HandleStorageError(err)
+		}
+		return connect.NewResponse(&v1.UpdateVirtualMachineResponse{
+			VirtualMachine: base.ConvertStorageVMToProto(vm),
+		}), nil
+	}
 
-	// Update the virtual machine in storage
-	updatedVM, err := s.Storage.UpdateVirtualMachine(vm)
+	// Update the virtual machine in storage and apply the change in the background
+	updatedVM, op, err := s.startUpdate(vm, previousStatus, driver, storage.EventUpdate, storage.OperationUpdateVirtualMachine, lifecycle.ReasonUpdated)
 	if err != nil {
-		return nil, s.HandleStorageError(err)
+		return nil, err
 	}
 
 	// Convert storage VM to proto VM
@@ -129,9 +217,78 @@ func (s *Service) UpdateVirtualMachine(_ context.Context, req *connect.Request[v
 	// Return the response
 	return connect.NewResponse(&v1.UpdateVirtualMachineResponse{
 		VirtualMachine: protoVM,
+		OperationId:    op.ID,
 	}), nil
 }
 
+// Rerender renders a stored virtual machine with the current revision of its
+// template and applies the result like an update. Errors are returned as
+// connect errors.
+func (s *Service) Rerender(ctx context.Context, vm storage.VirtualMachine) (storage.VirtualMachine, storage.Operation, error) {
+	previousStatus, driver, err := s.prepareUpdate(ctx, &vm)
+	if err != nil {
+		return storage.VirtualMachine{}, storage.Operation{}, err
+	}
+	return s.startUpdate(vm, previousStatus, driver, storage.EventUpdate, storage.OperationUpdateVirtualMachine, lifecycle.ReasonUpdated)
+}
+
+// prepareUpdate moves a virtual machine to the updating status, if it can be
+// updated in its current status, and renders it. It returns the status the
+// virtual machine was in and the driver that applies the change. Errors are
+// returned as connect errors.
+func (s *Service) prepareUpdate(ctx context.Context, vm *storage.VirtualMachine) (string, provisioner.Provisioner, error) {
+	// Check that the virtual machine can be updated in its current status
+	previousStatus := lifecycle.Status(vm.Lifecycle)
+	if err := lifecycle.Transition(&vm.Lifecycle, storage.StatusUpdating, lifecycle.ReasonUpdating, "", time.Now()); err != nil {
+		return "", nil, s.HandleLifecycleError(err)
+	}
+
+	// Get the driver that provisioned the virtual machine
+	_, driver, err := s.Provisioners.ForVirtualMachine(*vm)
+	if err != nil {
+		return "", nil, s.HandleProvisionerError(err)
+	}
+
+	// Process the template
+	result, err := s.Processor.ProcessVirtualMachineTemplate(ctx, *vm)
+	if err != nil {
+		return "", nil, s.HandleTemplateProcessorError(err)
+	}
+
+	// Set the rendered template and the template revision it came from,
+	// which the resource is now in sync with
+	vm.RenderedArtifacts = result.Artifacts
+	vm.TemplateRevision = result.Revision
+	vm.Drift = vm.Drift.WithState(storage.DriftInSync, time.Now())
+	return previousStatus, driver, nil
+}
+
+// startUpdate stores a virtual machine that is updating and applies the change
+// with its driver in the background. Once applied, the virtual machine runs
+// again with reason, or stays stopped if it was. Errors are returned as
+// connect errors.
+func (s *Service) startUpdate(vm storage.VirtualMachine, previousStatus string, driver provisioner.Provisioner, event, operation, reason string) (storage.VirtualMachine, storage.Operation, error) {
+	vm, err := s.Storage.UpdateVirtualMachine(vm)
+	if err != nil {
+		return storage.VirtualMachine{}, storage.Operation{}, s.HandleStorageError(err)
+	}
+
+	next := storage.StatusRunning
+	if previousStatus == storage.StatusStopped {
+		next = storage.StatusStopped
+	}
+	op, err := s.Operations.Start(storage.Operation{
+		Type:             operation,
+		VirtualMachineID: vm.ID,
+		Event:            event,
+		TargetStatus:     next,
+	}, s.work(vm, event, next, reason, driver.UpdateVirtualMachine))
+	if err != nil {
+		return storage.VirtualMachine{}, storage.Operation{}, s.HandleStorageError(err)
+	}
+	return vm, op, nil
+}
+
 // DeleteVirtualMachine deletes a virtual machine by ID
 func (s *Service) DeleteVirtualMachine(_ context.Context, req *connect.Request[v1.DeleteVirtualMachineRequest]) (*connect.Response[v1.DeleteVirtualMachineResponse], error) {
 	// Validate the request
@@ -140,14 +297,407 @@ func (s *Service) DeleteVirtualMachine(_ context.Context, req *connect.Request[v
 		return nil, err
 	}
 
-	// Delete the virtual machine from storage
-	err := s.Storage.DeleteVirtualMachine(req.Msg.Id)
+	// Get the virtual machine and the driver that provisioned it
+	vm, err := s.Storage.GetVirtualMachine(req.Msg.Id)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+	_, driver, err := s.Provisioners.ForVirtualMachine(vm)
+	if err != nil {
+		return nil, s.HandleProvisionerError(err)
+	}
+
+	// Store the virtual machine while it is deleted, if it can be deleted in
+	// its current status
+	if err := lifecycle.Transition(&vm.Lifecycle, storage.StatusDeleting, lifecycle.ReasonDeleting, "", time.Now()); err != nil {
+		return nil, s.HandleLifecycleError(err)
+	}
+	if req.Msg.ResourceVersion != 0 {
+		vm.ResourceVersion = req.Msg.ResourceVersion
+	}
+	vm, err = s.Storage.UpdateVirtualMachine(vm)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Remove the virtual machine from its infrastructure and then from
+	// storage in the background. On failure it is kept in the failed status
+	// and can be deleted again.
+	op, err := s.Operations.Start(storage.Operation{
+		Type:             storage.OperationDeleteVirtualMachine,
+		VirtualMachineID: vm.ID,
+		Event:            storage.EventDelete,
+	}, s.deleteWork(vm, driver))
 	if err != nil {
 		return nil, s.HandleStorageError(err)
 	}
 
 	// Return the response
 	return connect.NewResponse(&v1.DeleteVirtualMachineResponse{
-		Success: true,
+		Success:     true,
+		OperationId: op.ID,
+	}), nil
+}
+
+// StartVirtualMachine starts a stopped, suspended or failed virtual machine
+func (s *Service) StartVirtualMachine(_ context.Context, req *connect.Request[v1.StartVirtualMachineRequest]) (*connect.Response[v1.StartVirtualMachineResponse], error) {
+	// Validate the request
+	errors := validation.ValidateStartVirtualMachineRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Provision the virtual machine again to start it
+	vm, op, err := s.startPowerAction(req.Msg.Id, req.Msg.ResourceVersion, powerAction{
+		event:     storage.EventStart,
+		operation: storage.OperationStartVirtualMachine,
+		status:    storage.StatusProvisioning,
+		reason:    lifecycle.ReasonStarting,
+		next:      storage.StatusRunning,
+		done:      lifecycle.ReasonStarted,
+		apply: func(ctx context.Context, driver provisioner.Provisioner, vm storage.VirtualMachine) error {
+			return driver.StartVirtualMachine(ctx, vm)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.StartVirtualMachineResponse{
+		VirtualMachine: base.ConvertStorageVMToProto(vm),
+		OperationId:    op.ID,
+	}), nil
+}
+
+// StopVirtualMachine stops a running, suspended or failed virtual machine, gracefully or forcibly
+func (s *Service) StopVirtualMachine(_ context.Context, req *connect.Request[v1.StopVirtualMachineRequest]) (*connect.Response[v1.StopVirtualMachineResponse], error) {
+	// Validate the request
+	errors := validation.ValidateStopVirtualMachineRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// A forced stop is recorded as such in the event history
+	event := storage.EventStop
+	if req.Msg.Force {
+		event = storage.EventForceStop
+	}
+
+	// Stop the virtual machine
+	vm, op, err := s.startPowerAction(req.Msg.Id, req.Msg.ResourceVersion, powerAction{
+		event:     event,
+		operation: storage.OperationStopVirtualMachine,
+		status:    storage.StatusStopping,
+		reason:    lifecycle.ReasonStopping,
+		next:      storage.StatusStopped,
+		done:      lifecycle.ReasonStopped,
+		apply: func(ctx context.Context, driver provisioner.Provisioner, vm storage.VirtualMachine) error {
+			return driver.StopVirtualMachine(ctx, vm, req.Msg.Force)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.StopVirtualMachineResponse{
+		VirtualMachine: base.ConvertStorageVMToProto(vm),
+		OperationId:    op.ID,
+	}), nil
+}
+
+// RestartVirtualMachine restarts a running virtual machine
+func (s *Service) RestartVirtualMachine(_ context.Context, req *connect.Request[v1.RestartVirtualMachineRequest]) (*connect.Response[v1.RestartVirtualMachineResponse], error) {
+	// Validate the request
+	errors := validation.ValidateRestartVirtualMachineRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Restart the virtual machine
+	vm, op, err := s.startPowerAction(req.Msg.Id, req.Msg.ResourceVersion, powerAction{
+		event:     storage.EventRestart,
+		operation: storage.OperationRestartVirtualMachine,
+		status:    storage.StatusRestarting,
+		reason:    lifecycle.ReasonRestarting,
+		next:      storage.StatusRunning,
+		done:      lifecycle.ReasonRestarted,
+		apply: func(ctx context.Context, driver provisioner.Provisioner, vm storage.VirtualMachine) error {
+			return driver.RestartVirtualMachine(ctx, vm)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.RestartVirtualMachineResponse{
+		VirtualMachine: base.ConvertStorageVMToProto(vm),
+		OperationId:    op.ID,
+	}), nil
+}
+
+// SuspendVirtualMachine suspends a running virtual machine
+func (s *Service) SuspendVirtualMachine(_ context.Context, req *connect.Request[v1.SuspendVirtualMachineRequest]) (*connect.Response[v1.SuspendVirtualMachineResponse], error) {
+	// Validate the request
+	errors := validation.ValidateSuspendVirtualMachineRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Suspend the virtual machine
+	vm, op, err := s.startPowerAction(req.Msg.Id, req.Msg.ResourceVersion, powerAction{
+		event:     storage.EventSuspend,
+		operation: storage.OperationSuspendVirtualMachine,
+		status:    storage.StatusSuspending,
+		reason:    lifecycle.ReasonSuspending,
+		next:      storage.StatusSuspended,
+		done:      lifecycle.ReasonSuspended,
+		apply: func(ctx context.Context, driver provisioner.Provisioner, vm storage.VirtualMachine) error {
+			return driver.SuspendVirtualMachine(ctx, vm)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.SuspendVirtualMachineResponse{
+		VirtualMachine: base.ConvertStorageVMToProto(vm),
+		OperationId:    op.ID,
+	}), nil
+}
+
+// powerAction is how a power action moves a virtual machine through its lifecycle
+type powerAction struct {
+	event     string // recorded in the event history, and the operation of driver errors
+	operation string // type of the operation applying the action
+	status    string // status while the driver acts, entered with reason
+	reason    string
+	next      string // status once the driver is done, entered with done
+	done      string
+	apply     func(ctx context.Context, driver provisioner.Provisioner, vm storage.VirtualMachine) error
+}
+
+// startPowerAction stores a virtual machine in the status of a power action,
+// if it can change to it from its current status, and applies the action in
+// the background. Errors are returned as connect errors.
+func (s *Service) startPowerAction(id string, resourceVersion int64, action powerAction) (storage.VirtualMachine, storage.Operation, error) {
+	// Get the virtual machine and the driver that provisioned it
+	vm, err := s.Storage.GetVirtualMachine(id)
+	if err != nil {
+		return storage.VirtualMachine{}, storage.Operation{}, s.HandleStorageError(err)
+	}
+	_, driver, err := s.Provisioners.ForVirtualMachine(vm)
+	if err != nil {
+		return storage.VirtualMachine{}, storage.Operation{}, s.HandleProvisionerError(err)
+	}
+
+	// Store the virtual machine while the action is applied
+	if err := lifecycle.Transition(&vm.Lifecycle, action.status, action.reason, "", time.Now()); err != nil {
+		return storage.VirtualMachine{}, storage.Operation{}, s.HandleLifecycleError(err)
+	}
+	if resourceVersion != 0 {
+		vm.ResourceVersion = resourceVersion
+	}
+	vm, err = s.Storage.UpdateVirtualMachine(vm)
+	if err != nil {
+		return storage.VirtualMachine{}, storage.Operation{}, s.HandleStorageError(err)
+	}
+
+	// Apply the action in the background
+	op, err := s.Operations.Start(storage.Operation{
+		Type:             action.operation,
+		VirtualMachineID: vm.ID,
+		Event:            action.event,
+		TargetStatus:     action.next,
+	}, s.work(vm, action.event, action.next, action.done, func(ctx context.Context, vm storage.VirtualMachine) error {
+		return action.apply(ctx, driver, vm)
+	}))
+	if err != nil {
+		return storage.VirtualMachine{}, storage.Operation{}, s.HandleStorageError(err)
+	}
+	return vm, op, nil
+}
+
+// work applies a change to a stored virtual machine with apply and then
+// finishes it, moving the virtual machine to next with reason and recording
+// event in its history
+func (s *Service) work(vm storage.VirtualMachine, event, next, reason string, apply func(ctx context.Context, vm storage.VirtualMachine) error) longrunning.Work {
+	return func(ctx context.Context, progress func(int32)) (longrunning.Result, error) {
+		err := apply(provisioner.WithProgress(ctx, progress), vm)
+		vm, err := s.finish(vm, event, provisioner.Wrap(vm.Provisioner, event, err), next, reason)
+		return longrunning.Result{VirtualMachine: &vm}, err
+	}
+}
+
+// deleteWork removes a stored virtual machine from its infrastructure and
+// then from storage
+func (s *Service) deleteWork(vm storage.VirtualMachine, driver provisioner.Provisioner) longrunning.Work {
+	return func(ctx context.Context, progress func(int32)) (longrunning.Result, error) {
+		if err := driver.DeleteVirtualMachine(provisioner.WithProgress(ctx, progress), vm); err != nil {
+			_, err = s.finish(vm, storage.EventDelete, provisioner.Wrap(vm.Provisioner, storage.EventDelete, err), "", "")
+			return longrunning.Result{}, err
+		}
+		// The resource version was checked when the deletion started
+		if err := s.Storage.DeleteVirtualMachine(vm.ID, 0); err != nil {
+			return longrunning.Result{}, s.HandleStorageError(err)
+		}
+		return longrunning.Result{}, nil
+	}
+}
+
+// resume rebuilds the work of an operation on a virtual machine that was
+// interrupted by a server restart. The virtual machine must still be in the
+// status the operation moved it to.
+func (s *Service) resume(op storage.Operation) (longrunning.Work, error) {
+	vm, err := s.Storage.GetVirtualMachine(op.VirtualMachineID)
+	if err != nil {
+		return nil, err
+	}
+	_, driver, err := s.Provisioners.ForVirtualMachine(vm)
+	if err != nil {
+		return nil, err
+	}
+
+	// The status of the virtual machine while the operation runs, the reason
+	// it moves on with and the driver call applying the change
+	var status, reason string
+	var apply func(ctx context.Context, vm storage.VirtualMachine) error
+	switch op.Type {
+	case storage.OperationCreateVirtualMachine:
+		status, reason, apply = storage.StatusProvisioning, lifecycle.ReasonProvisioned, driver.CreateVirtualMachine
+	case storage.OperationUpdateVirtualMachine:
+		status, reason, apply = storage.StatusUpdating, lifecycle.ReasonUpdated, driver.UpdateVirtualMachine
+	case storage.OperationRestoreVirtualMachine:
+		status, reason, apply = storage.StatusUpdating, lifecycle.ReasonRestored, driver.UpdateVirtualMachine
+	case storage.OperationStartVirtualMachine:
+		status, reason, apply = storage.StatusProvisioning, lifecycle.ReasonStarted, driver.StartVirtualMachine
+	case storage.OperationStopVirtualMachine:
+		force := op.Event == storage.EventForceStop
+		status, reason, apply = storage.StatusStopping, lifecycle.ReasonStopped, func(ctx context.Context, vm storage.VirtualMachine) error {
+			return driver.StopVirtualMachine(ctx, vm, force)
+		}
+	case storage.OperationRestartVirtualMachine:
+		status, reason, apply = storage.StatusRestarting, lifecycle.ReasonRestarted, driver.RestartVirtualMachine
+	case storage.OperationSuspendVirtualMachine:
+		status, reason, apply = storage.StatusSuspending, lifecycle.ReasonSuspended, driver.SuspendVirtualMachine
+	case storage.OperationDeleteVirtualMachine:
+		status = storage.StatusDeleting
+	default:
+		return nil, fmt.Errorf("operations of type %q cannot be resumed", op.Type)
+	}
+	if current := lifecycle.Status(vm.Lifecycle); current != status {
+		return nil, fmt.Errorf("virtual machine is %s, not %s", current, status)
+	}
+
+	if op.Type == storage.OperationDeleteVirtualMachine {
+		return s.deleteWork(vm, driver), nil
+	}
+	if op.Event == "" || op.TargetStatus == "" {
+		return nil, fmt.Errorf("operation %s does not record how to resume it", op.ID)
+	}
+	return s.work(vm, op.Event, op.TargetStatus, reason, apply), nil
+}
+
+// finish ends a driver operation on a stored virtual machine. It moves the
+// virtual machine to next with reason, or to the failed status if the
+// operation failed with opErr, records the outcome of action in its event
+// history and stores it. The returned error is opErr or a failure to store
+// the status, as a connect error.
+func (s *Service) finish(vm storage.VirtualMachine, action string, opErr error, next, reason string) (storage.VirtualMachine, error) {
+	message := ""
+	if opErr != nil {
+		log.Printf("Warning: Virtual machine %s (%s): %v", vm.ID, vm.Name, opErr)
+		next, reason, message = storage.StatusFailed, lifecycle.ReasonFailed, opErr.Error()
+	}
+
+	for {
+		status := vm.Status
+		now := time.Now()
+		if err := lifecycle.Transition(&vm.Lifecycle, next, reason, message, now); err != nil {
+			return storage.VirtualMachine{}, s.HandleLifecycleError(err)
+		}
+		vm.RecordEvent(storage.Event{Action: action, Succeeded: opErr == nil, Message: message, Time: now})
+		stored, err := s.Storage.UpdateVirtualMachine(vm)
+		if err == nil && opErr != nil {
+			return stored, s.HandleProvisionerError(opErr)
+		}
+		if err == nil {
+			return stored, nil
+		}
+		if !errors.Is(err, storage.ErrConflict) {
+			return storage.VirtualMachine{}, s.HandleStorageError(err)
+		}
+
+		// The virtual machine was stored meanwhile, e.g. by a drift check or a
+		// re-render. Retry on the new version unless its status changed too.
+		vm, err = s.Storage.GetVirtualMachine(vm.ID)
+		if err != nil {
+			return storage.VirtualMachine{}, s.HandleStorageError(err)
+		}
+		if vm.Status != status {
+			return storage.VirtualMachine{}, s.HandleLifecycleError(&lifecycle.TransitionError{From: vm.Status, To: next})
+		}
+	}
+}
+
+// GetRenderedArtifact returns one rendered artifact of a virtual machine
+func (s *Service) GetRenderedArtifact(_ context.Context, req *connect.Request[v1.GetRenderedArtifactRequest]) (*connect.Response[v1.GetRenderedArtifactResponse], error) {
+	// Validate the request
+	errors := validation.ValidateVirtualMachineGetRenderedArtifactRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Get the virtual machine from storage
+	vm, err := s.Storage.GetVirtualMachine(req.Msg.Id)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Look up the artifact
+	content, ok := vm.RenderedArtifacts[req.Msg.Name]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("virtual machine %q has no artifact %q", vm.Name, req.Msg.Name))
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.GetRenderedArtifactResponse{
+		Name:    req.Msg.Name,
+		Content: content,
+	}), nil
+}
+
+// ExportRenderedArtifacts returns all rendered artifacts of a virtual machine as an archive
+func (s *Service) ExportRenderedArtifacts(_ context.Context, req *connect.Request[v1.ExportRenderedArtifactsRequest]) (*connect.Response[v1.ExportRenderedArtifactsResponse], error) {
+	// Validate the request
+	errors := validation.ValidateVirtualMachineExportRenderedArtifactsRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Get the virtual machine from storage
+	vm, err := s.Storage.GetVirtualMachine(req.Msg.Id)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Pack the artifacts
+	format := base.ArchiveTarGz
+	if req.Msg.Format == v1.ExportRenderedArtifactsRequest_FORMAT_ZIP {
+		format = base.ArchiveZip
+	}
+	archive, err := base.ArchiveArtifacts(vm.Name, vm.RenderedArtifacts, format)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.ExportRenderedArtifactsResponse{
+		FileName:    archive.FileName,
+		ContentType: archive.ContentType,
+		Archive:     archive.Content,
 	}), nil
 }
@@ -0,0 +1,187 @@
+package vm_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/aa1ex/paas-provider/internal/longrunning"
+	"github.com/aa1ex/paas-provider/internal/provisioner"
+	"github.com/aa1ex/paas-provider/internal/server/vm"
+	"github.com/aa1ex/paas-provider/internal/storage"
+	"github.com/aa1ex/paas-provider/internal/tmplproc"
+	v1 "github.com/aa1ex/paas-provider/pkg/api/grpc/virtual_machine/v1"
+)
+
+// newService creates a virtual machine service on a memory storage holding a
+// virtual machine template, with a fake driver that acts at once
+func newService(t *testing.T) (*vm.Service, storage.Storage, *longrunning.Runner) {
+	t.Helper()
+	s := storage.NewMemoryStorage()
+	if _, err := s.CreateTemplate(storage.Template{
+		ID:           "vm",
+		Name:         "vm",
+		Type:         "vm",
+		RawTemplate:  "{{ .Name }}: {{ .CPU }}",
+		Parameters:   []storage.Parameter{{Name: "disk", Type: "integer"}},
+		OutputFormat: "plain",
+	}); err != nil {
+		t.Fatalf("CreateTemplate: %v", err)
+	}
+	provisioners, err := provisioner.NewRegistry(provisioner.Config{})
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+	runner := longrunning.NewRunner(context.Background(), s, 1)
+	t.Cleanup(func() { runner.Close(time.Second) })
+	return vm.NewService(s, tmplproc.NewTemplateProcessor(s, tmplproc.DefaultLimits), provisioners, runner), s, runner
+}
+
+// storeVM stores a virtual machine in a status
+func storeVM(t *testing.T, s storage.Storage, status string) storage.VirtualMachine {
+	t.Helper()
+	vm, err := s.CreateVirtualMachine(storage.VirtualMachine{
+		ID:          "vm1",
+		Name:        "web",
+		CPU:         2,
+		TemplateID:  "vm",
+		Provisioner: provisioner.DefaultDriver,
+		Lifecycle:   storage.Lifecycle{Status: status},
+	})
+	if err != nil {
+		t.Fatalf("CreateVirtualMachine: %v", err)
+	}
+	return vm
+}
+
+// wait waits for an operation and returns it
+func wait(t *testing.T, runner *longrunning.Runner, id string) storage.Operation {
+	t.Helper()
+	op, err := runner.Wait(context.Background(), id, time.Second)
+	if err != nil {
+		t.Fatalf("Wait(%s): %v", id, err)
+	}
+	if !op.Done() {
+		t.Fatalf("operation %s is still %s", id, op.State)
+	}
+	return op
+}
+
+func TestPowerActions(t *testing.T) {
+	type action func(s *vm.Service, id string) (string, error)
+	start := func(s *vm.Service, id string) (string, error) {
+		resp, err := s.StartVirtualMachine(context.Background(), connect.NewRequest(&v1.StartVirtualMachineRequest{Id: id}))
+		if err != nil {
+			return "", err
+		}
+		return resp.Msg.OperationId, nil
+	}
+	stop := func(s *vm.Service, id string) (string, error) {
+		resp, err := s.StopVirtualMachine(context.Background(), connect.NewRequest(&v1.StopVirtualMachineRequest{Id: id}))
+		if err != nil {
+			return "", err
+		}
+		return resp.Msg.OperationId, nil
+	}
+	forceStop := func(s *vm.Service, id string) (string, error) {
+		resp, err := s.StopVirtualMachine(context.Background(), connect.NewRequest(&v1.StopVirtualMachineRequest{Id: id, Force: true}))
+		if err != nil {
+			return "", err
+		}
+		return resp.Msg.OperationId, nil
+	}
+	restart := func(s *vm.Service, id string) (string, error) {
+		resp, err := s.RestartVirtualMachine(context.Background(), connect.NewRequest(&v1.RestartVirtualMachineRequest{Id: id}))
+		if err != nil {
+			return "", err
+		}
+		return resp.Msg.OperationId, nil
+	}
+	suspend := func(s *vm.Service, id string) (string, error) {
+		resp, err := s.SuspendVirtualMachine(context.Background(), connect.NewRequest(&v1.SuspendVirtualMachineRequest{Id: id}))
+		if err != nil {
+			return "", err
+		}
+		return resp.Msg.OperationId, nil
+	}
+
+	tests := []struct {
+		name       string
+		status     string
+		action     action
+		wantStatus string // empty if the action is refused
+		wantEvent  string
+	}{
+		{name: "start stopped", status: storage.StatusStopped, action: start, wantStatus: storage.StatusRunning, wantEvent: storage.EventStart},
+		{name: "start suspended", status: storage.StatusSuspended, action: start, wantStatus: storage.StatusRunning, wantEvent: storage.EventStart},
+		{name: "start failed", status: storage.StatusFailed, action: start, wantStatus: storage.StatusRunning, wantEvent: storage.EventStart},
+		{name: "start running", status: storage.StatusRunning, action: start},
+		{name: "start deleting", status: storage.StatusDeleting, action: start},
+		{name: "stop running", status: storage.StatusRunning, action: stop, wantStatus: storage.StatusStopped, wantEvent: storage.EventStop},
+		{name: "force stop suspended", status: storage.StatusSuspended, action: forceStop, wantStatus: storage.StatusStopped, wantEvent: storage.EventForceStop},
+		{name: "stop stopped", status: storage.StatusStopped, action: stop},
+		{name: "stop provisioning", status: storage.StatusProvisioning, action: stop},
+		{name: "restart running", status: storage.StatusRunning, action: restart, wantStatus: storage.StatusRunning, wantEvent: storage.EventRestart},
+		{name: "restart stopped", status: storage.StatusStopped, action: restart},
+		{name: "restart suspended", status: storage.StatusSuspended, action: restart},
+		{name: "suspend running", status: storage.StatusRunning, action: suspend, wantStatus: storage.StatusSuspended, wantEvent: storage.EventSuspend},
+		{name: "suspend suspended", status: storage.StatusSuspended, action: suspend},
+		{name: "suspend stopped", status: storage.StatusStopped, action: suspend},
+		{name: "suspend failed", status: storage.StatusFailed, action: suspend},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, store, runner := newService(t)
+			stored := storeVM(t, store, tt.status)
+
+			opID, err := tt.action(s, stored.ID)
+			if tt.wantStatus == "" {
+				if code := connect.CodeOf(err); code != connect.CodeFailedPrecondition {
+					t.Fatalf("got error %v with code %v, want %v", err, code, connect.CodeFailedPrecondition)
+				}
+				if got, _ := store.GetVirtualMachine(stored.ID); got.Status != tt.status || got.ResourceVersion != stored.ResourceVersion {
+					t.Errorf("refused action changed the virtual machine to %s at version %d", got.Status, got.ResourceVersion)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if op := wait(t, runner, opID); op.State != storage.OperationSucceeded {
+				t.Fatalf("operation is %s: %s", op.State, op.ErrorMessage)
+			}
+			got, err := store.GetVirtualMachine(stored.ID)
+			if err != nil {
+				t.Fatalf("GetVirtualMachine: %v", err)
+			}
+			if got.Status != tt.wantStatus {
+				t.Errorf("virtual machine is %s, want %s", got.Status, tt.wantStatus)
+			}
+			if len(got.Events) == 0 || got.Events[len(got.Events)-1].Action != tt.wantEvent {
+				t.Errorf("events = %+v, want the last to be %s", got.Events, tt.wantEvent)
+			}
+		})
+	}
+}
+
+func TestPowerActionResourceVersion(t *testing.T) {
+	s, store, runner := newService(t)
+	stored := storeVM(t, store, storage.StatusRunning)
+
+	// A stale resource version is refused
+	_, err := s.StopVirtualMachine(context.Background(), connect.NewRequest(&v1.StopVirtualMachineRequest{Id: stored.ID, ResourceVersion: stored.ResourceVersion + 1}))
+	if code := connect.CodeOf(err); code != connect.CodeAborted {
+		t.Fatalf("got error %v with code %v, want %v", err, code, connect.CodeAborted)
+	}
+
+	// An unset resource version keeps the stored one
+	resp, err := s.StopVirtualMachine(context.Background(), connect.NewRequest(&v1.StopVirtualMachineRequest{Id: stored.ID}))
+	if err != nil {
+		t.Fatalf("StopVirtualMachine: %v", err)
+	}
+	wait(t, runner, resp.Msg.OperationId)
+}
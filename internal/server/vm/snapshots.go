@@ -0,0 +1,200 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/aa1ex/paas-provider/internal/drift"
+	"github.com/aa1ex/paas-provider/internal/lifecycle"
+	"github.com/aa1ex/paas-provider/internal/server/base"
+	"github.com/aa1ex/paas-provider/internal/server/util"
+	"github.com/aa1ex/paas-provider/internal/storage"
+	"github.com/aa1ex/paas-provider/internal/validation"
+	v1 "github.com/aa1ex/paas-provider/pkg/api/grpc/virtual_machine/v1"
+)
+
+// CreateVirtualMachineSnapshot captures the configuration and rendered artifacts of a virtual machine
+func (s *Service) CreateVirtualMachineSnapshot(_ context.Context, req *connect.Request[v1.CreateVirtualMachineSnapshotRequest]) (*connect.Response[v1.CreateVirtualMachineSnapshotResponse], error) {
+	// Validate the request
+	errors := validation.ValidateCreateVirtualMachineSnapshotRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Get the virtual machine from storage
+	vm, err := s.Storage.GetVirtualMachine(req.Msg.VirtualMachineId)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Store the snapshot
+	snapshot, err := s.Storage.CreateVirtualMachineSnapshot(storage.VirtualMachineSnapshot{
+		ID:                util.GenerateID(),
+		VirtualMachineID:  vm.ID,
+		Name:              req.Msg.Name,
+		CPU:               vm.CPU,
+		Memory:            vm.Memory,
+		OS:                vm.OS,
+		TemplateID:        vm.TemplateID,
+		TemplateRevision:  vm.TemplateRevision,
+		Parameters:        maps.Clone(vm.Parameters),
+		RenderedArtifacts: maps.Clone(vm.RenderedArtifacts),
+	})
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.CreateVirtualMachineSnapshotResponse{
+		Snapshot: base.ConvertStorageVMSnapshotToProto(snapshot),
+	}), nil
+}
+
+// ListVirtualMachineSnapshots retrieves a page of the snapshots of a virtual machine
+func (s *Service) ListVirtualMachineSnapshots(_ context.Context, req *connect.Request[v1.ListVirtualMachineSnapshotsRequest]) (*connect.Response[v1.ListVirtualMachineSnapshotsResponse], error) {
+	// Validate the request
+	errors := validation.ValidateListVirtualMachineSnapshotsRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Check that the virtual machine exists
+	if _, err := s.Storage.GetVirtualMachine(req.Msg.VirtualMachineId); err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Get the requested page of snapshots from storage
+	snapshots, nextPageToken, err := s.Storage.ListVirtualMachineSnapshots(req.Msg.VirtualMachineId, storage.ListOptions{
+		Filter:    req.Msg.Filter,
+		OrderBy:   req.Msg.OrderBy,
+		PageSize:  req.Msg.PageSize,
+		PageToken: req.Msg.PageToken,
+	})
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Convert storage snapshots to proto snapshots
+	protoSnapshots := make([]*v1.VirtualMachineSnapshot, len(snapshots))
+	for i, snapshot := range snapshots {
+		protoSnapshots[i] = base.ConvertStorageVMSnapshotToProto(snapshot)
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.ListVirtualMachineSnapshotsResponse{
+		Snapshots:     protoSnapshots,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// RestoreVirtualMachineSnapshot rolls a virtual machine back to a snapshot
+func (s *Service) RestoreVirtualMachineSnapshot(ctx context.Context, req *connect.Request[v1.RestoreVirtualMachineSnapshotRequest]) (*connect.Response[v1.RestoreVirtualMachineSnapshotResponse], error) {
+	// Validate the request
+	errors := validation.ValidateRestoreVirtualMachineSnapshotRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Get the snapshot and its virtual machine
+	snapshot, err := s.Storage.GetVirtualMachineSnapshot(req.Msg.Id)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+	storedVM, err := s.Storage.GetVirtualMachine(snapshot.VirtualMachineID)
+	if err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// The virtual machine must keep referring to a virtual machine template
+	if err := s.checkSnapshotTemplate(snapshot); err != nil {
+		return nil, err
+	}
+
+	// Check that the virtual machine can be updated in its current status
+	vm := storedVM
+	previousStatus := lifecycle.Status(vm.Lifecycle)
+	if err := lifecycle.Transition(&vm.Lifecycle, storage.StatusUpdating, lifecycle.ReasonRestoring, "", time.Now()); err != nil {
+		return nil, s.HandleLifecycleError(err)
+	}
+
+	// Get the driver that provisioned the virtual machine
+	_, driver, err := s.Provisioners.ForVirtualMachine(vm)
+	if err != nil {
+		return nil, s.HandleProvisionerError(err)
+	}
+
+	// Restore the configuration and the rendered artifacts as they were. They
+	// may come from an older template revision, so record whether the current
+	// revision still renders them.
+	vm.CPU, vm.Memory, vm.OS = snapshot.CPU, snapshot.Memory, snapshot.OS
+	vm.TemplateID, vm.TemplateRevision = snapshot.TemplateID, snapshot.TemplateRevision
+	vm.Parameters = maps.Clone(snapshot.Parameters)
+	vm.RenderedArtifacts = maps.Clone(snapshot.RenderedArtifacts)
+	rendered, err := s.Processor.ProcessVirtualMachineTemplate(ctx, vm)
+	vm.Drift = vm.Drift.WithState(drift.Compare(vm.RenderedArtifacts, rendered, err).State, time.Now())
+	if req.Msg.ResourceVersion != 0 {
+		vm.ResourceVersion = req.Msg.ResourceVersion
+	}
+
+	// Stop here if the request only validates
+	if req.Msg.ValidateOnly {
+		if err := storage.CheckResourceVersion(storedVM.ResourceVersion, vm.ResourceVersion); err != nil {
+			return nil, s.HandleStorageError(err)
+		}
+		return connect.NewResponse(&v1.RestoreVirtualMachineSnapshotResponse{
+			VirtualMachine: base.ConvertStorageVMToProto(vm),
+		}), nil
+	}
+
+	// Update the virtual machine in storage and apply the change in the background
+	restoredVM, op, err := s.startUpdate(vm, previousStatus, driver, storage.EventRestore, storage.OperationRestoreVirtualMachine, lifecycle.ReasonRestored)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.RestoreVirtualMachineSnapshotResponse{
+		VirtualMachine: base.ConvertStorageVMToProto(restoredVM),
+		OperationId:    op.ID,
+	}), nil
+}
+
+// DeleteVirtualMachineSnapshot deletes a snapshot by ID
+func (s *Service) DeleteVirtualMachineSnapshot(_ context.Context, req *connect.Request[v1.DeleteVirtualMachineSnapshotRequest]) (*connect.Response[v1.DeleteVirtualMachineSnapshotResponse], error) {
+	// Validate the request
+	errors := validation.ValidateDeleteVirtualMachineSnapshotRequest(req.Msg)
+	if err := s.HandleValidationErrors(errors); err != nil {
+		return nil, err
+	}
+
+	// Delete the snapshot from storage
+	if err := s.Storage.DeleteVirtualMachineSnapshot(req.Msg.Id); err != nil {
+		return nil, s.HandleStorageError(err)
+	}
+
+	// Return the response
+	return connect.NewResponse(&v1.DeleteVirtualMachineSnapshotResponse{
+		Success: true,
+	}), nil
+}
+
+// checkSnapshotTemplate checks that the template of a snapshot still exists
+// and is a virtual machine template, and returns a connect error otherwise
+func (s *Service) checkSnapshotTemplate(snapshot storage.VirtualMachineSnapshot) error {
+	template, err := s.Storage.GetTemplate(snapshot.TemplateID)
+	if errors.Is(err, storage.ErrNotFound) {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("template %q of snapshot %q no longer exists", snapshot.TemplateID, snapshot.Name))
+	}
+	if err != nil {
+		return s.HandleStorageError(err)
+	}
+	if template.Type != "vm" {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("template %q of snapshot %q is no longer a virtual machine template", snapshot.TemplateID, snapshot.Name))
+	}
+	return nil
+}
@@ -0,0 +1,253 @@
+package vm_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/aa1ex/paas-provider/internal/server/vm"
+	"github.com/aa1ex/paas-provider/internal/storage"
+	v1 "github.com/aa1ex/paas-provider/pkg/api/grpc/virtual_machine/v1"
+)
+
+// snapshotVM stores a running virtual machine rendered with 2 CPUs and takes
+// a snapshot of it
+func snapshotVM(t *testing.T, s *vm.Service, store storage.Storage) (storage.VirtualMachine, *v1.VirtualMachineSnapshot) {
+	t.Helper()
+	vm := storeVM(t, store, storage.StatusRunning)
+	vm.Parameters = map[string]string{"disk": "10"}
+	vm.RenderedArtifacts = storage.Artifacts{storage.MainArtifact: "web: 2"}
+	vm, err := store.UpdateVirtualMachine(vm)
+	if err != nil {
+		t.Fatalf("UpdateVirtualMachine: %v", err)
+	}
+	resp, err := s.CreateVirtualMachineSnapshot(context.Background(), connect.NewRequest(&v1.CreateVirtualMachineSnapshotRequest{VirtualMachineId: vm.ID, Name: "before"}))
+	if err != nil {
+		t.Fatalf("CreateVirtualMachineSnapshot: %v", err)
+	}
+	return vm, resp.Msg.Snapshot
+}
+
+// changeVM changes the CPUs, parameters and artifacts of a stored virtual machine
+func changeVM(t *testing.T, store storage.Storage, id string) storage.VirtualMachine {
+	t.Helper()
+	vm, err := store.GetVirtualMachine(id)
+	if err != nil {
+		t.Fatalf("GetVirtualMachine: %v", err)
+	}
+	vm.CPU = 4
+	vm.Parameters["disk"] = "20"
+	vm.RenderedArtifacts = storage.Artifacts{storage.MainArtifact: "web: 4"}
+	if vm, err = store.UpdateVirtualMachine(vm); err != nil {
+		t.Fatalf("UpdateVirtualMachine: %v", err)
+	}
+	return vm
+}
+
+func TestCreateVirtualMachineSnapshot(t *testing.T) {
+	s, store, _ := newService(t)
+	vm, snapshot := snapshotVM(t, s, store)
+
+	if snapshot.VirtualMachineId != vm.ID || snapshot.Name != "before" || snapshot.Cpu != 2 || snapshot.TemplateId != "vm" {
+		t.Errorf("snapshot = %+v, want the configuration of %s", snapshot, vm.ID)
+	}
+
+	// Later changes to the virtual machine do not change the snapshot
+	changeVM(t, store, vm.ID)
+	stored, err := store.GetVirtualMachineSnapshot(snapshot.Id)
+	if err != nil {
+		t.Fatalf("GetVirtualMachineSnapshot: %v", err)
+	}
+	if stored.CPU != 2 || stored.Parameters["disk"] != "10" || stored.RenderedArtifacts[storage.MainArtifact] != "web: 2" {
+		t.Errorf("snapshot changed with its virtual machine: %+v", stored)
+	}
+
+	resp, err := s.ListVirtualMachineSnapshots(context.Background(), connect.NewRequest(&v1.ListVirtualMachineSnapshotsRequest{VirtualMachineId: vm.ID}))
+	if err != nil {
+		t.Fatalf("ListVirtualMachineSnapshots: %v", err)
+	}
+	if len(resp.Msg.Snapshots) != 1 || resp.Msg.Snapshots[0].Id != snapshot.Id {
+		t.Errorf("ListVirtualMachineSnapshots = %+v, want %s", resp.Msg.Snapshots, snapshot.Id)
+	}
+
+	_, err = s.CreateVirtualMachineSnapshot(context.Background(), connect.NewRequest(&v1.CreateVirtualMachineSnapshotRequest{VirtualMachineId: "missing", Name: "x"}))
+	if code := connect.CodeOf(err); code != connect.CodeNotFound {
+		t.Errorf("snapshot of a missing virtual machine: got code %v, want %v", code, connect.CodeNotFound)
+	}
+}
+
+func TestRestoreVirtualMachineSnapshot(t *testing.T) {
+	tests := []struct {
+		name string
+		// prepare changes the stored state before restoring and returns the
+		// resource version sent with the request
+		prepare      func(t *testing.T, store storage.Storage, vm storage.VirtualMachine) int64
+		validateOnly bool
+		wantCode     connect.Code
+		wantStatus   string
+		wantDrift    string
+	}{
+		{
+			name: "unset resource version keeps the stored one",
+			prepare: func(t *testing.T, store storage.Storage, vm storage.VirtualMachine) int64 {
+				changeVM(t, store, vm.ID)
+				return 0
+			},
+			wantStatus: storage.StatusRunning,
+			wantDrift:  storage.DriftInSync,
+		},
+		{
+			name: "current resource version",
+			prepare: func(t *testing.T, store storage.Storage, vm storage.VirtualMachine) int64 {
+				return changeVM(t, store, vm.ID).ResourceVersion
+			},
+			wantStatus: storage.StatusRunning,
+			wantDrift:  storage.DriftInSync,
+		},
+		{
+			name: "stale resource version",
+			prepare: func(t *testing.T, store storage.Storage, vm storage.VirtualMachine) int64 {
+				changeVM(t, store, vm.ID)
+				return vm.ResourceVersion
+			},
+			wantCode: connect.CodeAborted,
+		},
+		{
+			name: "stale resource version when only validating",
+			prepare: func(t *testing.T, store storage.Storage, vm storage.VirtualMachine) int64 {
+				changeVM(t, store, vm.ID)
+				return vm.ResourceVersion
+			},
+			validateOnly: true,
+			wantCode:     connect.CodeAborted,
+		},
+		{
+			name: "stopped virtual machine stays stopped",
+			prepare: func(t *testing.T, store storage.Storage, vm storage.VirtualMachine) int64 {
+				vm.Status = storage.StatusStopped
+				if _, err := store.UpdateVirtualMachine(vm); err != nil {
+					t.Fatalf("UpdateVirtualMachine: %v", err)
+				}
+				return 0
+			},
+			wantStatus: storage.StatusStopped,
+			wantDrift:  storage.DriftInSync,
+		},
+		{
+			name: "template changed since the snapshot",
+			prepare: func(t *testing.T, store storage.Storage, vm storage.VirtualMachine) int64 {
+				template, err := store.GetTemplate("vm")
+				if err != nil {
+					t.Fatalf("GetTemplate: %v", err)
+				}
+				template.RawTemplate = "{{ .Name }}"
+				if _, err := store.UpdateTemplate(template); err != nil {
+					t.Fatalf("UpdateTemplate: %v", err)
+				}
+				return 0
+			},
+			wantStatus: storage.StatusRunning,
+			wantDrift:  storage.DriftDrifted,
+		},
+		{
+			name: "template deleted since the snapshot",
+			prepare: func(t *testing.T, store storage.Storage, vm storage.VirtualMachine) int64 {
+				// The virtual machine moved to another template first
+				if _, err := store.CreateTemplate(storage.Template{ID: "vm2", Name: "vm2", Type: "vm", RawTemplate: "{{ .Name }}", OutputFormat: "plain"}); err != nil {
+					t.Fatalf("CreateTemplate: %v", err)
+				}
+				vm.TemplateID = "vm2"
+				if _, err := store.UpdateVirtualMachine(vm); err != nil {
+					t.Fatalf("UpdateVirtualMachine: %v", err)
+				}
+				if err := store.DeleteTemplate("vm", 0, false); err != nil {
+					t.Fatalf("DeleteTemplate: %v", err)
+				}
+				return 0
+			},
+			wantCode: connect.CodeFailedPrecondition,
+		},
+		{
+			name: "virtual machine in a status it cannot be updated in",
+			prepare: func(t *testing.T, store storage.Storage, vm storage.VirtualMachine) int64 {
+				vm.Status = storage.StatusProvisioning
+				if _, err := store.UpdateVirtualMachine(vm); err != nil {
+					t.Fatalf("UpdateVirtualMachine: %v", err)
+				}
+				return 0
+			},
+			wantCode: connect.CodeFailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, store, runner := newService(t)
+			vm, snapshot := snapshotVM(t, s, store)
+			resourceVersion := tt.prepare(t, store, vm)
+			before, err := store.GetVirtualMachine(vm.ID)
+			if err != nil {
+				t.Fatalf("GetVirtualMachine: %v", err)
+			}
+
+			resp, err := s.RestoreVirtualMachineSnapshot(context.Background(), connect.NewRequest(&v1.RestoreVirtualMachineSnapshotRequest{
+				Id:              snapshot.Id,
+				ResourceVersion: resourceVersion,
+				ValidateOnly:    tt.validateOnly,
+			}))
+			if tt.wantCode != 0 {
+				if code := connect.CodeOf(err); code != tt.wantCode {
+					t.Fatalf("got error %v with code %v, want %v", err, code, tt.wantCode)
+				}
+				if got, _ := store.GetVirtualMachine(vm.ID); got.ResourceVersion != before.ResourceVersion {
+					t.Errorf("refused restore stored the virtual machine at version %d, was %d", got.ResourceVersion, before.ResourceVersion)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if op := wait(t, runner, resp.Msg.OperationId); op.State != storage.OperationSucceeded {
+				t.Fatalf("operation is %s: %s", op.State, op.ErrorMessage)
+			}
+			got, err := store.GetVirtualMachine(vm.ID)
+			if err != nil {
+				t.Fatalf("GetVirtualMachine: %v", err)
+			}
+			if got.CPU != 2 || got.Parameters["disk"] != "10" || got.RenderedArtifacts[storage.MainArtifact] != "web: 2" {
+				t.Errorf("restored virtual machine = %+v, want the configuration of the snapshot", got)
+			}
+			if got.Status != tt.wantStatus || got.Drift.State != tt.wantDrift {
+				t.Errorf("restored virtual machine is %s and %s, want %s and %s", got.Status, got.Drift.State, tt.wantStatus, tt.wantDrift)
+			}
+			if got.ResourceVersion <= before.ResourceVersion {
+				t.Errorf("restored resource version = %d, want more than %d", got.ResourceVersion, before.ResourceVersion)
+			}
+			if len(got.Events) == 0 || got.Events[len(got.Events)-1].Action != storage.EventRestore {
+				t.Errorf("events = %+v, want the last to be %s", got.Events, storage.EventRestore)
+			}
+		})
+	}
+}
+
+func TestDeleteVirtualMachineSnapshot(t *testing.T) {
+	s, store, _ := newService(t)
+	vm, snapshot := snapshotVM(t, s, store)
+
+	if _, err := s.DeleteVirtualMachineSnapshot(context.Background(), connect.NewRequest(&v1.DeleteVirtualMachineSnapshotRequest{Id: snapshot.Id})); err != nil {
+		t.Fatalf("DeleteVirtualMachineSnapshot: %v", err)
+	}
+	if _, err := store.GetVirtualMachineSnapshot(snapshot.Id); err == nil {
+		t.Errorf("snapshot still stored after deletion")
+	}
+	if _, err := store.GetVirtualMachine(vm.ID); err != nil {
+		t.Errorf("deleting a snapshot deleted its virtual machine: %v", err)
+	}
+
+	_, err := s.DeleteVirtualMachineSnapshot(context.Background(), connect.NewRequest(&v1.DeleteVirtualMachineSnapshotRequest{Id: snapshot.Id}))
+	if code := connect.CodeOf(err); code != connect.CodeNotFound {
+		t.Errorf("deleting a missing snapshot: got code %v, want %v", code, connect.CodeNotFound)
+	}
+}
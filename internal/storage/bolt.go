@@ -0,0 +1,628 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	templatesBucket          = []byte("templates")
+	templateRevisionsBucket  = []byte("template_revisions")
+	virtualMachinesBucket    = []byte("virtual_machines")
+	vmSnapshotsBucket        = []byte("virtual_machine_snapshots")
+	kubernetesClustersBucket = []byte("kubernetes_clusters")
+	operationsBucket         = []byte("operations")
+)
+
+// BoltStorage is an on-disk storage for our entities backed by bbolt
+type BoltStorage struct {
+	db *bolt.DB
+}
+
+// NewBoltStorage opens (or creates) a bbolt database at the given path
+func NewBoltStorage(path string) (*BoltStorage, error) {
+	if path == "" {
+		return nil, fmt.Errorf("bolt storage requires a path")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bolt database: %w", err)
+	}
+
+	// Make sure all buckets exist
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{templatesBucket, templateRevisionsBucket, virtualMachinesBucket, vmSnapshotsBucket, kubernetesClustersBucket, operationsBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return migrateTemplateRevisions(tx)
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to initialize bolt database: %w", err)
+	}
+
+	return &BoltStorage{db: db}, nil
+}
+
+// Close closes the underlying database
+func (s *BoltStorage) Close() error {
+	return s.db.Close()
+}
+
+// Template operations
+
+// CreateTemplate creates a new template
+func (s *BoltStorage) CreateTemplate(template Template) (Template, error) {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(templatesBucket)
+		if b.Get([]byte(template.ID)) != nil {
+			return ErrAlreadyExists
+		}
+		template.ResourceVersion = 1
+		template.Revision = 1
+		if err := putJSON(b, template.ID, template); err != nil {
+			return err
+		}
+		return putTemplateRevision(tx, newTemplateRevision(template))
+	})
+	if err != nil {
+		return Template{}, err
+	}
+	return template, nil
+}
+
+// GetTemplate retrieves a template by ID
+func (s *BoltStorage) GetTemplate(id string) (Template, error) {
+	var template Template
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return getJSON(tx.Bucket(templatesBucket), id, &template)
+	})
+	if err != nil {
+		return Template{}, err
+	}
+	return template, nil
+}
+
+// ListTemplates retrieves templates matching the list options
+func (s *BoltStorage) ListTemplates(templateType string, opts ListOptions) ([]Template, string, error) {
+	return boltQuery(s.db, templatesBucket, opts, func(template Template) bool {
+		return templateType == "" || template.Type == templateType
+	})
+}
+
+// UpdateTemplate updates an existing template
+func (s *BoltStorage) UpdateTemplate(template Template) (Template, error) {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(templatesBucket)
+		var stored Template
+		if err := getJSON(b, template.ID, &stored); err != nil {
+			return err
+		}
+		if err := CheckResourceVersion(stored.ResourceVersion, template.ResourceVersion); err != nil {
+			return err
+		}
+		if template.Type != stored.Type {
+			dependents, err := boltTemplateDependents(tx, template.ID)
+			if err != nil {
+				return err
+			}
+			if dependents != nil {
+				return dependents
+			}
+		}
+		template.ResourceVersion = stored.ResourceVersion + 1
+		template.Revision = stored.Revision + 1
+		if err := putJSON(b, template.ID, template); err != nil {
+			return err
+		}
+		return putTemplateRevision(tx, newTemplateRevision(template))
+	})
+	if err != nil {
+		return Template{}, err
+	}
+	return template, nil
+}
+
+// DeleteTemplate deletes a template by ID
+func (s *BoltStorage) DeleteTemplate(id string, resourceVersion int64, cascade bool) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(templatesBucket)
+		var stored Template
+		if err := getJSON(b, id, &stored); err != nil {
+			return err
+		}
+		if err := CheckResourceVersion(stored.ResourceVersion, resourceVersion); err != nil {
+			return err
+		}
+		dependents, err := boltTemplateDependents(tx, id)
+		if err != nil {
+			return err
+		}
+		if dependents != nil {
+			if !cascade {
+				return dependents
+			}
+			for _, vmID := range dependents.VirtualMachines {
+				if err := boltDeleteVirtualMachine(tx, vmID); err != nil {
+					return err
+				}
+			}
+			for _, clusterID := range dependents.KubernetesClusters {
+				if err := tx.Bucket(kubernetesClustersBucket).Delete([]byte(clusterID)); err != nil {
+					return err
+				}
+			}
+		}
+		if err := b.Delete([]byte(id)); err != nil {
+			return err
+		}
+
+		// Remove all revisions of the template
+		err = tx.Bucket(templateRevisionsBucket).DeleteBucket([]byte(id))
+		if errors.Is(err, bolt.ErrBucketNotFound) {
+			return nil
+		}
+		return err
+	})
+}
+
+// ListTemplateRevisions retrieves all revisions of a template, oldest first
+func (s *BoltStorage) ListTemplateRevisions(templateID string) ([]TemplateRevision, error) {
+	var revisions []TemplateRevision
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(templateRevisionsBucket).Bucket([]byte(templateID))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(_, data []byte) error {
+			var revision TemplateRevision
+			if err := json.Unmarshal(data, &revision); err != nil {
+				return err
+			}
+			revisions = append(revisions, revision)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 {
+		return nil, ErrNotFound
+	}
+	return revisions, nil
+}
+
+// GetTemplateRevision retrieves a single revision of a template
+func (s *BoltStorage) GetTemplateRevision(templateID string, revision int64) (TemplateRevision, error) {
+	var r TemplateRevision
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(templateRevisionsBucket).Bucket([]byte(templateID))
+		if b == nil {
+			return ErrNotFound
+		}
+		return getJSON(b, templateRevisionKey(revision), &r)
+	})
+	if err != nil {
+		return TemplateRevision{}, err
+	}
+	return r, nil
+}
+
+// boltTemplateDependents returns the resources rendered from a template within a transaction
+func boltTemplateDependents(tx *bolt.Tx, templateID string) (*DependentsError, error) {
+	vms, err := boltListTx[VirtualMachine](tx, virtualMachinesBucket)
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := boltListTx[KubernetesCluster](tx, kubernetesClustersBucket)
+	if err != nil {
+		return nil, err
+	}
+	return templateDependents(templateID, vms, clusters), nil
+}
+
+// templateRevisionKey builds a key that sorts revisions in order within the
+// bucket of their template
+func templateRevisionKey(revision int64) string {
+	return fmt.Sprintf("%020d", revision)
+}
+
+// putTemplateRevision stores a template revision in the bucket of its template,
+// nested in the revisions bucket so that no template ID is a prefix of another
+func putTemplateRevision(tx *bolt.Tx, revision TemplateRevision) error {
+	b, err := tx.Bucket(templateRevisionsBucket).CreateBucketIfNotExists([]byte(revision.TemplateID))
+	if err != nil {
+		return err
+	}
+	return putJSON(b, templateRevisionKey(revision.Revision), revision)
+}
+
+// migrateTemplateRevisions moves revisions stored directly in the revisions
+// bucket, keyed by template ID, "/" and revision, into the bucket of their template
+func migrateTemplateRevisions(tx *bolt.Tx) error {
+	b := tx.Bucket(templateRevisionsBucket)
+	var keys [][]byte
+	err := b.ForEach(func(k, data []byte) error {
+		if data != nil {
+			keys = append(keys, k)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		var revision TemplateRevision
+		if err := json.Unmarshal(b.Get(k), &revision); err != nil {
+			return err
+		}
+		if err := b.Delete(k); err != nil {
+			return err
+		}
+		if err := putTemplateRevision(tx, revision); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VirtualMachine operations
+
+// CreateVirtualMachine creates a new virtual machine
+func (s *BoltStorage) CreateVirtualMachine(vm VirtualMachine) (VirtualMachine, error) {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(virtualMachinesBucket)
+		if b.Get([]byte(vm.ID)) != nil {
+			return ErrAlreadyExists
+		}
+		vm.ResourceVersion = 1
+		vm.CreatedAt = time.Now().UTC()
+		vm.UpdatedAt = vm.CreatedAt
+		return putJSON(b, vm.ID, vm)
+	})
+	if err != nil {
+		return VirtualMachine{}, err
+	}
+	return vm, nil
+}
+
+// GetVirtualMachine retrieves a virtual machine by ID
+func (s *BoltStorage) GetVirtualMachine(id string) (VirtualMachine, error) {
+	var vm VirtualMachine
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return getJSON(tx.Bucket(virtualMachinesBucket), id, &vm)
+	})
+	if err != nil {
+		return VirtualMachine{}, err
+	}
+	return vm, nil
+}
+
+// ListVirtualMachines retrieves virtual machines matching the list options
+func (s *BoltStorage) ListVirtualMachines(opts ListOptions) ([]VirtualMachine, string, error) {
+	return boltQuery[VirtualMachine](s.db, virtualMachinesBucket, opts, nil)
+}
+
+// UpdateVirtualMachine updates an existing virtual machine
+func (s *BoltStorage) UpdateVirtualMachine(vm VirtualMachine) (VirtualMachine, error) {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(virtualMachinesBucket)
+		var stored VirtualMachine
+		if err := getJSON(b, vm.ID, &stored); err != nil {
+			return err
+		}
+		if err := CheckResourceVersion(stored.ResourceVersion, vm.ResourceVersion); err != nil {
+			return err
+		}
+		vm.ResourceVersion = stored.ResourceVersion + 1
+		vm.CreatedAt, vm.UpdatedAt = stored.CreatedAt, time.Now().UTC()
+		return putJSON(b, vm.ID, vm)
+	})
+	if err != nil {
+		return VirtualMachine{}, err
+	}
+	return vm, nil
+}
+
+// DeleteVirtualMachine deletes a virtual machine by ID
+func (s *BoltStorage) DeleteVirtualMachine(id string, resourceVersion int64) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(virtualMachinesBucket)
+		var stored VirtualMachine
+		if err := getJSON(b, id, &stored); err != nil {
+			return err
+		}
+		if err := CheckResourceVersion(stored.ResourceVersion, resourceVersion); err != nil {
+			return err
+		}
+		return boltDeleteVirtualMachine(tx, id)
+	})
+}
+
+// boltDeleteVirtualMachine deletes a virtual machine and its snapshots within a transaction
+func boltDeleteVirtualMachine(tx *bolt.Tx, id string) error {
+	if err := tx.Bucket(virtualMachinesBucket).Delete([]byte(id)); err != nil {
+		return err
+	}
+	snapshots, err := boltListTx[VirtualMachineSnapshot](tx, vmSnapshotsBucket)
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range snapshots {
+		if snapshot.VirtualMachineID != id {
+			continue
+		}
+		if err := tx.Bucket(vmSnapshotsBucket).Delete([]byte(snapshot.ID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VirtualMachineSnapshot operations
+
+// CreateVirtualMachineSnapshot creates a new snapshot of a virtual machine
+func (s *BoltStorage) CreateVirtualMachineSnapshot(snapshot VirtualMachineSnapshot) (VirtualMachineSnapshot, error) {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		if tx.Bucket(virtualMachinesBucket).Get([]byte(snapshot.VirtualMachineID)) == nil {
+			return ErrNotFound
+		}
+		b := tx.Bucket(vmSnapshotsBucket)
+		if b.Get([]byte(snapshot.ID)) != nil {
+			return ErrAlreadyExists
+		}
+		snapshot.CreatedAt = time.Now().UTC()
+		return putJSON(b, snapshot.ID, snapshot)
+	})
+	if err != nil {
+		return VirtualMachineSnapshot{}, err
+	}
+	return snapshot, nil
+}
+
+// GetVirtualMachineSnapshot retrieves a snapshot by ID
+func (s *BoltStorage) GetVirtualMachineSnapshot(id string) (VirtualMachineSnapshot, error) {
+	var snapshot VirtualMachineSnapshot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return getJSON(tx.Bucket(vmSnapshotsBucket), id, &snapshot)
+	})
+	if err != nil {
+		return VirtualMachineSnapshot{}, err
+	}
+	return snapshot, nil
+}
+
+// ListVirtualMachineSnapshots retrieves the snapshots of a virtual machine matching the list options
+func (s *BoltStorage) ListVirtualMachineSnapshots(vmID string, opts ListOptions) ([]VirtualMachineSnapshot, string, error) {
+	return boltQuery(s.db, vmSnapshotsBucket, opts, func(snapshot VirtualMachineSnapshot) bool {
+		return vmID == "" || snapshot.VirtualMachineID == vmID
+	})
+}
+
+// DeleteVirtualMachineSnapshot deletes a snapshot by ID
+func (s *BoltStorage) DeleteVirtualMachineSnapshot(id string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(vmSnapshotsBucket)
+		if b.Get([]byte(id)) == nil {
+			return ErrNotFound
+		}
+		return b.Delete([]byte(id))
+	})
+}
+
+// KubernetesCluster operations
+
+// CreateKubernetesCluster creates a new Kubernetes cluster
+func (s *BoltStorage) CreateKubernetesCluster(cluster KubernetesCluster) (KubernetesCluster, error) {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(kubernetesClustersBucket)
+		if b.Get([]byte(cluster.ID)) != nil {
+			return ErrAlreadyExists
+		}
+		cluster.ResourceVersion = 1
+		cluster.CreatedAt = time.Now().UTC()
+		cluster.UpdatedAt = cluster.CreatedAt
+		return putJSON(b, cluster.ID, cluster)
+	})
+	if err != nil {
+		return KubernetesCluster{}, err
+	}
+	return cluster, nil
+}
+
+// GetKubernetesCluster retrieves a Kubernetes cluster by ID
+func (s *BoltStorage) GetKubernetesCluster(id string) (KubernetesCluster, error) {
+	var cluster KubernetesCluster
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return getJSON(tx.Bucket(kubernetesClustersBucket), id, &cluster)
+	})
+	if err != nil {
+		return KubernetesCluster{}, err
+	}
+	return cluster, nil
+}
+
+// ListKubernetesClusters retrieves Kubernetes clusters matching the list options
+func (s *BoltStorage) ListKubernetesClusters(opts ListOptions) ([]KubernetesCluster, string, error) {
+	return boltQuery[KubernetesCluster](s.db, kubernetesClustersBucket, opts, nil)
+}
+
+// UpdateKubernetesCluster updates an existing Kubernetes cluster
+func (s *BoltStorage) UpdateKubernetesCluster(cluster KubernetesCluster) (KubernetesCluster, error) {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(kubernetesClustersBucket)
+		var stored KubernetesCluster
+		if err := getJSON(b, cluster.ID, &stored); err != nil {
+			return err
+		}
+		if err := CheckResourceVersion(stored.ResourceVersion, cluster.ResourceVersion); err != nil {
+			return err
+		}
+		cluster.ResourceVersion = stored.ResourceVersion + 1
+		cluster.CreatedAt, cluster.UpdatedAt = stored.CreatedAt, time.Now().UTC()
+		return putJSON(b, cluster.ID, cluster)
+	})
+	if err != nil {
+		return KubernetesCluster{}, err
+	}
+	return cluster, nil
+}
+
+// DeleteKubernetesCluster deletes a Kubernetes cluster by ID
+func (s *BoltStorage) DeleteKubernetesCluster(id string, resourceVersion int64) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(kubernetesClustersBucket)
+		var stored KubernetesCluster
+		if err := getJSON(b, id, &stored); err != nil {
+			return err
+		}
+		if err := CheckResourceVersion(stored.ResourceVersion, resourceVersion); err != nil {
+			return err
+		}
+		return b.Delete([]byte(id))
+	})
+}
+
+// Long-running operations
+
+// CreateOperation creates a new operation
+func (s *BoltStorage) CreateOperation(op Operation) (Operation, error) {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(operationsBucket)
+		if b.Get([]byte(op.ID)) != nil {
+			return ErrAlreadyExists
+		}
+		op.ResourceVersion = 1
+		op.CreatedAt = time.Now().UTC()
+		op.UpdatedAt = op.CreatedAt
+		return putJSON(b, op.ID, op)
+	})
+	if err != nil {
+		return Operation{}, err
+	}
+	return op, nil
+}
+
+// GetOperation retrieves an operation by ID
+func (s *BoltStorage) GetOperation(id string) (Operation, error) {
+	var op Operation
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return getJSON(tx.Bucket(operationsBucket), id, &op)
+	})
+	if err != nil {
+		return Operation{}, err
+	}
+	return op, nil
+}
+
+// ListOperations retrieves operations matching the list options
+func (s *BoltStorage) ListOperations(opts ListOptions) ([]Operation, string, error) {
+	return boltQuery[Operation](s.db, operationsBucket, opts, nil)
+}
+
+// UpdateOperation updates an existing operation
+func (s *BoltStorage) UpdateOperation(op Operation) (Operation, error) {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(operationsBucket)
+		var stored Operation
+		if err := getJSON(b, op.ID, &stored); err != nil {
+			return err
+		}
+		if err := CheckResourceVersion(stored.ResourceVersion, op.ResourceVersion); err != nil {
+			return err
+		}
+		op.ResourceVersion = stored.ResourceVersion + 1
+		op.CreatedAt, op.UpdatedAt = stored.CreatedAt, time.Now().UTC()
+		return putJSON(b, op.ID, op)
+	})
+	if err != nil {
+		return Operation{}, err
+	}
+	return op, nil
+}
+
+// DeleteOperation deletes an operation by ID
+func (s *BoltStorage) DeleteOperation(id string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(operationsBucket)
+		if b.Get([]byte(id)) == nil {
+			return ErrNotFound
+		}
+		return b.Delete([]byte(id))
+	})
+}
+
+// boltQuery decodes the entities of a bucket accepted by keep, or all of
+// them with a nil keep, and returns the page of them selected by the list
+// options. Entities outside of the page are not retained.
+func boltQuery[T queryable](db *bolt.DB, bucket []byte, opts ListOptions, keep func(T) bool) ([]T, string, error) {
+	p, err := newPager[T](opts)
+	if err != nil {
+		return nil, "", err
+	}
+	err = db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).ForEach(func(_, data []byte) error {
+			var entity T
+			if err := json.Unmarshal(data, &entity); err != nil {
+				return err
+			}
+			if keep == nil || keep(entity) {
+				p.add(entity)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	page, next := p.result()
+	return page, next, nil
+}
+
+// boltListTx decodes all entities of a bucket within a transaction
+func boltListTx[T any](tx *bolt.Tx, bucket []byte) ([]T, error) {
+	var entities []T
+	err := tx.Bucket(bucket).ForEach(func(_, data []byte) error {
+		var entity T
+		if err := json.Unmarshal(data, &entity); err != nil {
+			return err
+		}
+		entities = append(entities, entity)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+// getJSON loads and decodes a single entity from a bucket
+func getJSON(b *bolt.Bucket, id string, entity any) error {
+	data := b.Get([]byte(id))
+	if data == nil {
+		return ErrNotFound
+	}
+	return json.Unmarshal(data, entity)
+}
+
+// putJSON encodes and stores a single entity in a bucket
+func putJSON(b *bolt.Bucket, id string, entity any) error {
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return err
+	}
+	return b.Put([]byte(id), data)
+}
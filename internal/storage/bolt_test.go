@@ -0,0 +1,75 @@
+package storage_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
+	"github.com/aa1ex/paas-provider/internal/storage/storagetest"
+)
+
+func TestBoltStorage(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storage.Storage {
+		s, err := storage.NewBoltStorage(filepath.Join(t.TempDir(), "paas.db"))
+		if err != nil {
+			t.Fatalf("NewBoltStorage: %v", err)
+		}
+		return s
+	})
+}
+
+func TestBoltStorageMigratesTemplateRevisions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "paas.db")
+
+	// Store revisions the way earlier versions did, keyed by template ID, "/" and revision
+	db, err := bolt.Open(path, 0o600, nil)
+	if err != nil {
+		t.Fatalf("bolt.Open: %v", err)
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte("template_revisions"))
+		if err != nil {
+			return err
+		}
+		for _, id := range []string{"a", "a/b"} {
+			for revision := int64(1); revision <= 2; revision++ {
+				data, err := json.Marshal(storage.TemplateRevision{TemplateID: id, Revision: revision, RawTemplate: id})
+				if err != nil {
+					return err
+				}
+				if err := b.Put([]byte(fmt.Sprintf("%s/%020d", id, revision)), data); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("storing revisions: %v", err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	s, err := storage.NewBoltStorage(path)
+	if err != nil {
+		t.Fatalf("NewBoltStorage: %v", err)
+	}
+	defer s.Close()
+	for _, id := range []string{"a", "a/b"} {
+		revisions, err := s.ListTemplateRevisions(id)
+		if err != nil {
+			t.Fatalf("ListTemplateRevisions(%s): %v", id, err)
+		}
+		if len(revisions) != 2 || revisions[0].Revision != 1 || revisions[1].RawTemplate != id {
+			t.Errorf("ListTemplateRevisions(%s) = %+v, want its two revisions", id, revisions)
+		}
+		if _, err := s.GetTemplateRevision(id, 2); err != nil {
+			t.Errorf("GetTemplateRevision(%s, 2): %v", id, err)
+		}
+	}
+}
@@ -0,0 +1,497 @@
+package storage
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+// MemoryStorage is an in-memory storage for our entities
+type MemoryStorage struct {
+	templates          map[string]Template
+	templateRevisions  map[string][]TemplateRevision
+	virtualMachines    map[string]VirtualMachine
+	vmSnapshots        map[string]VirtualMachineSnapshot
+	kubernetesClusters map[string]KubernetesCluster
+	operations         map[string]Operation
+	mu                 sync.RWMutex
+}
+
+// NewMemoryStorage creates a new in-memory storage
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{
+		templates:          make(map[string]Template),
+		templateRevisions:  make(map[string][]TemplateRevision),
+		virtualMachines:    make(map[string]VirtualMachine),
+		vmSnapshots:        make(map[string]VirtualMachineSnapshot),
+		kubernetesClusters: make(map[string]KubernetesCluster),
+		operations:         make(map[string]Operation),
+	}
+}
+
+// Close is a no-op for the in-memory storage
+func (s *MemoryStorage) Close() error {
+	return nil
+}
+
+// Template operations
+
+// CreateTemplate creates a new template
+func (s *MemoryStorage) CreateTemplate(template Template) (Template, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.templates[template.ID]; ok {
+		return Template{}, ErrAlreadyExists
+	}
+	template.ResourceVersion = 1
+	template.Revision = 1
+	s.templates[template.ID] = template.clone()
+	s.templateRevisions[template.ID] = []TemplateRevision{newTemplateRevision(s.templates[template.ID])}
+	return template, nil
+}
+
+// GetTemplate retrieves a template by ID
+func (s *MemoryStorage) GetTemplate(id string) (Template, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	template, ok := s.templates[id]
+	if !ok {
+		return Template{}, ErrNotFound
+	}
+	return template.clone(), nil
+}
+
+// ListTemplates retrieves templates matching the list options
+func (s *MemoryStorage) ListTemplates(templateType string, opts ListOptions) ([]Template, string, error) {
+	return memoryQuery(s, s.templates, opts, func(template Template) bool {
+		return templateType == "" || template.Type == templateType
+	})
+}
+
+// UpdateTemplate updates an existing template
+func (s *MemoryStorage) UpdateTemplate(template Template) (Template, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.templates[template.ID]
+	if !ok {
+		return Template{}, ErrNotFound
+	}
+	if err := CheckResourceVersion(stored.ResourceVersion, template.ResourceVersion); err != nil {
+		return Template{}, err
+	}
+	if template.Type != stored.Type {
+		if dependents := s.templateDependents(template.ID); dependents != nil {
+			return Template{}, dependents
+		}
+	}
+	template.ResourceVersion = stored.ResourceVersion + 1
+	template.Revision = stored.Revision + 1
+	s.templates[template.ID] = template.clone()
+	s.templateRevisions[template.ID] = append(s.templateRevisions[template.ID], newTemplateRevision(s.templates[template.ID]))
+	return template, nil
+}
+
+// DeleteTemplate deletes a template by ID
+func (s *MemoryStorage) DeleteTemplate(id string, resourceVersion int64, cascade bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.templates[id]
+	if !ok {
+		return ErrNotFound
+	}
+	if err := CheckResourceVersion(stored.ResourceVersion, resourceVersion); err != nil {
+		return err
+	}
+	if dependents := s.templateDependents(id); dependents != nil {
+		if !cascade {
+			return dependents
+		}
+		for _, vmID := range dependents.VirtualMachines {
+			s.deleteVirtualMachine(vmID)
+		}
+		for _, clusterID := range dependents.KubernetesClusters {
+			delete(s.kubernetesClusters, clusterID)
+		}
+	}
+	delete(s.templates, id)
+	delete(s.templateRevisions, id)
+	return nil
+}
+
+// templateDependents returns the resources rendered from a template. The caller must hold the lock.
+func (s *MemoryStorage) templateDependents(templateID string) *DependentsError {
+	vms := make([]VirtualMachine, 0, len(s.virtualMachines))
+	for _, vm := range s.virtualMachines {
+		vms = append(vms, vm)
+	}
+	clusters := make([]KubernetesCluster, 0, len(s.kubernetesClusters))
+	for _, cluster := range s.kubernetesClusters {
+		clusters = append(clusters, cluster)
+	}
+	return templateDependents(templateID, vms, clusters)
+}
+
+// ListTemplateRevisions retrieves all revisions of a template, oldest first
+func (s *MemoryStorage) ListTemplateRevisions(templateID string) ([]TemplateRevision, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	revisions, ok := s.templateRevisions[templateID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	cloned := make([]TemplateRevision, len(revisions))
+	for i, r := range revisions {
+		cloned[i] = r.clone()
+	}
+	return cloned, nil
+}
+
+// GetTemplateRevision retrieves a single revision of a template
+func (s *MemoryStorage) GetTemplateRevision(templateID string, revision int64) (TemplateRevision, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, r := range s.templateRevisions[templateID] {
+		if r.Revision == revision {
+			return r.clone(), nil
+		}
+	}
+	return TemplateRevision{}, ErrNotFound
+}
+
+// VirtualMachine operations
+
+// CreateVirtualMachine creates a new virtual machine
+func (s *MemoryStorage) CreateVirtualMachine(vm VirtualMachine) (VirtualMachine, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.virtualMachines[vm.ID]; ok {
+		return VirtualMachine{}, ErrAlreadyExists
+	}
+	vm.ResourceVersion = 1
+	vm.CreatedAt = time.Now().UTC()
+	vm.UpdatedAt = vm.CreatedAt
+	s.virtualMachines[vm.ID] = vm.clone()
+	return vm, nil
+}
+
+// GetVirtualMachine retrieves a virtual machine by ID
+func (s *MemoryStorage) GetVirtualMachine(id string) (VirtualMachine, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	vm, ok := s.virtualMachines[id]
+	if !ok {
+		return VirtualMachine{}, ErrNotFound
+	}
+	return vm.clone(), nil
+}
+
+// ListVirtualMachines retrieves virtual machines matching the list options
+func (s *MemoryStorage) ListVirtualMachines(opts ListOptions) ([]VirtualMachine, string, error) {
+	return memoryQuery(s, s.virtualMachines, opts, nil)
+}
+
+// UpdateVirtualMachine updates an existing virtual machine
+func (s *MemoryStorage) UpdateVirtualMachine(vm VirtualMachine) (VirtualMachine, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.virtualMachines[vm.ID]
+	if !ok {
+		return VirtualMachine{}, ErrNotFound
+	}
+	if err := CheckResourceVersion(stored.ResourceVersion, vm.ResourceVersion); err != nil {
+		return VirtualMachine{}, err
+	}
+	vm.ResourceVersion = stored.ResourceVersion + 1
+	vm.CreatedAt, vm.UpdatedAt = stored.CreatedAt, time.Now().UTC()
+	s.virtualMachines[vm.ID] = vm.clone()
+	return vm, nil
+}
+
+// DeleteVirtualMachine deletes a virtual machine by ID
+func (s *MemoryStorage) DeleteVirtualMachine(id string, resourceVersion int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.virtualMachines[id]
+	if !ok {
+		return ErrNotFound
+	}
+	if err := CheckResourceVersion(stored.ResourceVersion, resourceVersion); err != nil {
+		return err
+	}
+	s.deleteVirtualMachine(id)
+	return nil
+}
+
+// deleteVirtualMachine deletes a virtual machine and its snapshots. The caller must hold the lock.
+func (s *MemoryStorage) deleteVirtualMachine(id string) {
+	delete(s.virtualMachines, id)
+	for snapshotID, snapshot := range s.vmSnapshots {
+		if snapshot.VirtualMachineID == id {
+			delete(s.vmSnapshots, snapshotID)
+		}
+	}
+}
+
+// VirtualMachineSnapshot operations
+
+// CreateVirtualMachineSnapshot creates a new snapshot of a virtual machine
+func (s *MemoryStorage) CreateVirtualMachineSnapshot(snapshot VirtualMachineSnapshot) (VirtualMachineSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.virtualMachines[snapshot.VirtualMachineID]; !ok {
+		return VirtualMachineSnapshot{}, ErrNotFound
+	}
+	if _, ok := s.vmSnapshots[snapshot.ID]; ok {
+		return VirtualMachineSnapshot{}, ErrAlreadyExists
+	}
+	snapshot.CreatedAt = time.Now().UTC()
+	s.vmSnapshots[snapshot.ID] = snapshot.clone()
+	return snapshot, nil
+}
+
+// GetVirtualMachineSnapshot retrieves a snapshot by ID
+func (s *MemoryStorage) GetVirtualMachineSnapshot(id string) (VirtualMachineSnapshot, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snapshot, ok := s.vmSnapshots[id]
+	if !ok {
+		return VirtualMachineSnapshot{}, ErrNotFound
+	}
+	return snapshot.clone(), nil
+}
+
+// ListVirtualMachineSnapshots retrieves the snapshots of a virtual machine matching the list options
+func (s *MemoryStorage) ListVirtualMachineSnapshots(vmID string, opts ListOptions) ([]VirtualMachineSnapshot, string, error) {
+	return memoryQuery(s, s.vmSnapshots, opts, func(snapshot VirtualMachineSnapshot) bool {
+		return vmID == "" || snapshot.VirtualMachineID == vmID
+	})
+}
+
+// DeleteVirtualMachineSnapshot deletes a snapshot by ID
+func (s *MemoryStorage) DeleteVirtualMachineSnapshot(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.vmSnapshots[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.vmSnapshots, id)
+	return nil
+}
+
+// KubernetesCluster operations
+
+// CreateKubernetesCluster creates a new Kubernetes cluster
+func (s *MemoryStorage) CreateKubernetesCluster(cluster KubernetesCluster) (KubernetesCluster, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.kubernetesClusters[cluster.ID]; ok {
+		return KubernetesCluster{}, ErrAlreadyExists
+	}
+	cluster.ResourceVersion = 1
+	cluster.CreatedAt = time.Now().UTC()
+	cluster.UpdatedAt = cluster.CreatedAt
+	s.kubernetesClusters[cluster.ID] = cluster.clone()
+	return cluster, nil
+}
+
+// GetKubernetesCluster retrieves a Kubernetes cluster by ID
+func (s *MemoryStorage) GetKubernetesCluster(id string) (KubernetesCluster, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	cluster, ok := s.kubernetesClusters[id]
+	if !ok {
+		return KubernetesCluster{}, ErrNotFound
+	}
+	return cluster.clone(), nil
+}
+
+// ListKubernetesClusters retrieves Kubernetes clusters matching the list options
+func (s *MemoryStorage) ListKubernetesClusters(opts ListOptions) ([]KubernetesCluster, string, error) {
+	return memoryQuery(s, s.kubernetesClusters, opts, nil)
+}
+
+// UpdateKubernetesCluster updates an existing Kubernetes cluster
+func (s *MemoryStorage) UpdateKubernetesCluster(cluster KubernetesCluster) (KubernetesCluster, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.kubernetesClusters[cluster.ID]
+	if !ok {
+		return KubernetesCluster{}, ErrNotFound
+	}
+	if err := CheckResourceVersion(stored.ResourceVersion, cluster.ResourceVersion); err != nil {
+		return KubernetesCluster{}, err
+	}
+	cluster.ResourceVersion = stored.ResourceVersion + 1
+	cluster.CreatedAt, cluster.UpdatedAt = stored.CreatedAt, time.Now().UTC()
+	s.kubernetesClusters[cluster.ID] = cluster.clone()
+	return cluster, nil
+}
+
+// DeleteKubernetesCluster deletes a Kubernetes cluster by ID
+func (s *MemoryStorage) DeleteKubernetesCluster(id string, resourceVersion int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.kubernetesClusters[id]
+	if !ok {
+		return ErrNotFound
+	}
+	if err := CheckResourceVersion(stored.ResourceVersion, resourceVersion); err != nil {
+		return err
+	}
+	delete(s.kubernetesClusters, id)
+	return nil
+}
+
+// Long-running operations
+
+// CreateOperation creates a new operation
+func (s *MemoryStorage) CreateOperation(op Operation) (Operation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.operations[op.ID]; ok {
+		return Operation{}, ErrAlreadyExists
+	}
+	op.ResourceVersion = 1
+	op.CreatedAt = time.Now().UTC()
+	op.UpdatedAt = op.CreatedAt
+	s.operations[op.ID] = op.clone()
+	return op, nil
+}
+
+// GetOperation retrieves an operation by ID
+func (s *MemoryStorage) GetOperation(id string) (Operation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	op, ok := s.operations[id]
+	if !ok {
+		return Operation{}, ErrNotFound
+	}
+	return op.clone(), nil
+}
+
+// ListOperations retrieves operations matching the list options
+func (s *MemoryStorage) ListOperations(opts ListOptions) ([]Operation, string, error) {
+	return memoryQuery(s, s.operations, opts, nil)
+}
+
+// UpdateOperation updates an existing operation
+func (s *MemoryStorage) UpdateOperation(op Operation) (Operation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.operations[op.ID]
+	if !ok {
+		return Operation{}, ErrNotFound
+	}
+	if err := CheckResourceVersion(stored.ResourceVersion, op.ResourceVersion); err != nil {
+		return Operation{}, err
+	}
+	op.ResourceVersion = stored.ResourceVersion + 1
+	op.CreatedAt, op.UpdatedAt = stored.CreatedAt, time.Now().UTC()
+	s.operations[op.ID] = op.clone()
+	return op, nil
+}
+
+// DeleteOperation deletes an operation by ID
+func (s *MemoryStorage) DeleteOperation(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.operations[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.operations, id)
+	return nil
+}
+
+// memoryQuery returns copies of the page of entities accepted by keep, or of
+// all entities with a nil keep, selected by the list options
+func memoryQuery[T interface {
+	queryable
+	clone() T
+}](s *MemoryStorage, entities map[string]T, opts ListOptions, keep func(T) bool) ([]T, string, error) {
+	p, err := newPager[T](opts)
+	if err != nil {
+		return nil, "", err
+	}
+	s.mu.RLock()
+	for _, entity := range entities {
+		if keep == nil || keep(entity) {
+			p.add(entity)
+		}
+	}
+	s.mu.RUnlock()
+	page, next := p.result()
+	for i := range page {
+		page[i] = page[i].clone()
+	}
+	return page, next, nil
+}
+
+// The clone methods copy an entity with its maps, slices and pointers, so
+// that callers cannot change stored entities in place and later changes to
+// stored entities do not show through values returned before
+
+func (t Template) clone() Template {
+	t.Files = slices.Clone(t.Files)
+	t.Parameters = cloneParameters(t.Parameters)
+	return t
+}
+
+func (r TemplateRevision) clone() TemplateRevision {
+	r.Files = slices.Clone(r.Files)
+	r.Parameters = cloneParameters(r.Parameters)
+	return r
+}
+
+func cloneParameters(parameters []Parameter) []Parameter {
+	if parameters == nil {
+		return nil
+	}
+	cloned := make([]Parameter, len(parameters))
+	for i, parameter := range parameters {
+		parameter.AllowedValues = slices.Clone(parameter.AllowedValues)
+		if parameter.Min != nil {
+			value := *parameter.Min
+			parameter.Min = &value
+		}
+		if parameter.Max != nil {
+			value := *parameter.Max
+			parameter.Max = &value
+		}
+		cloned[i] = parameter
+	}
+	return cloned
+}
+
+func (vm VirtualMachine) clone() VirtualMachine {
+	vm.Parameters = maps.Clone(vm.Parameters)
+	vm.RenderedArtifacts = maps.Clone(vm.RenderedArtifacts)
+	vm.Conditions = slices.Clone(vm.Conditions)
+	vm.Events = slices.Clone(vm.Events)
+	return vm
+}
+
+func (s VirtualMachineSnapshot) clone() VirtualMachineSnapshot {
+	s.Parameters = maps.Clone(s.Parameters)
+	s.RenderedArtifacts = maps.Clone(s.RenderedArtifacts)
+	return s
+}
+
+func (c KubernetesCluster) clone() KubernetesCluster {
+	c.Parameters = maps.Clone(c.Parameters)
+	c.RenderedArtifacts = maps.Clone(c.RenderedArtifacts)
+	c.Conditions = slices.Clone(c.Conditions)
+	return c
+}
+
+func (o Operation) clone() Operation {
+	if o.VirtualMachine != nil {
+		vm := o.VirtualMachine.clone()
+		o.VirtualMachine = &vm
+	}
+	if o.KubernetesCluster != nil {
+		cluster := o.KubernetesCluster.clone()
+		o.KubernetesCluster = &cluster
+	}
+	return o
+}
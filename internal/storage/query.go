@@ -0,0 +1,522 @@
+package storage
+
+import (
+	"container/heap"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidQuery is returned when a filter, order or page token cannot be used
+var ErrInvalidQuery = errors.New("invalid list query")
+
+// ListOptions controls filtering, ordering and pagination of list operations.
+//
+// Filter is a conjunction of comparisons joined with AND, for example
+// `region = "eu-1" AND node_count > 3`. Supported operators are =, !=, <, <=, >, >=.
+// OrderBy is a comma separated list of fields, each optionally followed by
+// "asc" or "desc", for example `node_count desc, name`. Results are always
+// ordered by id last so that pages are deterministic.
+// A zero PageSize returns all remaining results. A PageToken continues after
+// the last result of the previous page, so results created or deleted between
+// pages neither shift nor repeat the following pages.
+type ListOptions struct {
+	Filter    string
+	OrderBy   string
+	PageSize  int32
+	PageToken string
+}
+
+// queryable is implemented by entities that can be filtered and ordered by field name
+type queryable interface {
+	fieldValue(name string) (any, bool)
+}
+
+func (t Template) fieldValue(name string) (any, bool) {
+	switch name {
+	case "id":
+		return t.ID, true
+	case "name":
+		return t.Name, true
+	case "type":
+		return t.Type, true
+	case "revision":
+		return t.Revision, true
+	case "resource_version":
+		return t.ResourceVersion, true
+	}
+	return nil, false
+}
+
+func (vm VirtualMachine) fieldValue(name string) (any, bool) {
+	switch name {
+	case "id":
+		return vm.ID, true
+	case "name":
+		return vm.Name, true
+	case "cpu":
+		return int64(vm.CPU), true
+	case "memory":
+		return int64(vm.Memory), true
+	case "os":
+		return vm.OS, true
+	case "template_id":
+		return vm.TemplateID, true
+	case "template_revision":
+		return vm.TemplateRevision, true
+	case "drift_status":
+		return vm.Drift.State, true
+	case "status":
+		return vm.Status, true
+	case "provisioner":
+		return vm.Provisioner, true
+	case "resource_version":
+		return vm.ResourceVersion, true
+	}
+	return nil, false
+}
+
+func (s VirtualMachineSnapshot) fieldValue(name string) (any, bool) {
+	switch name {
+	case "id":
+		return s.ID, true
+	case "virtual_machine_id":
+		return s.VirtualMachineID, true
+	case "name":
+		return s.Name, true
+	case "cpu":
+		return int64(s.CPU), true
+	case "memory":
+		return int64(s.Memory), true
+	case "os":
+		return s.OS, true
+	case "template_id":
+		return s.TemplateID, true
+	case "template_revision":
+		return s.TemplateRevision, true
+	}
+	return nil, false
+}
+
+func (c KubernetesCluster) fieldValue(name string) (any, bool) {
+	switch name {
+	case "id":
+		return c.ID, true
+	case "name":
+		return c.Name, true
+	case "region":
+		return c.Region, true
+	case "node_count":
+		return int64(c.NodeCount), true
+	case "version":
+		return c.Version, true
+	case "template_id":
+		return c.TemplateID, true
+	case "template_revision":
+		return c.TemplateRevision, true
+	case "drift_status":
+		return c.Drift.State, true
+	case "status":
+		return c.Status, true
+	case "provisioner":
+		return c.Provisioner, true
+	case "resource_version":
+		return c.ResourceVersion, true
+	}
+	return nil, false
+}
+
+func (o Operation) fieldValue(name string) (any, bool) {
+	switch name {
+	case "id":
+		return o.ID, true
+	case "type":
+		return o.Type, true
+	case "virtual_machine_id":
+		return o.VirtualMachineID, true
+	case "kubernetes_cluster_id":
+		return o.KubernetesClusterID, true
+	case "state":
+		return o.State, true
+	case "progress":
+		return int64(o.Progress), true
+	case "resource_version":
+		return o.ResourceVersion, true
+	}
+	return nil, false
+}
+
+// condition is a single `field op value` comparison of a filter
+type condition struct {
+	field string
+	op    string
+	value string
+}
+
+// orderField is a single field of an order_by clause
+type orderField struct {
+	field string
+	desc  bool
+}
+
+// pager collects a single page of a list query from entities added in any
+// order. Only the entities after the page token are kept, and when the page
+// size is set no more than one page of them is held in memory, so listing
+// a page does not sort the whole collection.
+type pager[T queryable] struct {
+	opts       ListOptions
+	conditions []condition
+	order      []orderField
+	after      []any // sort key of the last entity of the previous page
+	page       []T   // heap with the entity sorting last on top when paging
+}
+
+// newPager parses the filter, order and page token of opts
+func newPager[T queryable](opts ListOptions) (*pager[T], error) {
+	var zero T
+
+	conditions, err := parseFilter(opts.Filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range conditions {
+		value, ok := zero.fieldValue(c.field)
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown filter field %q", ErrInvalidQuery, c.field)
+		}
+		if _, isInt := value.(int64); isInt {
+			if _, err := strconv.ParseInt(c.value, 10, 64); err != nil {
+				return nil, fmt.Errorf("%w: field %q requires a number, got %q", ErrInvalidQuery, c.field, c.value)
+			}
+		}
+	}
+
+	order, err := parseOrderBy(opts.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range order {
+		if _, ok := zero.fieldValue(o.field); !ok {
+			return nil, fmt.Errorf("%w: unknown order field %q", ErrInvalidQuery, o.field)
+		}
+	}
+	order = append(order, orderField{field: "id"})
+
+	after, err := decodePageToken[T](opts.PageToken, opts, order)
+	if err != nil {
+		return nil, err
+	}
+	return &pager[T]{opts: opts, conditions: conditions, order: order, after: after}, nil
+}
+
+// add offers an entity to the page
+func (p *pager[T]) add(entity T) {
+	if !matches(entity, p.conditions) {
+		return
+	}
+	if p.after != nil && p.compareKeys(p.key(entity), p.after) <= 0 {
+		return
+	}
+	if p.opts.PageSize <= 0 {
+		p.page = append(p.page, entity)
+		return
+	}
+	// One entity more than the page size tells whether a next page exists
+	if len(p.page) <= int(p.opts.PageSize) {
+		heap.Push((*pageHeap[T])(p), entity)
+		return
+	}
+	if p.less(entity, p.page[0]) {
+		p.page[0] = entity
+		heap.Fix((*pageHeap[T])(p), 0)
+	}
+}
+
+// result returns the page in order and the token of the next page
+func (p *pager[T]) result() ([]T, string) {
+	page := p.page
+	sort.Slice(page, func(i, j int) bool { return p.less(page[i], page[j]) })
+	if p.opts.PageSize <= 0 || len(page) <= int(p.opts.PageSize) {
+		return page, ""
+	}
+	page = page[:p.opts.PageSize]
+	return page, encodePageToken(p.key(page[len(page)-1]), p.opts)
+}
+
+// key returns the values of the order fields of an entity
+func (p *pager[T]) key(entity T) []any {
+	key := make([]any, len(p.order))
+	for i, o := range p.order {
+		key[i], _ = entity.fieldValue(o.field)
+	}
+	return key
+}
+
+// compareKeys compares two sort keys in the order of the query
+func (p *pager[T]) compareKeys(a, b []any) int {
+	for i, o := range p.order {
+		c := compare(a[i], b[i])
+		if c == 0 {
+			continue
+		}
+		if o.desc {
+			return -c
+		}
+		return c
+	}
+	return 0
+}
+
+// less reports whether entity a sorts before entity b
+func (p *pager[T]) less(a, b T) bool {
+	return p.compareKeys(p.key(a), p.key(b)) < 0
+}
+
+// pageHeap implements heap.Interface for a pager, keeping the entity that
+// sorts last on top so that it is the one replaced by earlier entities
+type pageHeap[T queryable] pager[T]
+
+func (h *pageHeap[T]) Len() int           { return len(h.page) }
+func (h *pageHeap[T]) Less(i, j int) bool { return (*pager[T])(h).less(h.page[j], h.page[i]) }
+func (h *pageHeap[T]) Swap(i, j int)      { h.page[i], h.page[j] = h.page[j], h.page[i] }
+func (h *pageHeap[T]) Push(x any)         { h.page = append(h.page, x.(T)) }
+func (h *pageHeap[T]) Pop() any {
+	last := h.page[len(h.page)-1]
+	h.page = h.page[:len(h.page)-1]
+	return last
+}
+
+// matches reports whether the entity satisfies every condition
+func matches(entity queryable, conditions []condition) bool {
+	for _, c := range conditions {
+		value, _ := entity.fieldValue(c.field)
+		var cmp int
+		switch v := value.(type) {
+		case int64:
+			n, _ := strconv.ParseInt(c.value, 10, 64)
+			cmp = compare(v, n)
+		case string:
+			cmp = compare(v, c.value)
+		}
+		var ok bool
+		switch c.op {
+		case "=":
+			ok = cmp == 0
+		case "!=":
+			ok = cmp != 0
+		case "<":
+			ok = cmp < 0
+		case "<=":
+			ok = cmp <= 0
+		case ">":
+			ok = cmp > 0
+		case ">=":
+			ok = cmp >= 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// compare compares two field values of the same type
+func compare(a, b any) int {
+	switch x := a.(type) {
+	case int64:
+		y, _ := b.(int64)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	case string:
+		y, _ := b.(string)
+		return strings.Compare(x, y)
+	}
+	return 0
+}
+
+// parseFilter parses a filter expression into a list of conditions
+func parseFilter(filter string) ([]condition, error) {
+	tokens, err := tokenize(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var conditions []condition
+	for i := 0; i < len(tokens); {
+		if len(conditions) > 0 {
+			if !strings.EqualFold(tokens[i], "AND") {
+				return nil, fmt.Errorf("%w: expected AND, got %q", ErrInvalidQuery, tokens[i])
+			}
+			i++
+		}
+		if i+3 > len(tokens) {
+			return nil, fmt.Errorf("%w: incomplete filter expression", ErrInvalidQuery)
+		}
+		c := condition{field: tokens[i], op: tokens[i+1], value: tokens[i+2]}
+		switch c.op {
+		case "=", "!=", "<", "<=", ">", ">=":
+		default:
+			return nil, fmt.Errorf("%w: unknown operator %q", ErrInvalidQuery, c.op)
+		}
+		c.value = unquote(c.value)
+		conditions = append(conditions, c)
+		i += 3
+	}
+	return conditions, nil
+}
+
+// tokenize splits a filter expression into identifiers, operators and literals
+func tokenize(filter string) ([]string, error) {
+	var tokens []string
+	runes := []rune(filter)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '"':
+			j := i + 1
+			for j < len(runes) && runes[j] != '"' {
+				if runes[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("%w: unterminated string in filter", ErrInvalidQuery)
+			}
+			tokens = append(tokens, string(runes[i:j+1]))
+			i = j + 1
+		case strings.ContainsRune("=!<>", r):
+			j := i + 1
+			if j < len(runes) && runes[j] == '=' {
+				j++
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j
+		default:
+			j := i
+			for j < len(runes) && !unicode.IsSpace(runes[j]) && !strings.ContainsRune("=!<>\"", runes[j]) {
+				j++
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+// unquote strips the quotes and escapes of a string literal
+func unquote(value string) string {
+	if len(value) >= 2 && value[0] == '"' {
+		value = value[1 : len(value)-1]
+		return strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(value)
+	}
+	return value
+}
+
+// parseOrderBy parses an order_by clause
+func parseOrderBy(orderBy string) ([]orderField, error) {
+	var fields []orderField
+	if strings.TrimSpace(orderBy) == "" {
+		return nil, nil
+	}
+	for _, part := range strings.Split(orderBy, ",") {
+		words := strings.Fields(part)
+		if len(words) == 0 || len(words) > 2 {
+			return nil, fmt.Errorf("%w: invalid order_by %q", ErrInvalidQuery, orderBy)
+		}
+		field := orderField{field: words[0]}
+		if len(words) == 2 {
+			switch strings.ToLower(words[1]) {
+			case "asc":
+			case "desc":
+				field.desc = true
+			default:
+				return nil, fmt.Errorf("%w: invalid order direction %q", ErrInvalidQuery, words[1])
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// queryHash identifies the filter and ordering a page token was issued for
+func queryHash(opts ListOptions) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(opts.Filter + "\x00" + opts.OrderBy))
+	return strconv.FormatUint(uint64(h.Sum32()), 36)
+}
+
+// pageToken is the decoded form of a page token: the sort key of the last
+// entity of the page, which stays valid when entities are added or deleted
+// between pages
+type pageToken struct {
+	Query string   `json:"q"`
+	Key   []string `json:"k"`
+}
+
+// encodePageToken creates an opaque token for the page after the given sort key
+func encodePageToken(key []any, opts ListOptions) string {
+	token := pageToken{Query: queryHash(opts), Key: make([]string, len(key))}
+	for i, value := range key {
+		switch v := value.(type) {
+		case int64:
+			token.Key[i] = strconv.FormatInt(v, 10)
+		case string:
+			token.Key[i] = v
+		}
+	}
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken returns the sort key stored in a page token, or nil
+// without a token
+func decodePageToken[T queryable](token string, opts ListOptions, order []orderField) ([]any, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed page token", ErrInvalidQuery)
+	}
+	var decoded pageToken
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, fmt.Errorf("%w: malformed page token", ErrInvalidQuery)
+	}
+	if decoded.Query != queryHash(opts) {
+		return nil, fmt.Errorf("%w: page token does not match the filter and order", ErrInvalidQuery)
+	}
+	if len(decoded.Key) != len(order) {
+		return nil, fmt.Errorf("%w: malformed page token", ErrInvalidQuery)
+	}
+
+	var zero T
+	key := make([]any, len(order))
+	for i, o := range order {
+		value, _ := zero.fieldValue(o.field)
+		switch value.(type) {
+		case int64:
+			n, err := strconv.ParseInt(decoded.Key[i], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: malformed page token", ErrInvalidQuery)
+			}
+			key[i] = n
+		default:
+			key[i] = decoded.Key[i]
+		}
+	}
+	return key, nil
+}
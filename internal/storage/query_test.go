@@ -0,0 +1,184 @@
+package storage_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
+)
+
+// newClusters creates a memory storage holding clusters with the given names
+func newClusters(t *testing.T, names ...string) storage.Storage {
+	t.Helper()
+	s := storage.NewMemoryStorage()
+	for i, name := range names {
+		cluster := storage.KubernetesCluster{
+			ID:        fmt.Sprintf("c%d", i),
+			Name:      name,
+			Region:    "eu-1",
+			NodeCount: int32(i + 1),
+		}
+		if _, err := s.CreateKubernetesCluster(cluster); err != nil {
+			t.Fatalf("CreateKubernetesCluster: %v", err)
+		}
+	}
+	return s
+}
+
+func TestListFilter(t *testing.T) {
+	s := newClusters(t, "web", `say "hi"`, `back\slash`, "a=b", "AND")
+
+	tests := []struct {
+		name    string
+		filter  string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty", filter: "", want: "[c0 c1 c2 c3 c4]"},
+		{name: "equal", filter: `name = "web"`, want: "[c0]"},
+		{name: "not equal", filter: `name != "web"`, want: "[c1 c2 c3 c4]"},
+		{name: "less", filter: "node_count < 3", want: "[c0 c1]"},
+		{name: "less or equal", filter: "node_count <= 3", want: "[c0 c1 c2]"},
+		{name: "greater", filter: "node_count > 3", want: "[c3 c4]"},
+		{name: "greater or equal", filter: "node_count >= 3", want: "[c2 c3 c4]"},
+		{name: "without spaces", filter: `node_count>=3 AND name!="AND"`, want: "[c2 c3]"},
+		{name: "lower case and", filter: "node_count > 1 and node_count < 3", want: "[c1]"},
+		{name: "unquoted string", filter: "name = web", want: "[c0]"},
+		{name: "escaped quote", filter: `name = "say \"hi\""`, want: "[c1]"},
+		{name: "escaped backslash", filter: `name = "back\\slash"`, want: "[c2]"},
+		{name: "operator in quotes", filter: `name = "a=b"`, want: "[c3]"},
+		{name: "keyword in quotes", filter: `name = "AND"`, want: "[c4]"},
+		{name: "unknown field", filter: `owner = "me"`, wantErr: true},
+		{name: "field of another entity", filter: "cpu = 2", wantErr: true},
+		{name: "unknown operator", filter: `name ~ "web"`, wantErr: true},
+		{name: "double equals", filter: `name == "web"`, wantErr: true},
+		{name: "missing value", filter: "name =", wantErr: true},
+		{name: "missing operator", filter: `name "web"`, wantErr: true},
+		{name: "or", filter: `name = "web" OR name = "AND"`, wantErr: true},
+		{name: "trailing and", filter: `name = "web" AND`, wantErr: true},
+		{name: "unterminated string", filter: `name = "web`, wantErr: true},
+		{name: "escaped closing quote", filter: `name = "web\"`, wantErr: true},
+		{name: "quoted number", filter: `node_count = "3"`, want: "[c2]"},
+		{name: "number field with a word", filter: "node_count = three", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusters, _, err := s.ListKubernetesClusters(storage.ListOptions{Filter: tt.filter})
+			if tt.wantErr {
+				if !errors.Is(err, storage.ErrInvalidQuery) {
+					t.Fatalf("got error %v, want ErrInvalidQuery", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := clusterIDs(clusters); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListOrderBy(t *testing.T) {
+	s := newClusters(t, "b", "a", "b", "a")
+
+	tests := []struct {
+		name    string
+		orderBy string
+		want    string
+		wantErr bool
+	}{
+		{name: "id by default", orderBy: "", want: "[c0 c1 c2 c3]"},
+		{name: "ties ordered by id", orderBy: "name", want: "[c1 c3 c0 c2]"},
+		{name: "descending", orderBy: "name desc", want: "[c0 c2 c1 c3]"},
+		{name: "upper case direction", orderBy: "name DESC, node_count ASC", want: "[c0 c2 c1 c3]"},
+		{name: "several fields", orderBy: "name, node_count desc", want: "[c3 c1 c2 c0]"},
+		{name: "unknown field", orderBy: "owner", wantErr: true},
+		{name: "unknown direction", orderBy: "name down", wantErr: true},
+		{name: "too many words", orderBy: "name asc desc", wantErr: true},
+		{name: "empty field", orderBy: "name,", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusters, _, err := s.ListKubernetesClusters(storage.ListOptions{OrderBy: tt.orderBy})
+			if tt.wantErr {
+				if !errors.Is(err, storage.ErrInvalidQuery) {
+					t.Fatalf("got error %v, want ErrInvalidQuery", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := clusterIDs(clusters); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListPageToken(t *testing.T) {
+	s := newClusters(t, "a", "b", "c", "d", "e")
+	opts := storage.ListOptions{Filter: "node_count > 1", OrderBy: "node_count desc", PageSize: 2}
+	_, next, err := s.ListKubernetesClusters(opts)
+	if err != nil || next == "" {
+		t.Fatalf("ListKubernetesClusters = %q, %v, want a next page", next, err)
+	}
+
+	tests := []struct {
+		name    string
+		opts    storage.ListOptions
+		want    string
+		wantErr bool
+	}{
+		{name: "same query", opts: opts, want: "[c2 c1]"},
+		{name: "other page size", opts: storage.ListOptions{Filter: opts.Filter, OrderBy: opts.OrderBy, PageSize: 1}, want: "[c2]"},
+		{name: "other filter", opts: storage.ListOptions{Filter: "node_count > 2", OrderBy: opts.OrderBy}, wantErr: true},
+		{name: "other order", opts: storage.ListOptions{Filter: opts.Filter, OrderBy: "node_count"}, wantErr: true},
+		{name: "no order", opts: storage.ListOptions{Filter: opts.Filter}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.PageToken = next
+			clusters, _, err := s.ListKubernetesClusters(tt.opts)
+			if tt.wantErr {
+				if !errors.Is(err, storage.ErrInvalidQuery) {
+					t.Fatalf("got error %v, want ErrInvalidQuery", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := clusterIDs(clusters); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	for _, token := range []string{
+		"not base64!",
+		base64.RawURLEncoding.EncodeToString([]byte("not json")),
+		base64.RawURLEncoding.EncodeToString([]byte("2:abc")),
+	} {
+		opts.PageToken = token
+		if _, _, err := s.ListKubernetesClusters(opts); !errors.Is(err, storage.ErrInvalidQuery) {
+			t.Errorf("page token %q: got error %v, want ErrInvalidQuery", token, err)
+		}
+	}
+}
+
+// clusterIDs formats the IDs of clusters
+func clusterIDs(clusters []storage.KubernetesCluster) string {
+	ids := make([]string, 0, len(clusters))
+	for _, cluster := range clusters {
+		ids = append(ids, cluster.ID)
+	}
+	return fmt.Sprint(ids)
+}
@@ -1,20 +1,187 @@
 package storage
 
 import (
+	"encoding/json"
 	"errors"
-	"sync"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 )
 
 var (
-	ErrNotFound = errors.New("entity not found")
+	ErrNotFound      = errors.New("entity not found")
+	ErrAlreadyExists = errors.New("entity already exists")
+	ErrConflict      = errors.New("resource version conflict")
+	ErrInUse         = errors.New("template is in use")
+)
+
+// DependentsError lists the resources that prevent a template from being
+// deleted or changed. It matches ErrInUse with errors.Is.
+type DependentsError struct {
+	TemplateID         string
+	VirtualMachines    []string
+	KubernetesClusters []string
+}
+
+// Error returns the error message
+func (e *DependentsError) Error() string {
+	var dependents []string
+	if len(e.VirtualMachines) > 0 {
+		dependents = append(dependents, "virtual machines "+strings.Join(e.VirtualMachines, ", "))
+	}
+	if len(e.KubernetesClusters) > 0 {
+		dependents = append(dependents, "Kubernetes clusters "+strings.Join(e.KubernetesClusters, ", "))
+	}
+	return fmt.Sprintf("template %s is in use by %s", e.TemplateID, strings.Join(dependents, " and "))
+}
+
+// Is reports whether target is ErrInUse
+func (e *DependentsError) Is(target error) bool {
+	return target == ErrInUse
+}
+
+// Supported storage drivers
+const (
+	DriverMemory = "memory"
+	DriverBolt   = "bolt"
 )
 
 // Template represents a configuration template
 type Template struct {
 	ID          string
 	Name        string
-	Type        string // "vm" or "kubernetes"
+	Type        string // "vm", "kubernetes" or "partial"
+	Description string
+	RawTemplate string // rendered as the artifact named MainArtifact, may be empty if Files are set
+	Files       []TemplateFile
+	Revision    int64 // current revision, see TemplateRevision
+	Parameters  []Parameter
+	// Format the rendered artifacts must parse as: "plain", "yaml", "json",
+	// "toml", "ini" or "cloud-config". Empty is plain.
+	OutputFormat    string
+	NormalizeOutput bool   // replace rendered artifacts with their canonical formatting
+	OutputSchema    string // JSON Schema the main artifact must satisfy, as JSON or YAML
+	// Catalog file the template is loaded from, empty for templates managed
+	// through the API
+	CatalogFile string
+
+	ResourceVersion int64
+}
+
+// MainArtifact is the name of the artifact rendered from Template.RawTemplate
+const MainArtifact = "main"
+
+// TemplateFile is an additional file of a template, rendered as the artifact of the same name
+type TemplateFile struct {
+	Name         string
+	RawTemplate  string
+	OutputFormat string // overrides Template.OutputFormat if set
+	OutputSchema string // JSON Schema the artifact must satisfy, as JSON or YAML
+}
+
+// Artifacts maps artifact names to the content rendered for a resource
+type Artifacts map[string]string
+
+// UnmarshalJSON decodes artifacts, accepting the single rendered string
+// stored before templates had several files as the main artifact
+func (a *Artifacts) UnmarshalJSON(data []byte) error {
+	var rendered string
+	if err := json.Unmarshal(data, &rendered); err == nil {
+		*a = nil
+		if rendered != "" {
+			*a = Artifacts{MainArtifact: rendered}
+		}
+		return nil
+	}
+	return json.Unmarshal(data, (*map[string]string)(a))
+}
+
+// Parameter declares a value that resources pass to a template
+type Parameter struct {
+	Name          string
+	Type          string // "string", "integer", "number" or "boolean"
+	Required      bool
+	DefaultValue  string
+	AllowedValues []string
+	Min           *float64 // bounds the value of numbers and the length of strings
+	Max           *float64
+	Description   string
+}
+
+// TemplateRevision is an immutable snapshot of a template taken on every change
+type TemplateRevision struct {
+	TemplateID  string
+	Revision    int64
+	Name        string
+	Type        string
 	RawTemplate string
+	Files       []TemplateFile
+	Parameters  []Parameter
+	CreatedAt   time.Time
+
+	OutputFormat    string
+	NormalizeOutput bool
+	OutputSchema    string
+	Description     string
+}
+
+// Drift states of a resource
+const (
+	DriftInSync       = "in-sync"       // the rendered artifacts match the current template
+	DriftDrifted      = "drifted"       // the current template renders different artifacts
+	DriftRenderFailed = "render-failed" // the current template fails to render for the resource
+)
+
+// Drift tells whether the rendered artifacts of a resource still match what
+// the current revision of its template produces
+type Drift struct {
+	State string    // empty until the resource is first rendered or checked
+	Since time.Time // when State last changed
+}
+
+// WithState returns the drift with the given state, keeping Since if the
+// state does not change
+func (d Drift) WithState(state string, now time.Time) Drift {
+	if d.State == state {
+		return d
+	}
+	return Drift{State: state, Since: now}
+}
+
+// Lifecycle statuses of a resource
+const (
+	StatusPending      = "pending"
+	StatusProvisioning = "provisioning"
+	StatusRunning      = "running"
+	StatusStopping     = "stopping"
+	StatusStopped      = "stopped"
+	StatusUpdating     = "updating"
+	StatusDeleting     = "deleting"
+	StatusFailed       = "failed"
+
+	// Power statuses of virtual machines
+	StatusRestarting = "restarting"
+	StatusSuspending = "suspending"
+	StatusSuspended  = "suspended"
+)
+
+// Lifecycle is the status of a resource and the conditions that explain it.
+// Resources stored before statuses existed have an empty status and are
+// treated as running.
+type Lifecycle struct {
+	Status     string
+	Conditions []Condition
+}
+
+// Condition is an aspect of the state of a resource, such as whether it is ready
+type Condition struct {
+	Type               string
+	Status             bool
+	Reason             string // CamelCase cause of the last change
+	Message            string
+	LastTransitionTime time.Time // when Status last changed
+	LastUpdateTime     time.Time // when Reason or Message last changed
 }
 
 // VirtualMachine represents a VM configuration
@@ -25,7 +192,71 @@ type VirtualMachine struct {
 	Memory           int32
 	OS               string
 	TemplateID       string
-	RenderedTemplate string
+	TemplateRevision int64 // revision of the template the resource was rendered with
+	Parameters       map[string]string
+	// Rendered artifacts, stored under their former name RenderedTemplate
+	RenderedArtifacts Artifacts `json:"RenderedTemplate"`
+	Drift             Drift
+	Lifecycle
+	Provisioner string  // name of the driver that provisions the resource
+	Events      []Event // latest actions taken on the resource, oldest first
+
+	ResourceVersion int64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Actions recorded in the event history of a virtual machine
+const (
+	EventCreate    = "create"
+	EventUpdate    = "update"
+	EventDelete    = "delete"
+	EventStart     = "start"
+	EventStop      = "stop"
+	EventForceStop = "force_stop"
+	EventRestart   = "restart"
+	EventSuspend   = "suspend"
+	EventRestore   = "restore"
+)
+
+// MaxEvents is the number of events kept in the history of a virtual machine
+const MaxEvents = 20
+
+// Event records the outcome of an action taken on a virtual machine
+type Event struct {
+	Action    string
+	Succeeded bool
+	Message   string // why the action failed, if it did
+	Time      time.Time
+}
+
+// RecordEvent adds an event to the history of a virtual machine, dropping the
+// oldest events beyond MaxEvents
+func (vm *VirtualMachine) RecordEvent(event Event) {
+	// Copy the events, which may be shared with the stored resource
+	events := append([]Event(nil), vm.Events...)
+	events = append(events, event)
+	if len(events) > MaxEvents {
+		events = events[len(events)-MaxEvents:]
+	}
+	vm.Events = events
+}
+
+// VirtualMachineSnapshot is the configuration and rendered artifacts of a
+// virtual machine at a point in time. Snapshots do not change once taken.
+type VirtualMachineSnapshot struct {
+	ID                string
+	VirtualMachineID  string
+	Name              string
+	CPU               int32
+	Memory            int32
+	OS                string
+	TemplateID        string
+	TemplateRevision  int64
+	Parameters        map[string]string
+	RenderedArtifacts Artifacts
+
+	CreatedAt time.Time
 }
 
 // KubernetesCluster represents a Kubernetes cluster configuration
@@ -36,186 +267,211 @@ type KubernetesCluster struct {
 	NodeCount        int32
 	Version          string
 	TemplateID       string
-	RenderedTemplate string
-}
+	TemplateRevision int64 // revision of the template the resource was rendered with
+	Parameters       map[string]string
+	// Rendered artifacts, stored under their former name RenderedTemplate
+	RenderedArtifacts Artifacts `json:"RenderedTemplate"`
+	Drift             Drift
+	Lifecycle
+	Provisioner string // name of the driver that provisions the resource
 
-// Storage is an in-memory storage for our entities
-type Storage struct {
-	templates          map[string]Template
-	virtualMachines    map[string]VirtualMachine
-	kubernetesClusters map[string]KubernetesCluster
-	mu                 sync.RWMutex
+	ResourceVersion int64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
-// NewStorage creates a new in-memory storage
-func NewStorage() *Storage {
-	return &Storage{
-		templates:          make(map[string]Template),
-		virtualMachines:    make(map[string]VirtualMachine),
-		kubernetesClusters: make(map[string]KubernetesCluster),
-	}
-}
+// Operation types
+const (
+	OperationCreateVirtualMachine    = "create_virtual_machine"
+	OperationUpdateVirtualMachine    = "update_virtual_machine"
+	OperationDeleteVirtualMachine    = "delete_virtual_machine"
+	OperationCreateKubernetesCluster = "create_kubernetes_cluster"
+	OperationUpdateKubernetesCluster = "update_kubernetes_cluster"
+	OperationDeleteKubernetesCluster = "delete_kubernetes_cluster"
+	OperationStartVirtualMachine     = "start_virtual_machine"
+	OperationStopVirtualMachine      = "stop_virtual_machine"
+	OperationRestartVirtualMachine   = "restart_virtual_machine"
+	OperationSuspendVirtualMachine   = "suspend_virtual_machine"
+	OperationRestoreVirtualMachine   = "restore_virtual_machine"
+)
 
-// Template operations
+// Operation states
+const (
+	OperationPending   = "pending"
+	OperationRunning   = "running"
+	OperationSucceeded = "succeeded"
+	OperationFailed    = "failed"
+	OperationCancelled = "cancelled"
+)
 
-// CreateTemplate creates a new template
-func (s *Storage) CreateTemplate(template Template) Template {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.templates[template.ID] = template
-	return template
-}
+// Operation is a long-running change to a virtual machine or Kubernetes cluster
+type Operation struct {
+	ID                  string
+	Type                string
+	VirtualMachineID    string // set for virtual machine operations
+	KubernetesClusterID string // set for Kubernetes cluster operations
+	State               string
+	Progress            int32 // percentage of the work done
+	CancelRequested     bool
+	// What the work needs to resume after a server restart: the event it
+	// records on its resource and the status the resource enters once the
+	// work succeeds
+	Event        string
+	TargetStatus string
+	// Result of a succeeded create or update: the resource as it was stored
+	VirtualMachine    *VirtualMachine
+	KubernetesCluster *KubernetesCluster
+	// Error of a failed or cancelled operation, with its connect code
+	ErrorCode    string
+	ErrorMessage string
 
-// GetTemplate retrieves a template by ID
-func (s *Storage) GetTemplate(id string) (Template, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	template, ok := s.templates[id]
-	if !ok {
-		return Template{}, ErrNotFound
-	}
-	return template, nil
+	ResourceVersion int64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	EndedAt         time.Time // when the operation finished
 }
 
-// ListTemplates retrieves all templates
-func (s *Storage) ListTemplates(templateType string) []Template {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	var templates []Template
-	for _, template := range s.templates {
-		if templateType == "" || template.Type == templateType {
-			templates = append(templates, template)
-		}
-	}
-	return templates
+// Done reports whether an operation finished
+func (o Operation) Done() bool {
+	return o.State == OperationSucceeded || o.State == OperationFailed || o.State == OperationCancelled
 }
 
-// UpdateTemplate updates an existing template
-func (s *Storage) UpdateTemplate(template Template) (Template, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if _, ok := s.templates[template.ID]; !ok {
-		return Template{}, ErrNotFound
-	}
-	s.templates[template.ID] = template
-	return template, nil
-}
+// Storage is the persistence layer for our entities.
+//
+// Every entity carries a ResourceVersion that starts at 1 on creation and is
+// incremented on each update. Updates and deletes that pass a non-zero
+// resource version are rejected with ErrConflict when it differs from the
+// stored one; a zero resource version skips the check. Creating or updating a
+// virtual machine, Kubernetes cluster or operation sets its CreatedAt and
+// UpdatedAt.
+//
+// Creating or updating a template records a new TemplateRevision; revisions
+// are removed together with their template.
+//
+// A template that virtual machines or Kubernetes clusters are rendered from
+// cannot be deleted or change its type; such calls fail with a
+// *DependentsError. Deleting with cascade also deletes the dependents.
+//
+// List operations apply ListOptions and return the token of the next page,
+// which is empty on the last page.
+type Storage interface {
+	// Template operations
+	CreateTemplate(template Template) (Template, error)
+	GetTemplate(id string) (Template, error)
+	ListTemplates(templateType string, opts ListOptions) ([]Template, string, error)
+	UpdateTemplate(template Template) (Template, error)
+	DeleteTemplate(id string, resourceVersion int64, cascade bool) error
+	ListTemplateRevisions(templateID string) ([]TemplateRevision, error)
+	GetTemplateRevision(templateID string, revision int64) (TemplateRevision, error)
 
-// DeleteTemplate deletes a template by ID
-func (s *Storage) DeleteTemplate(id string) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if _, ok := s.templates[id]; !ok {
-		return ErrNotFound
-	}
-	delete(s.templates, id)
-	return nil
-}
+	// VirtualMachine operations
+	CreateVirtualMachine(vm VirtualMachine) (VirtualMachine, error)
+	GetVirtualMachine(id string) (VirtualMachine, error)
+	ListVirtualMachines(opts ListOptions) ([]VirtualMachine, string, error)
+	UpdateVirtualMachine(vm VirtualMachine) (VirtualMachine, error)
+	DeleteVirtualMachine(id string, resourceVersion int64) error
 
-// VirtualMachine operations
+	// VirtualMachineSnapshot operations. A snapshot can only be created for an
+	// existing virtual machine, and is deleted together with it. Listing with
+	// an empty virtual machine ID lists the snapshots of all virtual machines.
+	CreateVirtualMachineSnapshot(snapshot VirtualMachineSnapshot) (VirtualMachineSnapshot, error)
+	GetVirtualMachineSnapshot(id string) (VirtualMachineSnapshot, error)
+	ListVirtualMachineSnapshots(vmID string, opts ListOptions) ([]VirtualMachineSnapshot, string, error)
+	DeleteVirtualMachineSnapshot(id string) error
 
-// CreateVirtualMachine creates a new virtual machine
-func (s *Storage) CreateVirtualMachine(vm VirtualMachine) VirtualMachine {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.virtualMachines[vm.ID] = vm
-	return vm
-}
+	// KubernetesCluster operations
+	CreateKubernetesCluster(cluster KubernetesCluster) (KubernetesCluster, error)
+	GetKubernetesCluster(id string) (KubernetesCluster, error)
+	ListKubernetesClusters(opts ListOptions) ([]KubernetesCluster, string, error)
+	UpdateKubernetesCluster(cluster KubernetesCluster) (KubernetesCluster, error)
+	DeleteKubernetesCluster(id string, resourceVersion int64) error
 
-// GetVirtualMachine retrieves a virtual machine by ID
-func (s *Storage) GetVirtualMachine(id string) (VirtualMachine, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	vm, ok := s.virtualMachines[id]
-	if !ok {
-		return VirtualMachine{}, ErrNotFound
-	}
-	return vm, nil
-}
+	// Long-running operations
+	CreateOperation(op Operation) (Operation, error)
+	GetOperation(id string) (Operation, error)
+	ListOperations(opts ListOptions) ([]Operation, string, error)
+	UpdateOperation(op Operation) (Operation, error)
+	DeleteOperation(id string) error
 
-// ListVirtualMachines retrieves all virtual machines
-func (s *Storage) ListVirtualMachines() []VirtualMachine {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	var vms []VirtualMachine
-	for _, vm := range s.virtualMachines {
-		vms = append(vms, vm)
-	}
-	return vms
+	// Close releases any resources held by the storage
+	Close() error
 }
 
-// UpdateVirtualMachine updates an existing virtual machine
-func (s *Storage) UpdateVirtualMachine(vm VirtualMachine) (VirtualMachine, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if _, ok := s.virtualMachines[vm.ID]; !ok {
-		return VirtualMachine{}, ErrNotFound
+// Open creates a storage for the given driver.
+// The path is only used by drivers that persist data on disk.
+func Open(driver, path string) (Storage, error) {
+	switch driver {
+	case "", DriverMemory:
+		return NewMemoryStorage(), nil
+	case DriverBolt:
+		return NewBoltStorage(path)
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", driver)
 	}
-	s.virtualMachines[vm.ID] = vm
-	return vm, nil
 }
 
-// DeleteVirtualMachine deletes a virtual machine by ID
-func (s *Storage) DeleteVirtualMachine(id string) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if _, ok := s.virtualMachines[id]; !ok {
-		return ErrNotFound
+// CheckResourceVersion verifies that the requested resource version matches the stored one
+func CheckResourceVersion(stored, requested int64) error {
+	if requested != 0 && requested != stored {
+		return fmt.Errorf("%w: stored version is %d, got %d", ErrConflict, stored, requested)
 	}
-	delete(s.virtualMachines, id)
 	return nil
 }
 
-// KubernetesCluster operations
+// Template returns the template as it was at this revision
+func (r TemplateRevision) Template() Template {
+	return Template{
+		ID:          r.TemplateID,
+		Name:        r.Name,
+		Type:        r.Type,
+		RawTemplate: r.RawTemplate,
+		Files:       r.Files,
+		Revision:    r.Revision,
+		Parameters:  r.Parameters,
 
-// CreateKubernetesCluster creates a new Kubernetes cluster
-func (s *Storage) CreateKubernetesCluster(cluster KubernetesCluster) KubernetesCluster {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.kubernetesClusters[cluster.ID] = cluster
-	return cluster
-}
-
-// GetKubernetesCluster retrieves a Kubernetes cluster by ID
-func (s *Storage) GetKubernetesCluster(id string) (KubernetesCluster, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	cluster, ok := s.kubernetesClusters[id]
-	if !ok {
-		return KubernetesCluster{}, ErrNotFound
+		OutputFormat:    r.OutputFormat,
+		NormalizeOutput: r.NormalizeOutput,
+		OutputSchema:    r.OutputSchema,
+		Description:     r.Description,
 	}
-	return cluster, nil
 }
 
-// ListKubernetesClusters retrieves all Kubernetes clusters
-func (s *Storage) ListKubernetesClusters() []KubernetesCluster {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	var clusters []KubernetesCluster
-	for _, cluster := range s.kubernetesClusters {
-		clusters = append(clusters, cluster)
-	}
-	return clusters
-}
+// newTemplateRevision snapshots the current state of a template
+func newTemplateRevision(template Template) TemplateRevision {
+	return TemplateRevision{
+		TemplateID:  template.ID,
+		Revision:    template.Revision,
+		Name:        template.Name,
+		Type:        template.Type,
+		RawTemplate: template.RawTemplate,
+		Files:       template.Files,
+		Parameters:  template.Parameters,
+		CreatedAt:   time.Now().UTC(),
 
-// UpdateKubernetesCluster updates an existing Kubernetes cluster
-func (s *Storage) UpdateKubernetesCluster(cluster KubernetesCluster) (KubernetesCluster, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if _, ok := s.kubernetesClusters[cluster.ID]; !ok {
-		return KubernetesCluster{}, ErrNotFound
+		OutputFormat:    template.OutputFormat,
+		NormalizeOutput: template.NormalizeOutput,
+		OutputSchema:    template.OutputSchema,
+		Description:     template.Description,
 	}
-	s.kubernetesClusters[cluster.ID] = cluster
-	return cluster, nil
 }
 
-// DeleteKubernetesCluster deletes a Kubernetes cluster by ID
-func (s *Storage) DeleteKubernetesCluster(id string) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if _, ok := s.kubernetesClusters[id]; !ok {
-		return ErrNotFound
+// templateDependents returns the resources rendered from a template, or nil if there are none
+func templateDependents(templateID string, vms []VirtualMachine, clusters []KubernetesCluster) *DependentsError {
+	dependents := &DependentsError{TemplateID: templateID}
+	for _, vm := range vms {
+		if vm.TemplateID == templateID {
+			dependents.VirtualMachines = append(dependents.VirtualMachines, vm.ID)
+		}
 	}
-	delete(s.kubernetesClusters, id)
-	return nil
+	for _, cluster := range clusters {
+		if cluster.TemplateID == templateID {
+			dependents.KubernetesClusters = append(dependents.KubernetesClusters, cluster.ID)
+		}
+	}
+	if len(dependents.VirtualMachines) == 0 && len(dependents.KubernetesClusters) == 0 {
+		return nil
+	}
+	sort.Strings(dependents.VirtualMachines)
+	sort.Strings(dependents.KubernetesClusters)
+	return dependents
 }
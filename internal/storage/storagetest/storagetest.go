@@ -0,0 +1,776 @@
+// Package storagetest provides a conformance suite that every storage.Storage
+// backend is expected to pass.
+package storagetest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
+)
+
+// Factory creates a fresh, empty storage for a single test
+type Factory func(t *testing.T) storage.Storage
+
+// Run runs the full conformance suite against the storage created by newStorage
+func Run(t *testing.T, newStorage Factory) {
+	t.Run("Templates", func(t *testing.T) { testTemplates(t, open(t, newStorage)) })
+	t.Run("VirtualMachines", func(t *testing.T) { testVirtualMachines(t, open(t, newStorage)) })
+	t.Run("VirtualMachineSnapshots", func(t *testing.T) { testVirtualMachineSnapshots(t, open(t, newStorage)) })
+	t.Run("KubernetesClusters", func(t *testing.T) { testKubernetesClusters(t, open(t, newStorage)) })
+	t.Run("Operations", func(t *testing.T) { testOperations(t, open(t, newStorage)) })
+	t.Run("ResourceVersions", func(t *testing.T) { testResourceVersions(t, open(t, newStorage)) })
+	t.Run("TemplateRevisions", func(t *testing.T) { testTemplateRevisions(t, open(t, newStorage)) })
+	t.Run("TemplateRevisionsOfPrefixIDs", func(t *testing.T) { testTemplateRevisionsOfPrefixIDs(t, open(t, newStorage)) })
+	t.Run("TemplateDependents", func(t *testing.T) { testTemplateDependents(t, open(t, newStorage)) })
+	t.Run("ListOptions", func(t *testing.T) { testListOptions(t, open(t, newStorage)) })
+	t.Run("Aliasing", func(t *testing.T) { testAliasing(t, open(t, newStorage)) })
+}
+
+func open(t *testing.T, newStorage Factory) storage.Storage {
+	t.Helper()
+	s := newStorage(t)
+	t.Cleanup(func() {
+		if err := s.Close(); err != nil {
+			t.Errorf("Close: %v", err)
+		}
+	})
+	return s
+}
+
+func testTemplates(t *testing.T, s storage.Storage) {
+	minDisk := 10.0
+	vmTemplate := storage.Template{ID: "t1", Name: "VM", Type: "vm", RawTemplate: "{{ .Name }}", Parameters: []storage.Parameter{
+		{Name: "disk_size", Type: "integer", Required: true, Min: &minDisk},
+		{Name: "cni", Type: "string", DefaultValue: "calico", AllowedValues: []string{"calico", "cilium"}},
+	}}
+	k8sTemplate := storage.Template{ID: "t2", Name: "K8s", Type: "kubernetes", RawTemplate: "{{ .Region }}", Files: []storage.TemplateFile{
+		{Name: "kubeconfig", RawTemplate: "cluster: {{ .Name }}", OutputFormat: "yaml", OutputSchema: "type: object"},
+	}, OutputFormat: "plain", NormalizeOutput: true}
+
+	vmTemplate, err := s.CreateTemplate(vmTemplate)
+	if err != nil {
+		t.Fatalf("CreateTemplate(%s): %v", vmTemplate.ID, err)
+	}
+	if _, err := s.CreateTemplate(k8sTemplate); err != nil {
+		t.Fatalf("CreateTemplate(%s): %v", k8sTemplate.ID, err)
+	}
+	if _, err := s.CreateTemplate(k8sTemplate); !errors.Is(err, storage.ErrAlreadyExists) {
+		t.Errorf("CreateTemplate(duplicate) error = %v, want ErrAlreadyExists", err)
+	}
+
+	got, err := s.GetTemplate(vmTemplate.ID)
+	if err != nil {
+		t.Fatalf("GetTemplate: %v", err)
+	}
+	if !reflect.DeepEqual(got, vmTemplate) {
+		t.Errorf("GetTemplate = %+v, want %+v", got, vmTemplate)
+	}
+
+	all, _, err := s.ListTemplates("", storage.ListOptions{})
+	if err != nil {
+		t.Fatalf("ListTemplates: %v", err)
+	}
+	if len(all) != 2 {
+		t.Errorf("ListTemplates(\"\") returned %d templates, want 2", len(all))
+	}
+	vms, _, err := s.ListTemplates("vm", storage.ListOptions{})
+	if err != nil {
+		t.Fatalf("ListTemplates(vm): %v", err)
+	}
+	if len(vms) != 1 || vms[0].ID != vmTemplate.ID {
+		t.Errorf("ListTemplates(vm) = %+v, want only %s", vms, vmTemplate.ID)
+	}
+
+	vmTemplate.Name = "VM v2"
+	if _, err := s.UpdateTemplate(vmTemplate); err != nil {
+		t.Fatalf("UpdateTemplate: %v", err)
+	}
+	if got, _ := s.GetTemplate(vmTemplate.ID); got.Name != "VM v2" {
+		t.Errorf("UpdateTemplate did not persist, got name %q", got.Name)
+	}
+	if _, err := s.UpdateTemplate(storage.Template{ID: "missing"}); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("UpdateTemplate(missing) error = %v, want ErrNotFound", err)
+	}
+
+	if err := s.DeleteTemplate(vmTemplate.ID, 0, false); err != nil {
+		t.Fatalf("DeleteTemplate: %v", err)
+	}
+	if _, err := s.GetTemplate(vmTemplate.ID); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("GetTemplate after delete error = %v, want ErrNotFound", err)
+	}
+	if err := s.DeleteTemplate(vmTemplate.ID, 0, false); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("DeleteTemplate(missing) error = %v, want ErrNotFound", err)
+	}
+}
+
+func testVirtualMachines(t *testing.T, s storage.Storage) {
+	vm := storage.VirtualMachine{ID: "vm1", Name: "web", CPU: 2, Memory: 2048, OS: "ubuntu", TemplateID: "t1", Parameters: map[string]string{"disk_size": "20"}, RenderedArtifacts: storage.Artifacts{storage.MainArtifact: "web", "meta-data": "instance-id: web"}}
+
+	vm, err := s.CreateVirtualMachine(vm)
+	if err != nil {
+		t.Fatalf("CreateVirtualMachine: %v", err)
+	}
+	got, err := s.GetVirtualMachine(vm.ID)
+	if err != nil {
+		t.Fatalf("GetVirtualMachine: %v", err)
+	}
+	if !reflect.DeepEqual(got, vm) {
+		t.Errorf("GetVirtualMachine = %+v, want %+v", got, vm)
+	}
+
+	vms, _, err := s.ListVirtualMachines(storage.ListOptions{})
+	if err != nil {
+		t.Fatalf("ListVirtualMachines: %v", err)
+	}
+	if len(vms) != 1 {
+		t.Errorf("ListVirtualMachines returned %d VMs, want 1", len(vms))
+	}
+
+	vm.CPU = 4
+	if _, err := s.UpdateVirtualMachine(vm); err != nil {
+		t.Fatalf("UpdateVirtualMachine: %v", err)
+	}
+	if got, _ := s.GetVirtualMachine(vm.ID); got.CPU != 4 {
+		t.Errorf("UpdateVirtualMachine did not persist, got cpu %d", got.CPU)
+	}
+	if _, err := s.UpdateVirtualMachine(storage.VirtualMachine{ID: "missing"}); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("UpdateVirtualMachine(missing) error = %v, want ErrNotFound", err)
+	}
+
+	if err := s.DeleteVirtualMachine(vm.ID, 0); err != nil {
+		t.Fatalf("DeleteVirtualMachine: %v", err)
+	}
+	if _, err := s.GetVirtualMachine(vm.ID); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("GetVirtualMachine after delete error = %v, want ErrNotFound", err)
+	}
+	if err := s.DeleteVirtualMachine(vm.ID, 0); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("DeleteVirtualMachine(missing) error = %v, want ErrNotFound", err)
+	}
+}
+
+func testVirtualMachineSnapshots(t *testing.T, s storage.Storage) {
+	for _, id := range []string{"vm1", "vm2"} {
+		if _, err := s.CreateVirtualMachine(storage.VirtualMachine{ID: id, Name: id, TemplateID: "t1"}); err != nil {
+			t.Fatalf("CreateVirtualMachine(%s): %v", id, err)
+		}
+	}
+
+	snapshot := storage.VirtualMachineSnapshot{ID: "s1", VirtualMachineID: "vm1", Name: "before upgrade", CPU: 2, Memory: 2048, OS: "ubuntu", TemplateID: "t1", TemplateRevision: 3, Parameters: map[string]string{"disk_size": "20"}, RenderedArtifacts: storage.Artifacts{storage.MainArtifact: "web"}}
+	snapshot, err := s.CreateVirtualMachineSnapshot(snapshot)
+	if err != nil {
+		t.Fatalf("CreateVirtualMachineSnapshot: %v", err)
+	}
+	if snapshot.CreatedAt.IsZero() {
+		t.Error("CreateVirtualMachineSnapshot did not set the creation time")
+	}
+	got, err := s.GetVirtualMachineSnapshot(snapshot.ID)
+	if err != nil {
+		t.Fatalf("GetVirtualMachineSnapshot: %v", err)
+	}
+	if !reflect.DeepEqual(got, snapshot) {
+		t.Errorf("GetVirtualMachineSnapshot = %+v, want %+v", got, snapshot)
+	}
+	if _, err := s.CreateVirtualMachineSnapshot(snapshot); !errors.Is(err, storage.ErrAlreadyExists) {
+		t.Errorf("CreateVirtualMachineSnapshot(duplicate) error = %v, want ErrAlreadyExists", err)
+	}
+	if _, err := s.CreateVirtualMachineSnapshot(storage.VirtualMachineSnapshot{ID: "s9", VirtualMachineID: "missing"}); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("CreateVirtualMachineSnapshot(missing VM) error = %v, want ErrNotFound", err)
+	}
+	for _, other := range []storage.VirtualMachineSnapshot{{ID: "s2", VirtualMachineID: "vm1"}, {ID: "s3", VirtualMachineID: "vm2"}} {
+		if _, err := s.CreateVirtualMachineSnapshot(other); err != nil {
+			t.Fatalf("CreateVirtualMachineSnapshot(%s): %v", other.ID, err)
+		}
+	}
+
+	snapshots, _, err := s.ListVirtualMachineSnapshots("vm1", storage.ListOptions{})
+	if err != nil {
+		t.Fatalf("ListVirtualMachineSnapshots: %v", err)
+	}
+	if len(snapshots) != 2 || snapshots[0].ID != "s1" || snapshots[1].ID != "s2" {
+		t.Errorf("ListVirtualMachineSnapshots(vm1) = %+v, want s1 and s2", snapshots)
+	}
+	if all, _, _ := s.ListVirtualMachineSnapshots("", storage.ListOptions{}); len(all) != 3 {
+		t.Errorf("ListVirtualMachineSnapshots(all) returned %d snapshots, want 3", len(all))
+	}
+
+	if err := s.DeleteVirtualMachineSnapshot("s2"); err != nil {
+		t.Fatalf("DeleteVirtualMachineSnapshot: %v", err)
+	}
+	if _, err := s.GetVirtualMachineSnapshot("s2"); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("GetVirtualMachineSnapshot after delete error = %v, want ErrNotFound", err)
+	}
+	if err := s.DeleteVirtualMachineSnapshot("s2"); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("DeleteVirtualMachineSnapshot(missing) error = %v, want ErrNotFound", err)
+	}
+
+	// Deleting a virtual machine deletes its snapshots but no others
+	if err := s.DeleteVirtualMachine("vm1", 0); err != nil {
+		t.Fatalf("DeleteVirtualMachine: %v", err)
+	}
+	if _, err := s.GetVirtualMachineSnapshot("s1"); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("GetVirtualMachineSnapshot after deleting its VM error = %v, want ErrNotFound", err)
+	}
+	if _, err := s.GetVirtualMachineSnapshot("s3"); err != nil {
+		t.Errorf("GetVirtualMachineSnapshot(s3): %v", err)
+	}
+}
+
+func testKubernetesClusters(t *testing.T, s storage.Storage) {
+	cluster := storage.KubernetesCluster{ID: "c1", Name: "prod", Region: "eu-1", NodeCount: 3, Version: "1.30", TemplateID: "t2", Parameters: map[string]string{"cni": "cilium"}, RenderedArtifacts: storage.Artifacts{storage.MainArtifact: "prod"}}
+
+	cluster, err := s.CreateKubernetesCluster(cluster)
+	if err != nil {
+		t.Fatalf("CreateKubernetesCluster: %v", err)
+	}
+	got, err := s.GetKubernetesCluster(cluster.ID)
+	if err != nil {
+		t.Fatalf("GetKubernetesCluster: %v", err)
+	}
+	if !reflect.DeepEqual(got, cluster) {
+		t.Errorf("GetKubernetesCluster = %+v, want %+v", got, cluster)
+	}
+
+	clusters, _, err := s.ListKubernetesClusters(storage.ListOptions{})
+	if err != nil {
+		t.Fatalf("ListKubernetesClusters: %v", err)
+	}
+	if len(clusters) != 1 {
+		t.Errorf("ListKubernetesClusters returned %d clusters, want 1", len(clusters))
+	}
+
+	cluster.NodeCount = 5
+	if _, err := s.UpdateKubernetesCluster(cluster); err != nil {
+		t.Fatalf("UpdateKubernetesCluster: %v", err)
+	}
+	if got, _ := s.GetKubernetesCluster(cluster.ID); got.NodeCount != 5 {
+		t.Errorf("UpdateKubernetesCluster did not persist, got node_count %d", got.NodeCount)
+	}
+	if _, err := s.UpdateKubernetesCluster(storage.KubernetesCluster{ID: "missing"}); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("UpdateKubernetesCluster(missing) error = %v, want ErrNotFound", err)
+	}
+
+	if err := s.DeleteKubernetesCluster(cluster.ID, 0); err != nil {
+		t.Fatalf("DeleteKubernetesCluster: %v", err)
+	}
+	if _, err := s.GetKubernetesCluster(cluster.ID); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("GetKubernetesCluster after delete error = %v, want ErrNotFound", err)
+	}
+	if err := s.DeleteKubernetesCluster(cluster.ID, 0); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("DeleteKubernetesCluster(missing) error = %v, want ErrNotFound", err)
+	}
+}
+
+func testOperations(t *testing.T, s storage.Storage) {
+	op := storage.Operation{ID: "op1", Type: storage.OperationUpdateVirtualMachine, VirtualMachineID: "vm1", State: storage.OperationRunning, Event: storage.EventUpdate, TargetStatus: storage.StatusStopped}
+
+	op, err := s.CreateOperation(op)
+	if err != nil {
+		t.Fatalf("CreateOperation: %v", err)
+	}
+	if op.ResourceVersion != 1 {
+		t.Errorf("created resource version = %d, want 1", op.ResourceVersion)
+	}
+	if _, err := s.CreateOperation(op); !errors.Is(err, storage.ErrAlreadyExists) {
+		t.Errorf("CreateOperation(duplicate) error = %v, want ErrAlreadyExists", err)
+	}
+	got, err := s.GetOperation(op.ID)
+	if err != nil {
+		t.Fatalf("GetOperation: %v", err)
+	}
+	if !reflect.DeepEqual(got, op) {
+		t.Errorf("GetOperation = %+v, want %+v", got, op)
+	}
+
+	// A finished operation keeps its result and error
+	done := op
+	done.State = storage.OperationSucceeded
+	done.Progress = 100
+	done.VirtualMachine = &storage.VirtualMachine{ID: "vm1", Name: "web", Lifecycle: storage.Lifecycle{Status: storage.StatusStopped}}
+	done.EndedAt = time.Now().UTC()
+	done, err = s.UpdateOperation(done)
+	if err != nil {
+		t.Fatalf("UpdateOperation: %v", err)
+	}
+	if done.ResourceVersion != 2 {
+		t.Errorf("updated resource version = %d, want 2", done.ResourceVersion)
+	}
+	if got, _ := s.GetOperation(op.ID); !reflect.DeepEqual(got, done) {
+		t.Errorf("UpdateOperation did not persist, got %+v, want %+v", got, done)
+	}
+	if _, err := s.UpdateOperation(op); !errors.Is(err, storage.ErrConflict) {
+		t.Errorf("stale UpdateOperation error = %v, want ErrConflict", err)
+	}
+	if _, err := s.UpdateOperation(storage.Operation{ID: "missing"}); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("UpdateOperation(missing) error = %v, want ErrNotFound", err)
+	}
+
+	if _, err := s.CreateOperation(storage.Operation{ID: "op2", Type: storage.OperationCreateKubernetesCluster, KubernetesClusterID: "c1", State: storage.OperationPending}); err != nil {
+		t.Fatalf("CreateOperation: %v", err)
+	}
+	ops, _, err := s.ListOperations(storage.ListOptions{Filter: `state = "pending"`})
+	if err != nil {
+		t.Fatalf("ListOperations: %v", err)
+	}
+	if len(ops) != 1 || ops[0].ID != "op2" {
+		t.Errorf("ListOperations(pending) = %+v, want op2", ops)
+	}
+
+	if err := s.DeleteOperation(op.ID); err != nil {
+		t.Fatalf("DeleteOperation: %v", err)
+	}
+	if _, err := s.GetOperation(op.ID); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("GetOperation after delete error = %v, want ErrNotFound", err)
+	}
+	if err := s.DeleteOperation(op.ID); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("DeleteOperation(missing) error = %v, want ErrNotFound", err)
+	}
+}
+
+func testResourceVersions(t *testing.T, s storage.Storage) {
+	cluster, err := s.CreateKubernetesCluster(storage.KubernetesCluster{ID: "c1", Name: "prod", ResourceVersion: 42})
+	if err != nil {
+		t.Fatalf("CreateKubernetesCluster: %v", err)
+	}
+	if cluster.ResourceVersion != 1 {
+		t.Fatalf("created resource version = %d, want 1", cluster.ResourceVersion)
+	}
+
+	// Two writers read the same version
+	first, second := cluster, cluster
+	first.NodeCount = 3
+	updated, err := s.UpdateKubernetesCluster(first)
+	if err != nil {
+		t.Fatalf("UpdateKubernetesCluster: %v", err)
+	}
+	if updated.ResourceVersion != 2 {
+		t.Errorf("updated resource version = %d, want 2", updated.ResourceVersion)
+	}
+
+	second.NodeCount = 5
+	if _, err := s.UpdateKubernetesCluster(second); !errors.Is(err, storage.ErrConflict) {
+		t.Errorf("stale UpdateKubernetesCluster error = %v, want ErrConflict", err)
+	}
+	if err := s.DeleteKubernetesCluster(cluster.ID, 1); !errors.Is(err, storage.ErrConflict) {
+		t.Errorf("stale DeleteKubernetesCluster error = %v, want ErrConflict", err)
+	}
+
+	// A zero resource version skips the check
+	second.ResourceVersion = 0
+	if updated, err = s.UpdateKubernetesCluster(second); err != nil {
+		t.Fatalf("unconditional UpdateKubernetesCluster: %v", err)
+	}
+	if updated.ResourceVersion != 3 {
+		t.Errorf("updated resource version = %d, want 3", updated.ResourceVersion)
+	}
+	if err := s.DeleteKubernetesCluster(cluster.ID, updated.ResourceVersion); err != nil {
+		t.Errorf("DeleteKubernetesCluster with current version: %v", err)
+	}
+}
+
+func testTemplateRevisions(t *testing.T, s storage.Storage) {
+	template, err := s.CreateTemplate(storage.Template{ID: "t1", Name: "VM", Type: "vm", RawTemplate: "v1"})
+	if err != nil {
+		t.Fatalf("CreateTemplate: %v", err)
+	}
+	if template.Revision != 1 {
+		t.Errorf("created revision = %d, want 1", template.Revision)
+	}
+
+	template.RawTemplate = "v2"
+	if template, err = s.UpdateTemplate(template); err != nil {
+		t.Fatalf("UpdateTemplate: %v", err)
+	}
+	if template.Revision != 2 {
+		t.Errorf("updated revision = %d, want 2", template.Revision)
+	}
+
+	revisions, err := s.ListTemplateRevisions(template.ID)
+	if err != nil {
+		t.Fatalf("ListTemplateRevisions: %v", err)
+	}
+	if len(revisions) != 2 {
+		t.Fatalf("ListTemplateRevisions returned %d revisions, want 2", len(revisions))
+	}
+	for i, want := range []string{"v1", "v2"} {
+		if r := revisions[i]; r.Revision != int64(i+1) || r.RawTemplate != want || r.TemplateID != template.ID {
+			t.Errorf("revision %d = %+v, want revision %d with body %q", i, r, i+1, want)
+		}
+	}
+
+	first, err := s.GetTemplateRevision(template.ID, 1)
+	if err != nil {
+		t.Fatalf("GetTemplateRevision: %v", err)
+	}
+	if first.RawTemplate != "v1" || first.CreatedAt.IsZero() {
+		t.Errorf("GetTemplateRevision(1) = %+v, want body v1 with a creation time", first)
+	}
+	if _, err := s.GetTemplateRevision(template.ID, 3); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("GetTemplateRevision(missing) error = %v, want ErrNotFound", err)
+	}
+
+	// Revisions are removed with the template
+	if err := s.DeleteTemplate(template.ID, 0, false); err != nil {
+		t.Fatalf("DeleteTemplate: %v", err)
+	}
+	if _, err := s.ListTemplateRevisions(template.ID); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("ListTemplateRevisions after delete error = %v, want ErrNotFound", err)
+	}
+	if _, err := s.GetTemplateRevision(template.ID, 1); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("GetTemplateRevision after delete error = %v, want ErrNotFound", err)
+	}
+}
+
+func testTemplateRevisionsOfPrefixIDs(t *testing.T, s storage.Storage) {
+	// One template ID followed by "/" and more text must not pick up the other's revisions
+	for _, id := range []string{"a", "a/b"} {
+		template, err := s.CreateTemplate(storage.Template{ID: id, Name: id, Type: "vm", RawTemplate: id + " v1"})
+		if err != nil {
+			t.Fatalf("CreateTemplate(%s): %v", id, err)
+		}
+		template.RawTemplate = id + " v2"
+		if _, err := s.UpdateTemplate(template); err != nil {
+			t.Fatalf("UpdateTemplate(%s): %v", id, err)
+		}
+	}
+
+	revisions, err := s.ListTemplateRevisions("a")
+	if err != nil {
+		t.Fatalf("ListTemplateRevisions: %v", err)
+	}
+	if len(revisions) != 2 {
+		t.Fatalf("ListTemplateRevisions(a) returned %d revisions, want 2", len(revisions))
+	}
+	for _, r := range revisions {
+		if r.TemplateID != "a" {
+			t.Errorf("ListTemplateRevisions(a) returned a revision of %q", r.TemplateID)
+		}
+	}
+
+	if err := s.DeleteTemplate("a", 0, false); err != nil {
+		t.Fatalf("DeleteTemplate: %v", err)
+	}
+	revisions, err = s.ListTemplateRevisions("a/b")
+	if err != nil {
+		t.Fatalf("ListTemplateRevisions(a/b) after deleting a: %v", err)
+	}
+	if len(revisions) != 2 {
+		t.Errorf("ListTemplateRevisions(a/b) after deleting a returned %d revisions, want 2", len(revisions))
+	}
+	if _, err := s.GetTemplateRevision("a/b", 1); err != nil {
+		t.Errorf("GetTemplateRevision(a/b, 1) after deleting a: %v", err)
+	}
+}
+
+func testTemplateDependents(t *testing.T, s storage.Storage) {
+	template, err := s.CreateTemplate(storage.Template{ID: "t1", Name: "VM", Type: "vm", RawTemplate: "{{ .Name }}"})
+	if err != nil {
+		t.Fatalf("CreateTemplate: %v", err)
+	}
+	for _, id := range []string{"vm2", "vm1"} {
+		if _, err := s.CreateVirtualMachine(storage.VirtualMachine{ID: id, TemplateID: template.ID}); err != nil {
+			t.Fatalf("CreateVirtualMachine(%s): %v", id, err)
+		}
+	}
+	if _, err := s.CreateVirtualMachine(storage.VirtualMachine{ID: "other", TemplateID: "t2"}); err != nil {
+		t.Fatalf("CreateVirtualMachine(other): %v", err)
+	}
+
+	err = s.DeleteTemplate(template.ID, 0, false)
+	var dependents *storage.DependentsError
+	if !errors.As(err, &dependents) || !errors.Is(err, storage.ErrInUse) {
+		t.Fatalf("DeleteTemplate(in use) error = %v, want DependentsError", err)
+	}
+	if got := fmt.Sprint(dependents.VirtualMachines); got != "[vm1 vm2]" || len(dependents.KubernetesClusters) != 0 {
+		t.Errorf("dependents = %+v, want virtual machines vm1 and vm2", dependents)
+	}
+
+	// The type cannot change while resources depend on the template
+	template.Type = "kubernetes"
+	if _, err := s.UpdateTemplate(template); !errors.Is(err, storage.ErrInUse) {
+		t.Errorf("UpdateTemplate(type change) error = %v, want ErrInUse", err)
+	}
+	template.Type = "vm"
+	template.RawTemplate = "{{ .OS }}"
+	if _, err := s.UpdateTemplate(template); err != nil {
+		t.Errorf("UpdateTemplate(same type): %v", err)
+	}
+
+	// Cascade deletes the dependents but nothing else
+	if err := s.DeleteTemplate(template.ID, 0, true); err != nil {
+		t.Fatalf("DeleteTemplate(cascade): %v", err)
+	}
+	for _, id := range []string{"vm1", "vm2"} {
+		if _, err := s.GetVirtualMachine(id); !errors.Is(err, storage.ErrNotFound) {
+			t.Errorf("GetVirtualMachine(%s) after cascade error = %v, want ErrNotFound", id, err)
+		}
+	}
+	if _, err := s.GetVirtualMachine("other"); err != nil {
+		t.Errorf("GetVirtualMachine(other) after cascade: %v", err)
+	}
+}
+
+func testListOptions(t *testing.T, s storage.Storage) {
+	for i, region := range []string{"eu-1", "us-1", "eu-1", "eu-1", "us-1"} {
+		cluster := storage.KubernetesCluster{
+			ID:        fmt.Sprintf("c%d", i),
+			Name:      fmt.Sprintf("cluster-%d", i),
+			Region:    region,
+			NodeCount: int32(i + 1),
+		}
+		if _, err := s.CreateKubernetesCluster(cluster); err != nil {
+			t.Fatalf("CreateKubernetesCluster: %v", err)
+		}
+	}
+
+	opts := storage.ListOptions{
+		Filter:   `region = "eu-1" AND node_count > 1`,
+		OrderBy:  "node_count desc",
+		PageSize: 1,
+	}
+	var ids []string
+	for {
+		page, next, err := s.ListKubernetesClusters(opts)
+		if err != nil {
+			t.Fatalf("ListKubernetesClusters(%+v): %v", opts, err)
+		}
+		for _, cluster := range page {
+			ids = append(ids, cluster.ID)
+		}
+		if next == "" {
+			break
+		}
+		opts.PageToken = next
+	}
+	if fmt.Sprint(ids) != "[c3 c2]" {
+		t.Errorf("paged filtered clusters = %v, want [c3 c2]", ids)
+	}
+
+	all, next, err := s.ListKubernetesClusters(storage.ListOptions{OrderBy: "region, name desc"})
+	if err != nil {
+		t.Fatalf("ListKubernetesClusters: %v", err)
+	}
+	if next != "" {
+		t.Errorf("next page token = %q for an unpaged list, want empty", next)
+	}
+	ids = nil
+	for _, cluster := range all {
+		ids = append(ids, cluster.ID)
+	}
+	if fmt.Sprint(ids) != "[c3 c2 c0 c4 c1]" {
+		t.Errorf("ordered clusters = %v, want [c3 c2 c0 c4 c1]", ids)
+	}
+
+	for _, opts := range []storage.ListOptions{
+		{Filter: "unknown = 1"},
+		{Filter: `node_count > "three"`},
+		{Filter: `region = "eu-1" OR region = "us-1"`},
+		{OrderBy: "unknown"},
+		{PageToken: "garbage"},
+	} {
+		if _, _, err := s.ListKubernetesClusters(opts); !errors.Is(err, storage.ErrInvalidQuery) {
+			t.Errorf("ListKubernetesClusters(%+v) error = %v, want ErrInvalidQuery", opts, err)
+		}
+	}
+
+	// Deleting a listed cluster between pages neither skips nor repeats clusters
+	opts = storage.ListOptions{OrderBy: "name", PageSize: 2}
+	page, next, err := s.ListKubernetesClusters(opts)
+	if err != nil || len(page) != 2 || next == "" {
+		t.Fatalf("ListKubernetesClusters(%+v) = %d clusters, %q, %v, want 2 and a next page", opts, len(page), next, err)
+	}
+	if err := s.DeleteKubernetesCluster(page[0].ID, 0); err != nil {
+		t.Fatalf("DeleteKubernetesCluster: %v", err)
+	}
+	opts.PageToken = next
+	page, _, err = s.ListKubernetesClusters(opts)
+	if err != nil {
+		t.Fatalf("ListKubernetesClusters(%+v): %v", opts, err)
+	}
+	ids = nil
+	for _, cluster := range page {
+		ids = append(ids, cluster.ID)
+	}
+	if fmt.Sprint(ids) != "[c2 c3]" {
+		t.Errorf("page after a deletion = %v, want [c2 c3]", ids)
+	}
+}
+
+// testAliasing checks that entities share no maps, slices or pointers with the
+// values passed to and returned by the storage
+func testAliasing(t *testing.T, s storage.Storage) {
+	newTemplate := func() storage.Template {
+		minDisk := 10.0
+		return storage.Template{
+			ID:          "t1",
+			Name:        "web",
+			Type:        "vm",
+			RawTemplate: "{{ .Name }}",
+			Files:       []storage.TemplateFile{{Name: "user-data", RawTemplate: "#cloud-config"}},
+			Parameters:  []storage.Parameter{{Name: "disk", Type: "integer", AllowedValues: []string{"10", "20"}, Min: &minDisk}},
+		}
+	}
+	changeTemplate := func(template storage.Template) {
+		template.Files[0].RawTemplate = "changed"
+		template.Parameters[0].AllowedValues[0] = "changed"
+		*template.Parameters[0].Min = 0
+	}
+	checkTemplate := func(step string) {
+		t.Helper()
+		got, err := s.GetTemplate("t1")
+		if err != nil {
+			t.Fatalf("GetTemplate: %v", err)
+		}
+		want := newTemplate()
+		if !reflect.DeepEqual(got.Files, want.Files) || !reflect.DeepEqual(got.Parameters, want.Parameters) {
+			t.Errorf("%s changed the stored template to %+v", step, got)
+		}
+		revision, err := s.GetTemplateRevision("t1", got.Revision)
+		if err != nil {
+			t.Fatalf("GetTemplateRevision: %v", err)
+		}
+		if !reflect.DeepEqual(revision.Files, want.Files) || !reflect.DeepEqual(revision.Parameters, want.Parameters) {
+			t.Errorf("%s changed the stored template revision to %+v", step, revision)
+		}
+	}
+
+	template := newTemplate()
+	created, err := s.CreateTemplate(template)
+	if err != nil {
+		t.Fatalf("CreateTemplate: %v", err)
+	}
+	changeTemplate(template)
+	checkTemplate("changing the created template")
+	changeTemplate(created)
+	checkTemplate("changing the returned template")
+	got, _ := s.GetTemplate("t1")
+	changeTemplate(got)
+	checkTemplate("changing a got template")
+	templates, _, _ := s.ListTemplates("", storage.ListOptions{})
+	changeTemplate(templates[0])
+	checkTemplate("changing a listed template")
+	revision, _ := s.GetTemplateRevision("t1", 1)
+	changeTemplate(revision.Template())
+	revisions, _ := s.ListTemplateRevisions("t1")
+	changeTemplate(revisions[0].Template())
+	checkTemplate("changing a template revision")
+	update := newTemplate()
+	if _, err := s.UpdateTemplate(update); err != nil {
+		t.Fatalf("UpdateTemplate: %v", err)
+	}
+	changeTemplate(update)
+	checkTemplate("changing the updated template")
+
+	newVM := func() storage.VirtualMachine {
+		return storage.VirtualMachine{
+			ID:                "vm1",
+			Name:              "web",
+			TemplateID:        "t1",
+			Parameters:        map[string]string{"disk": "10"},
+			RenderedArtifacts: storage.Artifacts{storage.MainArtifact: "web"},
+			Lifecycle:         storage.Lifecycle{Status: storage.StatusRunning, Conditions: []storage.Condition{{Type: "Ready", Status: true}}},
+			Events:            []storage.Event{{Action: storage.EventCreate, Succeeded: true}},
+		}
+	}
+	changeVM := func(vm storage.VirtualMachine) {
+		vm.Parameters["disk"] = "changed"
+		vm.RenderedArtifacts[storage.MainArtifact] = "changed"
+		vm.Conditions[0].Reason = "Changed"
+		vm.Events[0].Message = "changed"
+	}
+	checkVM := func(step string, got storage.VirtualMachine) {
+		t.Helper()
+		want := newVM()
+		if !reflect.DeepEqual(got.Parameters, want.Parameters) || !reflect.DeepEqual(got.RenderedArtifacts, want.RenderedArtifacts) ||
+			!reflect.DeepEqual(got.Conditions, want.Conditions) || !reflect.DeepEqual(got.Events, want.Events) {
+			t.Errorf("%s changed the stored virtual machine to %+v", step, got)
+		}
+	}
+	getVM := func() storage.VirtualMachine {
+		t.Helper()
+		vm, err := s.GetVirtualMachine("vm1")
+		if err != nil {
+			t.Fatalf("GetVirtualMachine: %v", err)
+		}
+		return vm
+	}
+
+	vm := newVM()
+	createdVM, err := s.CreateVirtualMachine(vm)
+	if err != nil {
+		t.Fatalf("CreateVirtualMachine: %v", err)
+	}
+	changeVM(vm)
+	checkVM("changing the created virtual machine", getVM())
+	changeVM(createdVM)
+	checkVM("changing the returned virtual machine", getVM())
+	changeVM(getVM())
+	checkVM("changing a got virtual machine", getVM())
+	vms, _, _ := s.ListVirtualMachines(storage.ListOptions{})
+	changeVM(vms[0])
+	checkVM("changing a listed virtual machine", getVM())
+	updateVM := newVM()
+	if _, err := s.UpdateVirtualMachine(updateVM); err != nil {
+		t.Fatalf("UpdateVirtualMachine: %v", err)
+	}
+	changeVM(updateVM)
+	checkVM("changing the updated virtual machine", getVM())
+
+	snapshot := storage.VirtualMachineSnapshot{ID: "s1", VirtualMachineID: "vm1", Parameters: newVM().Parameters, RenderedArtifacts: newVM().RenderedArtifacts}
+	if _, err := s.CreateVirtualMachineSnapshot(snapshot); err != nil {
+		t.Fatalf("CreateVirtualMachineSnapshot: %v", err)
+	}
+	snapshot.Parameters["disk"] = "changed"
+	snapshot.RenderedArtifacts[storage.MainArtifact] = "changed"
+	gotSnapshot, err := s.GetVirtualMachineSnapshot("s1")
+	if err != nil {
+		t.Fatalf("GetVirtualMachineSnapshot: %v", err)
+	}
+	gotSnapshot.Parameters["disk"] = "changed"
+	if gotSnapshot, _ = s.GetVirtualMachineSnapshot("s1"); !reflect.DeepEqual(gotSnapshot.Parameters, newVM().Parameters) ||
+		!reflect.DeepEqual(gotSnapshot.RenderedArtifacts, newVM().RenderedArtifacts) {
+		t.Errorf("changing snapshots changed the stored snapshot to %+v", gotSnapshot)
+	}
+
+	cluster := storage.KubernetesCluster{
+		ID:                "c1",
+		TemplateID:        "k1",
+		Parameters:        map[string]string{"pool": "a"},
+		RenderedArtifacts: storage.Artifacts{storage.MainArtifact: "c1"},
+		Lifecycle:         storage.Lifecycle{Conditions: []storage.Condition{{Type: "Ready"}}},
+	}
+	if _, err := s.CreateKubernetesCluster(cluster); err != nil {
+		t.Fatalf("CreateKubernetesCluster: %v", err)
+	}
+	cluster.Parameters["pool"] = "changed"
+	cluster.RenderedArtifacts[storage.MainArtifact] = "changed"
+	cluster.Conditions[0].Reason = "Changed"
+	gotCluster, err := s.GetKubernetesCluster("c1")
+	if err != nil {
+		t.Fatalf("GetKubernetesCluster: %v", err)
+	}
+	gotCluster.Parameters["pool"] = "changed"
+	if gotCluster, _ = s.GetKubernetesCluster("c1"); gotCluster.Parameters["pool"] != "a" ||
+		gotCluster.RenderedArtifacts[storage.MainArtifact] != "c1" || gotCluster.Conditions[0].Reason != "" {
+		t.Errorf("changing clusters changed the stored cluster to %+v", gotCluster)
+	}
+
+	result := newVM()
+	op := storage.Operation{ID: "op1", Type: storage.OperationCreateVirtualMachine, State: storage.OperationSucceeded, VirtualMachine: &result}
+	if _, err := s.CreateOperation(op); err != nil {
+		t.Fatalf("CreateOperation: %v", err)
+	}
+	changeVM(result)
+	gotOp, err := s.GetOperation("op1")
+	if err != nil {
+		t.Fatalf("GetOperation: %v", err)
+	}
+	changeVM(*gotOp.VirtualMachine)
+	if gotOp, _ = s.GetOperation("op1"); gotOp.VirtualMachine == nil {
+		t.Fatalf("stored operation lost its virtual machine")
+	}
+	checkVM("changing the result of an operation", *gotOp.VirtualMachine)
+}
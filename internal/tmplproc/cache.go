@@ -0,0 +1,150 @@
+package tmplproc
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"fmt"
+	"slices"
+	"sync"
+	"text/template"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
+)
+
+// templateCache keeps parsed templates so that rendering many resources from the
+// same template parses it only once. Entries are keyed by template ID and revision
+// and hold a hash of the template files, so a changed file is never served from
+// the cache even if an invalidation was missed. The partials an entry was parsed
+// with are not loaded again on a hit: invalidating a partial drops the entries
+// that call it instead. The least recently used entries are evicted once the
+// cache holds maxEntries of them.
+type templateCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[cacheKey]*list.Element // of *cacheEntry, most recently used first
+	lru        *list.List
+	generation uint64 // incremented by every invalidation
+}
+
+// cacheKey identifies one revision of a template
+type cacheKey struct {
+	id       string
+	revision int64
+}
+
+// cacheEntry holds the parsed files of a template, the hash of their sources
+// and the IDs of the partials they were parsed with
+type cacheEntry struct {
+	key      cacheKey
+	hash     [sha256.Size]byte
+	partials []string
+	files    map[string]parsedFile
+}
+
+// parsedFile is a parsed template file with its compiled output schema, if any
+type parsedFile struct {
+	template *template.Template
+	schema   *jsonschema.Schema
+}
+
+// newTemplateCache creates an empty template cache holding at most maxEntries templates
+func newTemplateCache(maxEntries int) *templateCache {
+	return &templateCache{
+		maxEntries: maxEntries,
+		entries:    make(map[cacheKey]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// get returns the parsed files of a template by artifact name. On a miss it
+// loads the partials the template calls with dependencies, parses the files
+// and caches them.
+func (c *templateCache) get(tmpl storage.Template, dependencies func(storage.Template) ([]storage.Template, error)) (map[string]parsedFile, error) {
+	key := cacheKey{id: tmpl.ID, revision: tmpl.Revision}
+	hash := sourceHash(tmpl)
+
+	c.mu.Lock()
+	if element, ok := c.entries[key]; ok && element.Value.(*cacheEntry).hash == hash {
+		c.lru.MoveToFront(element)
+		c.mu.Unlock()
+		return element.Value.(*cacheEntry).files, nil
+	}
+	generation := c.generation
+	c.mu.Unlock()
+
+	partials, err := dependencies(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]parsedFile)
+	for _, file := range templateFiles(tmpl) {
+		parsed, err := parseTemplateSet(file.RawTemplate, partials)
+		if err != nil {
+			return nil, fmt.Errorf("file %q: %w", file.Name, err)
+		}
+		schema, err := compileSchema(file.OutputSchema)
+		if err != nil {
+			return nil, fmt.Errorf("file %q: %w", file.Name, err)
+		}
+		files[file.Name] = parsedFile{template: parsed, schema: schema}
+	}
+
+	entry := &cacheEntry{key: key, hash: hash, files: files}
+	for _, partial := range partials {
+		entry.partials = append(entry.partials, partial.ID)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// A partial invalidated while the files were parsed may have been loaded
+	// before its change, so the result is used but not cached
+	if c.generation != generation {
+		return files, nil
+	}
+	if element, ok := c.entries[key]; ok {
+		c.lru.Remove(element)
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.maxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+	return files, nil
+}
+
+// invalidate drops all parsed revisions of a template and of the templates
+// parsed with it as a partial
+func (c *templateCache) invalidate(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	for key, element := range c.entries {
+		entry := element.Value.(*cacheEntry)
+		if key.id == id || slices.Contains(entry.partials, id) {
+			c.lru.Remove(element)
+			delete(c.entries, key)
+		}
+	}
+}
+
+// sourceHash hashes the files of a template with their output schemas
+func sourceHash(tmpl storage.Template) [sha256.Size]byte {
+	h := sha256.New()
+	write := func(s string) {
+		// Length-prefix each string so that different splits hash differently
+		h.Write([]byte{byte(len(s) >> 24), byte(len(s) >> 16), byte(len(s) >> 8), byte(len(s))})
+		h.Write([]byte(s))
+	}
+	for _, file := range templateFiles(tmpl) {
+		write(file.Name)
+		write(file.RawTemplate)
+		write(file.OutputSchema)
+	}
+
+	var sum [sha256.Size]byte
+	h.Sum(sum[:0])
+	return sum
+}
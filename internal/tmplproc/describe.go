@@ -0,0 +1,428 @@
+package tmplproc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
+	"github.com/aa1ex/paas-provider/internal/validation"
+)
+
+// Description lists what a template references
+type Description struct {
+	Fields    []string // field paths from the template data, e.g. ".Name" or ".Nodes[].Name"
+	Variables []string // variables declared by the template, e.g. "$node"
+	Functions []string // functions called by the template, other than the text/template builtins
+}
+
+// resourceFields lists the fields supplied to templates of each type, see the data maps in processor.go
+var resourceFields = map[string][]string{
+	"vm":         {"Name", "CPU", "Memory", "OS", "Parameters"},
+	"kubernetes": {"Name", "Region", "NodeCount", "Version", "Parameters"},
+}
+
+// resourceNames names the resources rendered from templates of each type
+var resourceNames = map[string]string{
+	"vm":         "virtual machines",
+	"kubernetes": "Kubernetes clusters",
+}
+
+// builtinFunctions are the functions predefined by text/template
+var builtinFunctions = map[string]bool{
+	"and": true, "call": true, "html": true, "index": true, "slice": true, "js": true,
+	"len": true, "not": true, "or": true, "print": true, "printf": true, "println": true,
+	"urlquery": true, "eq": true, "ge": true, "gt": true, "le": true, "lt": true, "ne": true,
+}
+
+// unknownPath marks a value whose position in the template data cannot be determined,
+// e.g. the result of a function call
+const unknownPath = "?"
+
+// Describe parses template files and reports the fields, variables and functions they reference.
+// Paths are followed through range, with, variables and template and include calls, so
+// {{ range .Nodes }}{{ .Name }}{{ end }} references ".Nodes[].Name".
+func Describe(rawTemplates ...string) (Description, error) {
+	return describe(rawTemplates, nil)
+}
+
+// describe is Describe that also follows calls into partials
+func describe(rawTemplates []string, partials []storage.Template) (Description, error) {
+	w := &treeWalker{
+		fields:    make(map[string]bool),
+		variables: make(map[string]bool),
+		functions: make(map[string]bool),
+	}
+
+	for _, rawTemplate := range rawTemplates {
+		tree := parse.New("template")
+		tree.Mode = parse.SkipFuncCheck
+		trees := make(map[string]*parse.Tree)
+		if _, err := tree.Parse(rawTemplate, "", "", trees); err != nil {
+			return Description{}, fmt.Errorf("failed to parse template: %w", err)
+		}
+		for _, partial := range partials {
+			tree := parse.New(partial.Name)
+			tree.Mode = parse.SkipFuncCheck
+			if _, err := tree.Parse(partial.RawTemplate, "", "", trees); err != nil {
+				return Description{}, fmt.Errorf("failed to parse partial %q: %w", partial.Name, err)
+			}
+		}
+
+		w.trees = trees
+		w.visited = make(map[string]bool)
+		if root, ok := trees["template"]; ok {
+			w.walk(root.Root, &scope{dot: "", vars: map[string]string{"$": ""}})
+		}
+	}
+
+	return Description{
+		Fields:    sortedKeys(w.fields),
+		Variables: sortedKeys(w.variables),
+		Functions: sortedKeys(w.functions),
+	}, nil
+}
+
+// DescribeTemplate describes all files of a template, following calls into
+// the partials it calls, see Dependencies
+func DescribeTemplate(template storage.Template, partials []storage.Template) (Description, error) {
+	files := templateFiles(template)
+	rawTemplates := make([]string, len(files))
+	for i, file := range files {
+		rawTemplates[i] = file.RawTemplate
+	}
+	return describe(rawTemplates, partials)
+}
+
+// ValidateTemplate checks that the files of a template parse and only reference
+// fields that resources of its type supply
+func ValidateTemplate(template storage.Template) validation.Errors {
+	var errors validation.Errors
+
+	parameters := make(map[string]bool, len(template.Parameters))
+	for _, parameter := range template.Parameters {
+		parameters[parameter.Name] = true
+	}
+
+	if template.Type == "partial" && len(template.Files) > 0 {
+		errors.Add("files", "partials cannot have files")
+	}
+	if template.Type == "partial" && template.OutputFormat != "" {
+		errors.Add("output_format", "partials cannot have an output format")
+	}
+	if template.Type == "partial" && template.OutputSchema != "" {
+		errors.Add("output_schema", "partials cannot have an output schema")
+	}
+	if !knownOutputFormat(template.OutputFormat) {
+		errors.Add("output_format", fmt.Sprintf("unknown output format %q", template.OutputFormat))
+	}
+	for i, file := range template.Files {
+		if !knownOutputFormat(file.OutputFormat) {
+			errors.Add(fmt.Sprintf("files[%d].output_format", i), fmt.Sprintf("unknown output format %q", file.OutputFormat))
+		}
+		format := file.OutputFormat
+		if format == "" {
+			format = template.OutputFormat
+		}
+		validateOutputSchema(fmt.Sprintf("files[%d].output_schema", i), file.OutputSchema, format, &errors)
+	}
+	if template.RawTemplate == "" && len(template.Files) > 0 && template.OutputSchema != "" {
+		errors.Add("output_schema", "applies to the template body, which is empty")
+	} else if template.Type != "partial" {
+		validateOutputSchema("output_schema", template.OutputSchema, template.OutputFormat, &errors)
+	}
+	if template.RawTemplate == "" && len(template.Files) == 0 {
+		errors.Add("raw_template", "is required when the template has no files")
+		return errors
+	}
+
+	if template.RawTemplate != "" || len(template.Files) == 0 {
+		validateFile("raw_template", template.RawTemplate, template.Type, parameters, &errors)
+	}
+	for i, file := range template.Files {
+		validateFile(fmt.Sprintf("files[%d].raw_template", i), file.RawTemplate, template.Type, parameters, &errors)
+	}
+
+	return errors
+}
+
+// validateOutputSchema checks that an output schema compiles and that the
+// output it applies to has a format that can be validated against it
+func validateOutputSchema(field, rawSchema, format string, errors *validation.Errors) {
+	if rawSchema == "" {
+		return
+	}
+	if format != FormatYAML && format != FormatJSON && format != FormatCloudConfig {
+		errors.Add(field, "requires a YAML, JSON or cloud-config output format")
+		return
+	}
+	if _, err := compileSchema(rawSchema); err != nil {
+		errors.Add(field, err.Error())
+	}
+}
+
+// validateFile checks that one template file parses and only references
+// fields that resources of the template type supply
+func validateFile(field, rawTemplate, templateType string, parameters map[string]bool, errors *validation.Errors) {
+	parsed, err := parseTemplate(rawTemplate)
+	if err != nil {
+		errors.Add(field, err.Error())
+		return
+	}
+	for _, t := range parsed.Templates() {
+		if t.Tree != nil && dynamicInclude(t.Tree.Root) {
+			errors.Add(field, "calls include with a name that is not a string constant")
+			break
+		}
+	}
+	description, err := Describe(rawTemplate)
+	if err != nil {
+		errors.Add(field, err.Error())
+		return
+	}
+	checkFields(field, description.Fields, templateType, parameters, errors)
+}
+
+// checkFields checks that field paths referenced by a template file are
+// supplied by resources of the template type
+func checkFields(field string, paths []string, templateType string, parameters map[string]bool, errors *validation.Errors) {
+	available, ok := resourceFields[templateType]
+	if !ok {
+		return
+	}
+
+	for _, path := range paths {
+		if !strings.HasPrefix(path, ".") {
+			continue
+		}
+		segments := strings.Split(strings.TrimPrefix(path, "."), ".")
+		name := strings.TrimSuffix(segments[0], "[]")
+		switch {
+		case !contains(available, name):
+			errors.Add(field, fmt.Sprintf("references %s, which %s do not provide", path, resourceNames[templateType]))
+		case name == "Parameters" && len(segments) > 1 && !parameters[strings.TrimSuffix(segments[1], "[]")]:
+			errors.Add(field, fmt.Sprintf("references %s, which is not a declared parameter", path))
+		case name != "Parameters" && len(segments) > 1:
+			errors.Add(field, fmt.Sprintf("references %s, but .%s has no fields", path, name))
+		}
+	}
+}
+
+// parseTemplate parses a template body with the function library
+func parseTemplate(rawTemplate string) (*template.Template, error) {
+	tmpl, err := template.New("template").Funcs(functions).Parse(rawTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// scope tracks the path of dot and of the variables in scope
+type scope struct {
+	dot  string
+	vars map[string]string
+}
+
+// child returns a nested scope; variables declared in it do not leak out
+func (s *scope) child(dot string) *scope {
+	vars := make(map[string]string, len(s.vars))
+	for name, path := range s.vars {
+		vars[name] = path
+	}
+	return &scope{dot: dot, vars: vars}
+}
+
+// treeWalker collects the references of a parse tree
+type treeWalker struct {
+	trees     map[string]*parse.Tree
+	fields    map[string]bool
+	variables map[string]bool
+	functions map[string]bool
+	visited   map[string]bool // template calls already walked, by name and dot
+}
+
+func (w *treeWalker) walk(node parse.Node, s *scope) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			w.walk(child, s)
+		}
+	case *parse.ActionNode:
+		w.pipe(n.Pipe, s)
+	case *parse.IfNode:
+		w.branch(&n.BranchNode, s)
+	case *parse.RangeNode:
+		w.branch(&n.BranchNode, s)
+	case *parse.WithNode:
+		w.branch(&n.BranchNode, s)
+	case *parse.TemplateNode:
+		dot := unknownPath
+		if n.Pipe != nil {
+			dot = w.pipe(n.Pipe, s)
+		}
+		w.call(n.Name, dot)
+	}
+}
+
+// call walks a template called by {{ template }} or include, with dot at path.
+// Templates that are neither defined by the walked files nor partials are skipped.
+func (w *treeWalker) call(name, dot string) {
+	key := name + "\x00" + dot
+	if tree, ok := w.trees[name]; ok && !w.visited[key] {
+		w.visited[key] = true
+		w.walk(tree.Root, &scope{dot: dot, vars: map[string]string{"$": dot}})
+	}
+}
+
+// branch walks an if, range or with block
+func (w *treeWalker) branch(n *parse.BranchNode, s *scope) {
+	inner := s.child(s.dot)
+	path := w.pipe(n.Pipe, inner)
+
+	switch n.NodeType {
+	case parse.NodeRange:
+		elem := join(path, "[]")
+		switch len(n.Pipe.Decl) {
+		case 1:
+			inner.vars[n.Pipe.Decl[0].Ident[0]] = elem
+		case 2:
+			inner.vars[n.Pipe.Decl[0].Ident[0]] = unknownPath
+			inner.vars[n.Pipe.Decl[1].Ident[0]] = elem
+		}
+		inner.dot = elem
+	case parse.NodeWith:
+		inner.dot = path
+	}
+	w.walk(n.List, inner)
+
+	// Variables declared by the pipeline are visible in the else branch too
+	elseScope := inner.child(s.dot)
+	w.walk(n.ElseList, elseScope)
+}
+
+// pipe records the references of a pipeline and returns the path of its result
+func (w *treeWalker) pipe(n *parse.PipeNode, s *scope) string {
+	if n == nil {
+		return unknownPath
+	}
+
+	path := unknownPath
+	for i, cmd := range n.Cmds {
+		// The result of the previous stage is passed as the last argument
+		piped := unknownPath
+		if i > 0 {
+			piped = path
+		}
+		result := w.command(cmd, s, piped)
+		if i == 0 {
+			path = result
+		} else {
+			// The result of a pipeline stage is passed to a function
+			path = unknownPath
+		}
+	}
+
+	for _, decl := range n.Decl {
+		name := decl.Ident[0]
+		w.variables[name] = true
+		s.vars[name] = path
+	}
+	return path
+}
+
+// command records the references of a command and returns the path of its result.
+// piped is the path of the value piped into the command, if any.
+func (w *treeWalker) command(n *parse.CommandNode, s *scope, piped string) string {
+	path := unknownPath
+	results := make([]string, len(n.Args))
+	for i, arg := range n.Args {
+		results[i] = w.arg(arg, s)
+		if i == 0 && len(n.Args) == 1 {
+			path = results[i]
+		}
+	}
+
+	// Follow include calls with a constant name, with dot set to their data
+	if name, ok := includeName(n); ok {
+		dot := piped
+		if len(n.Args) > 2 {
+			dot = results[2]
+		}
+		w.call(name, dot)
+	}
+	return path
+}
+
+// arg records the references of a command argument and returns its path
+func (w *treeWalker) arg(node parse.Node, s *scope) string {
+	switch n := node.(type) {
+	case *parse.DotNode:
+		return s.dot
+	case *parse.FieldNode:
+		return w.field(join(s.dot, n.Ident...))
+	case *parse.VariableNode:
+		base, ok := s.vars[n.Ident[0]]
+		if !ok {
+			base = unknownPath
+		}
+		if len(n.Ident) == 1 {
+			return base
+		}
+		return w.field(join(base, n.Ident[1:]...))
+	case *parse.ChainNode:
+		return w.field(join(w.arg(n.Node, s), n.Field...))
+	case *parse.PipeNode:
+		return w.pipe(n, s.child(s.dot))
+	case *parse.IdentifierNode:
+		if !builtinFunctions[n.Ident] {
+			w.functions[n.Ident] = true
+		}
+	}
+	return unknownPath
+}
+
+// field records a field path if its position in the template data is known
+func (w *treeWalker) field(path string) string {
+	if path != unknownPath {
+		w.fields[path] = true
+	}
+	return path
+}
+
+// join appends field names to a path
+func join(path string, idents ...string) string {
+	if path == unknownPath {
+		return unknownPath
+	}
+	for _, ident := range idents {
+		if ident == "[]" {
+			path += ident
+		} else {
+			path += "." + ident
+		}
+	}
+	return path
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+package tmplproc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
+	"github.com/aa1ex/paas-provider/internal/tmplproc"
+)
+
+func TestValidateTemplateIncludeNames(t *testing.T) {
+	tests := []struct {
+		name        string
+		rawTemplate string
+		wantErr     string
+	}{
+		{name: "constant name", rawTemplate: `{{include "labels" .}}`},
+		{name: "constant name in a pipeline", rawTemplate: `{{. | include "labels"}}`},
+		{name: "computed name", rawTemplate: `{{include (print "lab" "els") .}}`, wantErr: "not a string constant"},
+		{name: "name from a variable", rawTemplate: `{{$n := "labels"}}{{include $n .}}`, wantErr: "not a string constant"},
+		{name: "name piped in", rawTemplate: `{{"labels" | include}}`, wantErr: "not a string constant"},
+		{name: "computed name in a defined template", rawTemplate: `{{define "x"}}{{include (print "x") .}}{{end}}{{template "x" .}}`, wantErr: "not a string constant"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tmplproc.ValidateTemplate(storage.Template{Type: "vm", RawTemplate: tt.rawTemplate})
+			switch {
+			case tt.wantErr == "" && errs.HasErrors():
+				t.Fatalf("unexpected errors: %v", errs)
+			case tt.wantErr != "" && !strings.Contains(errs.Error(), tt.wantErr):
+				t.Fatalf("got errors %v, want %q", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDescribeFollowsCalls(t *testing.T) {
+	tests := []struct {
+		name        string
+		rawTemplate string
+		want        string
+	}{
+		{name: "template call", rawTemplate: `{{define "x"}}{{.Name}}{{end}}{{template "x" .Nodes}}`, want: "[.Nodes .Nodes.Name]"},
+		{name: "include", rawTemplate: `{{define "x"}}{{.Name}}{{end}}{{include "x" .Nodes}}`, want: "[.Nodes .Nodes.Name]"},
+		{name: "include in a pipeline", rawTemplate: `{{define "x"}}{{.Name}}{{end}}{{.Nodes | include "x"}}`, want: "[.Nodes .Nodes.Name]"},
+		{name: "include in range", rawTemplate: `{{define "x"}}{{.Name}}{{end}}{{range .Nodes}}{{include "x" .}}{{end}}`, want: "[.Nodes .Nodes[].Name]"},
+		{name: "include with a computed value", rawTemplate: `{{define "x"}}{{.Name}}{{end}}{{include "x" (list 1)}}`, want: "[]"},
+		{name: "include of an undefined template", rawTemplate: `{{include "x" .}}`, want: "[]"},
+		{name: "defined template that is not called", rawTemplate: `{{define "x"}}{{.Name}}{{end}}`, want: "[]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			description, err := tmplproc.Describe(tt.rawTemplate)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := fmt.Sprint(description.Fields); got != tt.want {
+				t.Errorf("got fields %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDescribeTemplatePartials(t *testing.T) {
+	partials := []storage.Template{
+		{Name: "labels", Type: "partial", RawTemplate: `app={{.Name}} disk={{.Parameters.disk}}{{include "owner" .Parameters}}`},
+		{Name: "owner", Type: "partial", RawTemplate: `{{.owner}}`},
+	}
+	description, err := tmplproc.DescribeTemplate(storage.Template{Type: "vm", RawTemplate: `{{include "labels" .}}`}, partials)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := fmt.Sprint(description.Fields), "[.Name .Parameters .Parameters.disk .Parameters.owner]"; got != want {
+		t.Errorf("got fields %s, want %s", got, want)
+	}
+}
+
+func TestValidatePartialsFields(t *testing.T) {
+	disk := []storage.Parameter{{Name: "disk", Type: "integer"}}
+	tests := []struct {
+		name     string
+		template storage.Template
+		wantErr  string
+	}{
+		{
+			name:     "declared parameter",
+			template: storage.Template{ID: "vm", Name: "vm", Type: "vm", RawTemplate: `{{include "labels" .}}`, Parameters: disk},
+		},
+		{
+			name:     "undeclared parameter",
+			template: storage.Template{ID: "vm", Name: "vm", Type: "vm", RawTemplate: `{{include "labels" .}}`},
+			wantErr:  "raw_template: references .Parameters.disk, which is not a declared parameter",
+		},
+		{
+			name: "undeclared parameter in a file",
+			template: storage.Template{ID: "vm", Name: "vm", Type: "vm", Files: []storage.TemplateFile{
+				{Name: "a", RawTemplate: "{{ .Name }}"},
+				{Name: "b", RawTemplate: `{{include "labels" .}}`},
+			}},
+			wantErr: "files[1].raw_template: references .Parameters.disk",
+		},
+		{
+			name:     "field the resources do not supply",
+			template: storage.Template{ID: "k8s", Name: "k8s", Type: "kubernetes", RawTemplate: `{{include "cpu" .}}`},
+			wantErr:  "references .CPU, which Kubernetes clusters do not provide",
+		},
+		{
+			name:     "partial breaking a calling template",
+			template: storage.Template{ID: "labels", Name: "labels", Type: "partial", RawTemplate: "{{ .Parameters.size }}"},
+			wantErr:  `template "caller" references .Parameters.size, which is not a declared parameter`,
+		},
+		{
+			name:     "partial the calling templates supply",
+			template: storage.Template{ID: "labels", Name: "labels", Type: "partial", RawTemplate: "{{ .Name }}-{{ .Parameters.disk }}"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := storage.NewMemoryStorage()
+			for _, template := range []storage.Template{
+				{ID: "labels", Name: "labels", Type: "partial", RawTemplate: "disk={{ .Parameters.disk }}"},
+				{ID: "cpu", Name: "cpu", Type: "partial", RawTemplate: "{{ .CPU }}"},
+				{ID: "caller", Name: "caller", Type: "vm", RawTemplate: `{{include "labels" .}}`, Parameters: disk},
+			} {
+				if _, err := s.CreateTemplate(template); err != nil {
+					t.Fatalf("CreateTemplate: %v", err)
+				}
+			}
+
+			err := tmplproc.NewTemplateProcessor(s, tmplproc.DefaultLimits).ValidatePartials(tt.template)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
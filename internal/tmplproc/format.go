@@ -0,0 +1,252 @@
+package tmplproc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats of rendered artifacts
+const (
+	FormatPlain       = "plain"
+	FormatYAML        = "yaml"
+	FormatJSON        = "json"
+	FormatTOML        = "toml"
+	FormatINI         = "ini"
+	FormatCloudConfig = "cloud-config"
+)
+
+// OutputFormats lists the supported output formats
+var OutputFormats = []string{FormatPlain, FormatYAML, FormatJSON, FormatTOML, FormatINI, FormatCloudConfig}
+
+// cloudConfigHeader is the first line cloud-init requires of cloud-config user data
+const cloudConfigHeader = "#cloud-config"
+
+// ErrInvalidOutput is returned when rendered output does not parse in the output format of its template
+var ErrInvalidOutput = errors.New("rendered output is invalid")
+
+// OutputError describes where rendered output fails to parse in its output format
+type OutputError struct {
+	Format  string
+	Line    int // 1-based, zero if unknown
+	Column  int // 1-based, zero if unknown
+	Message string
+}
+
+func (e *OutputError) Error() string {
+	switch {
+	case e.Line > 0 && e.Column > 0:
+		return fmt.Sprintf("invalid %s output at line %d, column %d: %s", e.Format, e.Line, e.Column, e.Message)
+	case e.Line > 0:
+		return fmt.Sprintf("invalid %s output at line %d: %s", e.Format, e.Line, e.Message)
+	}
+	return fmt.Sprintf("invalid %s output: %s", e.Format, e.Message)
+}
+
+// Unwrap makes errors.Is(err, ErrInvalidOutput) report true
+func (e *OutputError) Unwrap() error {
+	return ErrInvalidOutput
+}
+
+// knownOutputFormat reports whether format is a supported output format or empty
+func knownOutputFormat(format string) bool {
+	if format == "" {
+		return true
+	}
+	for _, known := range OutputFormats {
+		if format == known {
+			return true
+		}
+	}
+	return false
+}
+
+// checkOutput checks that rendered output parses in the given format and, if
+// normalize is set, returns it with its canonical formatting. Plain output and
+// an empty format are accepted as they are.
+func checkOutput(format, rendered string, normalize bool) (string, error) {
+	switch format {
+	case "", FormatPlain:
+		return rendered, nil
+	case FormatJSON:
+		return checkJSON(rendered, normalize)
+	case FormatYAML:
+		return checkYAML(rendered, normalize, 0)
+	case FormatCloudConfig:
+		return checkCloudConfig(rendered, normalize)
+	case FormatTOML:
+		return checkTOML(rendered, normalize)
+	case FormatINI:
+		return checkINI(rendered, normalize)
+	}
+	return "", fmt.Errorf("unknown output format %q", format)
+}
+
+// checkJSON checks a single JSON value and indents it by two spaces
+func checkJSON(rendered string, normalize bool) (string, error) {
+	var value any
+	if err := json.Unmarshal([]byte(rendered), &value); err != nil {
+		outputErr := &OutputError{Format: FormatJSON, Message: err.Error()}
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			// The offset is just past the character that failed
+			outputErr.Line, outputErr.Column = position(rendered, int(syntaxErr.Offset)-1)
+		}
+		return "", outputErr
+	}
+	if !normalize {
+		return rendered, nil
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, bytes.TrimSpace([]byte(rendered)), "", "  "); err != nil {
+		return "", fmt.Errorf("failed to format JSON: %w", err)
+	}
+	buf.WriteByte('\n')
+	return buf.String(), nil
+}
+
+// yamlErrorLine matches the line number yaml.v3 puts in its syntax errors
+var yamlErrorLine = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+// checkYAML checks a stream of YAML documents and re-encodes them indented by
+// two spaces, keeping comments. Reported lines are shifted by lineOffset.
+func checkYAML(rendered string, normalize bool, lineOffset int) (string, error) {
+	var docs []*yaml.Node
+	dec := yaml.NewDecoder(strings.NewReader(rendered))
+	for {
+		var doc yaml.Node
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			outputErr := &OutputError{Format: FormatYAML, Message: strings.TrimPrefix(err.Error(), "yaml: ")}
+			if m := yamlErrorLine.FindStringSubmatch(err.Error()); m != nil {
+				line, _ := strconv.Atoi(m[1])
+				outputErr.Line, outputErr.Message = line+lineOffset, m[2]
+			}
+			return "", outputErr
+		}
+		docs = append(docs, &doc)
+	}
+	if !normalize {
+		return rendered, nil
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	for _, doc := range docs {
+		if err := enc.Encode(doc); err != nil {
+			return "", fmt.Errorf("failed to format YAML: %w", err)
+		}
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("failed to format YAML: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// checkCloudConfig checks cloud-config user data: the "#cloud-config" line
+// followed by a YAML mapping
+func checkCloudConfig(rendered string, normalize bool) (string, error) {
+	header, body, _ := strings.Cut(rendered, "\n")
+	if strings.TrimRight(header, " \t\r") != cloudConfigHeader {
+		return "", &OutputError{Format: FormatCloudConfig, Line: 1, Column: 1, Message: fmt.Sprintf("must start with %q", cloudConfigHeader)}
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(body), &doc); err == nil && len(doc.Content) > 0 && doc.Content[0].Kind != yaml.MappingNode {
+		node := doc.Content[0]
+		return "", &OutputError{Format: FormatCloudConfig, Line: node.Line + 1, Column: node.Column, Message: "must be a mapping"}
+	}
+
+	body, err := checkYAML(body, normalize, 1)
+	if err != nil {
+		var outputErr *OutputError
+		if errors.As(err, &outputErr) {
+			outputErr.Format = FormatCloudConfig
+		}
+		return "", err
+	}
+	if !normalize {
+		return rendered, nil
+	}
+	return cloudConfigHeader + "\n" + body, nil
+}
+
+// checkTOML checks a TOML document. Normalizing re-encodes it with the keys of
+// every table sorted.
+func checkTOML(rendered string, normalize bool) (string, error) {
+	var value map[string]any
+	if err := toml.Unmarshal([]byte(rendered), &value); err != nil {
+		outputErr := &OutputError{Format: FormatTOML, Message: err.Error()}
+		var decodeErr *toml.DecodeError
+		if errors.As(err, &decodeErr) {
+			outputErr.Line, outputErr.Column = decodeErr.Position()
+		}
+		return "", outputErr
+	}
+	if !normalize {
+		return rendered, nil
+	}
+
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).SetIndentTables(true).Encode(value); err != nil {
+		return "", fmt.Errorf("failed to format TOML: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// checkINI checks an INI file of [section] headers, key = value pairs and
+// comments starting with ";" or "#". Normalizing trims the lines and puts
+// single spaces around "=".
+func checkINI(rendered string, normalize bool) (string, error) {
+	lines := strings.Split(rendered, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		column := strings.Index(line, trimmed) + 1
+
+		switch {
+		case trimmed == "" || strings.HasPrefix(trimmed, ";") || strings.HasPrefix(trimmed, "#"):
+			lines[i] = trimmed
+		case strings.HasPrefix(trimmed, "["):
+			name, ok := strings.CutSuffix(trimmed, "]")
+			if !ok || strings.TrimSpace(name[1:]) == "" {
+				return "", &OutputError{Format: FormatINI, Line: i + 1, Column: column, Message: "malformed section header"}
+			}
+			lines[i] = "[" + strings.TrimSpace(name[1:]) + "]"
+		default:
+			key, value, ok := strings.Cut(trimmed, "=")
+			if !ok {
+				return "", &OutputError{Format: FormatINI, Line: i + 1, Column: column, Message: `expected "key = value"`}
+			}
+			if strings.TrimSpace(key) == "" {
+				return "", &OutputError{Format: FormatINI, Line: i + 1, Column: column, Message: "missing key"}
+			}
+			lines[i] = strings.TrimSpace(key) + " = " + strings.TrimSpace(value)
+		}
+	}
+	if !normalize {
+		return rendered, nil
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// position converts a byte offset in s to a 1-based line and column
+func position(s string, offset int) (line, column int) {
+	offset = min(max(offset, 0), len(s))
+	before := s[:offset]
+	line = strings.Count(before, "\n") + 1
+	column = offset - strings.LastIndex(before, "\n")
+	return line, column
+}
@@ -0,0 +1,674 @@
+package tmplproc
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// maxRepeat bounds the size of strings built by repeat
+const maxRepeat = 10000
+
+// functions is the function library available to every template, on top of the
+// text/template builtins. The functions are deterministic and have no access to
+// the environment, files or the network, so a template renders the same output
+// for the same data wherever it runs. Argument order follows sprig, with the
+// value last so functions can be used in pipelines: {{ .Name | trunc 8 | upper }}.
+//
+// Defaults and checks:
+//
+//	default DEFAULT VALUE     VALUE, or DEFAULT if VALUE is empty
+//	empty VALUE               whether VALUE is nil, zero or has no elements
+//	coalesce VALUE...         the first non-empty VALUE
+//	ternary A B CONDITION     A if CONDITION is true, otherwise B
+//	required MESSAGE VALUE    VALUE, failing with MESSAGE if it is empty
+//
+// Strings:
+//
+//	upper, lower, title, trim STRING
+//	trimPrefix PREFIX STRING, trimSuffix SUFFIX STRING
+//	replace OLD NEW STRING
+//	contains SUBSTRING STRING, hasPrefix PREFIX STRING, hasSuffix SUFFIX STRING
+//	repeat COUNT STRING       at most 10000 repetitions
+//	trunc LENGTH STRING       the first LENGTH characters
+//	quote, squote VALUE       VALUE in double or single quotes
+//	indent SPACES STRING      STRING with each line indented
+//	nindent SPACES STRING     like indent, preceded by a newline
+//	splitList SEPARATOR STRING, join SEPARATOR LIST
+//	toString VALUE
+//
+// Encoding:
+//
+//	toYaml, toJson, toPrettyJson VALUE
+//	b64enc, b64dec STRING
+//	sha256sum STRING          hex-encoded SHA-256 digest
+//
+// Networks:
+//
+//	cidrHost PREFIX HOSTNUM            address HOSTNUM of PREFIX, counting from the end if negative
+//	cidrNetmask PREFIX                 netmask of an IPv4 PREFIX, e.g. 255.255.255.0
+//	cidrSubnet PREFIX NEWBITS NETNUM   subnet NETNUM of PREFIX extended by NEWBITS
+//
+// Partials:
+//
+//	include NAME DATA         output of a partial or defined template as a string,
+//	                          e.g. {{ include "labels" . | indent 4 }}
+//
+// Lists and dictionaries:
+//
+//	list VALUE..., dict KEY VALUE...
+//	get DICT KEY, hasKey DICT KEY, keys DICT (sorted)
+//	first LIST, last LIST, append LIST VALUE
+//
+// Integer math:
+//
+//	add, mul A B..., sub, div, mod A B, max, min A B...
+//
+// Dates (there is no "now", templates only work with the dates they are given):
+//
+//	toDate LAYOUT STRING      parse a date in a Go layout, e.g. "2006-01-02"
+//	date LAYOUT DATE          format a date or Unix time in a Go layout
+//	dateModify DURATION DATE  DATE shifted by a Go duration, e.g. "-24h"
+var functions = template.FuncMap{
+	// Defaults and checks
+	"default":  defaultValue,
+	"empty":    empty,
+	"coalesce": coalesce,
+	"ternary":  ternary,
+	"required": required,
+
+	// Strings
+	"upper":      strings.ToUpper,
+	"lower":      strings.ToLower,
+	"title":      title,
+	"trim":       strings.TrimSpace,
+	"trimPrefix": func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+	"trimSuffix": func(suffix, s string) string { return strings.TrimSuffix(s, suffix) },
+	"replace":    replace(DefaultLimits.MaxOutputBytes),
+	"contains":   func(substr, s string) bool { return strings.Contains(s, substr) },
+	"hasPrefix":  func(prefix, s string) bool { return strings.HasPrefix(s, prefix) },
+	"hasSuffix":  func(suffix, s string) bool { return strings.HasSuffix(s, suffix) },
+	"repeat":     repeat(DefaultLimits.MaxOutputBytes),
+	"trunc":      trunc,
+	"quote":      func(v any) string { return strconv.Quote(toString(v)) },
+	"squote":     func(v any) string { return "'" + toString(v) + "'" },
+	"indent":     indent(DefaultLimits.MaxOutputBytes),
+	"nindent":    nindent(DefaultLimits.MaxOutputBytes),
+	"splitList":  splitList(DefaultLimits.MaxOutputBytes),
+	"join":       joinList(DefaultLimits.MaxOutputBytes),
+	"toString":   toString,
+
+	// Encoding
+	"toYaml":       toYaml,
+	"toJson":       toJSON,
+	"toPrettyJson": toPrettyJSON,
+	"b64enc":       func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+	"b64dec":       b64dec,
+	"sha256sum":    sha256sum,
+
+	// Networks
+	"cidrHost":    cidrHost,
+	"cidrNetmask": cidrNetmask,
+	"cidrSubnet":  cidrSubnet,
+
+	// Partials, bound to each render by execute
+	"include": func(string, any) (string, error) { return "", errors.New("include is not available") },
+
+	// Lists and dictionaries
+	"list":   func(values ...any) []any { return values },
+	"dict":   dict,
+	"get":    func(d map[string]any, key string) any { return d[key] },
+	"hasKey": func(d map[string]any, key string) bool { _, ok := d[key]; return ok },
+	"keys":   keys,
+	"first":  first,
+	"last":   last,
+	"append": appendValue,
+
+	// Integer math
+	"add": add,
+	"sub": sub,
+	"mul": mul,
+	"div": div,
+	"mod": mod,
+	"max": maxValue,
+	"min": minValue,
+
+	// Dates
+	"toDate":     toDate,
+	"date":       formatDate,
+	"dateModify": dateModify,
+}
+
+// defaultValue returns the value, or the default if the value is empty
+func defaultValue(def any, given ...any) any {
+	if len(given) == 0 || empty(given[0]) {
+		return def
+	}
+	return given[0]
+}
+
+// empty reports whether a value is nil, the zero value of its type, or has no elements
+func empty(v any) bool {
+	value := reflect.ValueOf(v)
+	if !value.IsValid() {
+		return true
+	}
+	switch value.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return value.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return value.IsNil()
+	}
+	return value.IsZero()
+}
+
+// coalesce returns the first non-empty value
+func coalesce(values ...any) any {
+	for _, v := range values {
+		if !empty(v) {
+			return v
+		}
+	}
+	return nil
+}
+
+// ternary returns a if the condition is true, otherwise b
+func ternary(a, b any, condition bool) any {
+	if condition {
+		return a
+	}
+	return b
+}
+
+// required fails the render with the message if the value is empty
+func required(message string, v any) (any, error) {
+	if empty(v) {
+		return nil, errors.New(message)
+	}
+	return v, nil
+}
+
+// title upper-cases the first letter of each word
+func title(s string) string {
+	previous := ' '
+	return strings.Map(func(r rune) rune {
+		start := unicode.IsSpace(previous) || unicode.IsPunct(previous)
+		previous = r
+		if start {
+			return unicode.ToTitle(r)
+		}
+		return r
+	}, s)
+}
+
+// sizedFunctions returns the functions that can build values much larger than
+// their arguments, bounded by the output limit of a render. The function library
+// holds them bounded by the default limit.
+func sizedFunctions(maxBytes int) template.FuncMap {
+	return template.FuncMap{
+		"replace":   replace(maxBytes),
+		"repeat":    repeat(maxBytes),
+		"indent":    indent(maxBytes),
+		"nindent":   nindent(maxBytes),
+		"splitList": splitList(maxBytes),
+		"join":      joinList(maxBytes),
+	}
+}
+
+// errTooLarge is the error of a function refusing to build a value larger than maxBytes
+func errTooLarge(function string, maxBytes int) error {
+	return fmt.Errorf("%w: %s would build more than %d bytes", ErrOutputTooLarge, function, maxBytes)
+}
+
+// replace returns a function that replaces all occurrences of a string,
+// refusing to build strings larger than maxBytes
+func replace(maxBytes int) func(old, new, s string) (string, error) {
+	return func(old, new, s string) (string, error) {
+		// An empty old string matches at every rune boundary, as Count counts it
+		n := strings.Count(s, old)
+		if grow := len(new) - len(old); grow > 0 && (len(s) > maxBytes || n > (maxBytes-len(s))/grow) {
+			return "", errTooLarge("replace", maxBytes)
+		}
+		return strings.ReplaceAll(s, old, new), nil
+	}
+}
+
+// repeat returns a function that repeats a string, refusing to build strings
+// larger than maxBytes
+func repeat(maxBytes int) func(count int, s string) (string, error) {
+	return func(count int, s string) (string, error) {
+		if count < 0 || count > maxRepeat {
+			return "", fmt.Errorf("repeat count must be between 0 and %d", maxRepeat)
+		}
+		if len(s) > 0 && count > maxBytes/len(s) {
+			return "", errTooLarge("repeat", maxBytes)
+		}
+		return strings.Repeat(s, count), nil
+	}
+}
+
+// trunc returns the first characters of a string
+func trunc(length int, s string) string {
+	runes := []rune(s)
+	if length < 0 || length >= len(runes) {
+		return s
+	}
+	return string(runes[:length])
+}
+
+// indent returns a function that indents every line of a string, refusing to
+// build strings larger than maxBytes
+func indent(maxBytes int) func(spaces int, s string) (string, error) {
+	return func(spaces int, s string) (string, error) {
+		if spaces < 0 {
+			spaces = 0
+		}
+		lines := strings.Count(s, "\n") + 1
+		if len(s) > maxBytes || spaces > (maxBytes-len(s))/lines {
+			return "", errTooLarge("indent", maxBytes)
+		}
+		pad := strings.Repeat(" ", spaces)
+		return pad + strings.ReplaceAll(s, "\n", "\n"+pad), nil
+	}
+}
+
+// nindent returns a function like indent that also prepends a newline
+func nindent(maxBytes int) func(spaces int, s string) (string, error) {
+	indent := indent(maxBytes - 1)
+	return func(spaces int, s string) (string, error) {
+		indented, err := indent(spaces, s)
+		if err != nil {
+			return "", err
+		}
+		return "\n" + indented, nil
+	}
+}
+
+// splitList returns a function that splits a string into a list, refusing to
+// build lists of more than maxBytes elements
+func splitList(maxBytes int) func(sep, s string) ([]string, error) {
+	return func(sep, s string) ([]string, error) {
+		n := strings.Count(s, sep) + 1
+		if sep == "" {
+			n = utf8.RuneCountInString(s)
+		}
+		if n > maxBytes {
+			return nil, errTooLarge("splitList", maxBytes)
+		}
+		return strings.Split(s, sep), nil
+	}
+}
+
+// joinList returns a function that joins the elements of a list, converted to
+// strings, refusing to build strings larger than maxBytes
+func joinList(maxBytes int) func(sep string, list any) (string, error) {
+	return func(sep string, list any) (string, error) {
+		values, err := toList(list)
+		if err != nil {
+			return "", err
+		}
+		parts := make([]string, len(values))
+		size := 0
+		for i, v := range values {
+			parts[i] = toString(v)
+			if i > 0 {
+				size += len(sep)
+			}
+			if size += len(parts[i]); size > maxBytes {
+				return "", errTooLarge("join", maxBytes)
+			}
+		}
+		return strings.Join(parts, sep), nil
+	}
+}
+
+// toString converts a value to a string
+func toString(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case error:
+		return v.Error()
+	case fmt.Stringer:
+		return v.String()
+	}
+	return fmt.Sprint(v)
+}
+
+// toYaml encodes a value as YAML indented by two spaces, without the trailing newline
+func toYaml(v any) (string, error) {
+	var buf strings.Builder
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(v); err != nil {
+		return "", fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// toJSON encodes a value as compact JSON
+func toJSON(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode JSON: %w", err)
+	}
+	return string(data), nil
+}
+
+// toPrettyJSON encodes a value as indented JSON
+func toPrettyJSON(v any) (string, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode JSON: %w", err)
+	}
+	return string(data), nil
+}
+
+// b64dec decodes a base64 string
+func b64dec(s string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode base64: %w", err)
+	}
+	return string(data), nil
+}
+
+// sha256sum returns the hex-encoded SHA-256 digest of a string
+func sha256sum(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// cidrHost returns the address with the given number within a network prefix.
+// Negative numbers count back from the last address.
+func cidrHost(prefix string, hostnum int64) (string, error) {
+	network, err := parsePrefix(prefix)
+	if err != nil {
+		return "", err
+	}
+
+	size := new(big.Int).Lsh(big.NewInt(1), uint(network.Addr().BitLen()-network.Bits()))
+	n := big.NewInt(hostnum)
+	if hostnum < 0 {
+		n.Add(n, size)
+	}
+	if n.Sign() < 0 || n.Cmp(size) >= 0 {
+		return "", fmt.Errorf("prefix %s has no host number %d", prefix, hostnum)
+	}
+	return addrAdd(network.Addr(), n).String(), nil
+}
+
+// cidrNetmask returns the netmask of an IPv4 network prefix
+func cidrNetmask(prefix string) (string, error) {
+	network, err := parsePrefix(prefix)
+	if err != nil {
+		return "", err
+	}
+	if !network.Addr().Is4() {
+		return "", fmt.Errorf("prefix %s is not an IPv4 prefix", prefix)
+	}
+
+	mask := ^uint32(0) << (32 - network.Bits())
+	return netip.AddrFrom4([4]byte{byte(mask >> 24), byte(mask >> 16), byte(mask >> 8), byte(mask)}).String(), nil
+}
+
+// cidrSubnet returns subnet number netnum of a network prefix extended by newbits bits
+func cidrSubnet(prefix string, newbits int, netnum int64) (string, error) {
+	network, err := parsePrefix(prefix)
+	if err != nil {
+		return "", err
+	}
+
+	bits := network.Bits() + newbits
+	if newbits < 0 || bits > network.Addr().BitLen() {
+		return "", fmt.Errorf("prefix %s cannot be extended by %d bits", prefix, newbits)
+	}
+	if netnum < 0 || big.NewInt(netnum).Cmp(new(big.Int).Lsh(big.NewInt(1), uint(newbits))) >= 0 {
+		return "", fmt.Errorf("prefix %s extended by %d bits has no subnet %d", prefix, newbits, netnum)
+	}
+
+	offset := new(big.Int).Lsh(big.NewInt(netnum), uint(network.Addr().BitLen()-bits))
+	return netip.PrefixFrom(addrAdd(network.Addr(), offset), bits).String(), nil
+}
+
+// parsePrefix parses a network prefix and masks out its host bits
+func parsePrefix(prefix string) (netip.Prefix, error) {
+	network, err := netip.ParsePrefix(prefix)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid prefix %q: %w", prefix, err)
+	}
+	return network.Masked(), nil
+}
+
+// addrAdd adds an offset to an address; the offset must keep it within its network
+func addrAdd(addr netip.Addr, offset *big.Int) netip.Addr {
+	sum := new(big.Int).SetBytes(addr.AsSlice())
+	sum.Add(sum, offset)
+
+	bytes := sum.FillBytes(make([]byte, len(addr.AsSlice())))
+	result, _ := netip.AddrFromSlice(bytes)
+	return result
+}
+
+// dict builds a dictionary from alternating keys and values
+func dict(pairs ...any) (map[string]any, error) {
+	if len(pairs)%2 != 0 {
+		return nil, errors.New("dict requires an even number of arguments")
+	}
+	d := make(map[string]any, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		d[toString(pairs[i])] = pairs[i+1]
+	}
+	return d, nil
+}
+
+// keys returns the sorted keys of a dictionary
+func keys(d map[string]any) []string {
+	result := make([]string, 0, len(d))
+	for key := range d {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// first returns the first element of a list, or nil if it is empty
+func first(list any) (any, error) {
+	values, err := toList(list)
+	if err != nil || len(values) == 0 {
+		return nil, err
+	}
+	return values[0], nil
+}
+
+// last returns the last element of a list, or nil if it is empty
+func last(list any) (any, error) {
+	values, err := toList(list)
+	if err != nil || len(values) == 0 {
+		return nil, err
+	}
+	return values[len(values)-1], nil
+}
+
+// appendValue returns a new list with a value appended
+func appendValue(list any, v any) ([]any, error) {
+	values, err := toList(list)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]any, len(values), len(values)+1)
+	copy(result, values)
+	return append(result, v), nil
+}
+
+// toList converts a slice or array of any type to []any
+func toList(list any) ([]any, error) {
+	if values, ok := list.([]any); ok {
+		return values, nil
+	}
+	value := reflect.ValueOf(list)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return nil, fmt.Errorf("expected a list, got %T", list)
+	}
+	values := make([]any, value.Len())
+	for i := range values {
+		values[i] = value.Index(i).Interface()
+	}
+	return values, nil
+}
+
+// toInt64 converts a number or numeric string to an integer
+func toInt64(v any) (int64, error) {
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(value.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return int64(value.Float()), nil
+	case reflect.String:
+		i, err := strconv.ParseInt(value.String(), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not an integer", value.String())
+		}
+		return i, nil
+	}
+	return 0, fmt.Errorf("expected a number, got %T", v)
+}
+
+// reduce folds integer arguments with an operation
+func reduce(operation func(a, b int64) int64, values []any) (int64, error) {
+	if len(values) == 0 {
+		return 0, errors.New("expected at least one number")
+	}
+	result, err := toInt64(values[0])
+	if err != nil {
+		return 0, err
+	}
+	for _, v := range values[1:] {
+		i, err := toInt64(v)
+		if err != nil {
+			return 0, err
+		}
+		result = operation(result, i)
+	}
+	return result, nil
+}
+
+func add(values ...any) (int64, error) {
+	return reduce(func(a, b int64) int64 { return a + b }, values)
+}
+
+func mul(values ...any) (int64, error) {
+	return reduce(func(a, b int64) int64 { return a * b }, values)
+}
+
+func maxValue(values ...any) (int64, error) {
+	return reduce(func(a, b int64) int64 {
+		if b > a {
+			return b
+		}
+		return a
+	}, values)
+}
+
+func minValue(values ...any) (int64, error) {
+	return reduce(func(a, b int64) int64 {
+		if b < a {
+			return b
+		}
+		return a
+	}, values)
+}
+
+func sub(a, b any) (int64, error) {
+	return reduce(func(a, b int64) int64 { return a - b }, []any{a, b})
+}
+
+func div(a, b any) (int64, error) {
+	divisor, err := toInt64(b)
+	if err != nil {
+		return 0, err
+	}
+	if divisor == 0 {
+		return 0, errors.New("division by zero")
+	}
+	return reduce(func(a, b int64) int64 { return a / b }, []any{a, divisor})
+}
+
+func mod(a, b any) (int64, error) {
+	divisor, err := toInt64(b)
+	if err != nil {
+		return 0, err
+	}
+	if divisor == 0 {
+		return 0, errors.New("division by zero")
+	}
+	return reduce(func(a, b int64) int64 { return a % b }, []any{a, divisor})
+}
+
+// toDate parses a date in a Go layout
+func toDate(layout, value string) (time.Time, error) {
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse date: %w", err)
+	}
+	return t, nil
+}
+
+// formatDate formats a date, or a Unix time in seconds, in a Go layout
+func formatDate(layout string, date any) (string, error) {
+	t, err := toTime(date)
+	if err != nil {
+		return "", err
+	}
+	return t.Format(layout), nil
+}
+
+// dateModify shifts a date by a Go duration
+func dateModify(duration string, date any) (time.Time, error) {
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid duration %q", duration)
+	}
+	t, err := toTime(date)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.Add(d), nil
+}
+
+// toTime converts a date, or a Unix time in seconds, to a time.Time
+func toTime(date any) (time.Time, error) {
+	if t, ok := date.(time.Time); ok {
+		return t, nil
+	}
+	seconds, err := toInt64(date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected a date, got %T", date)
+	}
+	return time.Unix(seconds, 0).UTC(), nil
+}
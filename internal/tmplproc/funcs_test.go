@@ -0,0 +1,171 @@
+package tmplproc
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRepeat(t *testing.T) {
+	tests := []struct {
+		name    string
+		count   int
+		s       string
+		want    string
+		wantErr error
+	}{
+		{name: "repeats", count: 3, s: "ab", want: "ababab"},
+		{name: "zero count", count: 0, s: "ab", want: ""},
+		{name: "empty string", count: maxRepeat, s: "", want: ""},
+		{name: "up to the output limit", count: 50, s: "ab", want: strings.Repeat("ab", 50)},
+		{name: "beyond the output limit", count: 51, s: "ab", wantErr: ErrOutputTooLarge},
+		{name: "negative count", count: -1, s: "ab", wantErr: errAny},
+		{name: "beyond the count limit", count: maxRepeat + 1, s: "", wantErr: errAny},
+	}
+
+	repeat := repeat(100)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repeat(tt.count, tt.s)
+			checkResult(t, got, err, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func TestIndent(t *testing.T) {
+	tests := []struct {
+		name    string
+		spaces  int
+		s       string
+		want    string
+		wantErr error
+	}{
+		{name: "single line", spaces: 2, s: "a: 1", want: "  a: 1"},
+		{name: "every line", spaces: 2, s: "a: 1\nb: 2", want: "  a: 1\n  b: 2"},
+		{name: "negative spaces", spaces: -2, s: "a: 1", want: "a: 1"},
+		{name: "up to the output limit", spaces: 47, s: "ab\ncd", want: strings.Repeat(" ", 47) + "ab\n" + strings.Repeat(" ", 47) + "cd"},
+		{name: "beyond the output limit", spaces: 48, s: "ab\ncd", wantErr: ErrOutputTooLarge},
+		{name: "huge spaces", spaces: 1 << 40, s: "a", wantErr: ErrOutputTooLarge},
+		{name: "string beyond the output limit", spaces: 0, s: strings.Repeat("a", 101), wantErr: ErrOutputTooLarge},
+	}
+
+	indent := indent(100)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := indent(tt.spaces, tt.s)
+			checkResult(t, got, err, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func TestNindent(t *testing.T) {
+	tests := []struct {
+		name    string
+		spaces  int
+		s       string
+		want    string
+		wantErr error
+	}{
+		{name: "every line", spaces: 2, s: "a: 1\nb: 2", want: "\n  a: 1\n  b: 2"},
+		{name: "up to the output limit", spaces: 95, s: "abcd", want: "\n" + strings.Repeat(" ", 95) + "abcd"},
+		{name: "beyond the output limit", spaces: 96, s: "abcd", wantErr: ErrOutputTooLarge},
+	}
+
+	nindent := nindent(100)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := nindent(tt.spaces, tt.s)
+			checkResult(t, got, err, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func TestReplace(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		s        string
+		want     string
+		wantErr  error
+	}{
+		{name: "replaces every match", old: "a", new: "bb", s: "banana", want: "bbbnbbnbb"},
+		{name: "shrinks", old: "an", new: "", s: "banana", want: "ba"},
+		{name: "shrinks a string beyond the output limit", old: "aa", new: "a", s: strings.Repeat("aa", 100), want: strings.Repeat("a", 100)},
+		{name: "empty old string", old: "", new: "-", s: "ab", want: "-a-b-"},
+		{name: "up to the output limit", old: "a", new: "aa", s: strings.Repeat("a", 50), want: strings.Repeat("a", 100)},
+		{name: "beyond the output limit", old: "a", new: "aa", s: strings.Repeat("a", 51), wantErr: ErrOutputTooLarge},
+		{name: "empty old string beyond the output limit", old: "", new: strings.Repeat("x", 10), s: "abcdefghij", wantErr: ErrOutputTooLarge},
+	}
+
+	replace := replace(100)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := replace(tt.old, tt.new, tt.s)
+			checkResult(t, got, err, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	tests := []struct {
+		name    string
+		sep     string
+		s       string
+		want    string
+		wantErr error
+	}{
+		{name: "splits", sep: ",", s: "a,b,c", want: "a|b|c"},
+		{name: "empty separator", sep: "", s: "abc", want: "a|b|c"},
+		{name: "up to the output limit", sep: "", s: strings.Repeat("a", 100), want: strings.TrimSuffix(strings.Repeat("a|", 100), "|")},
+		{name: "beyond the output limit", sep: ",", s: strings.Repeat(",", 100), wantErr: ErrOutputTooLarge},
+	}
+
+	splitList := splitList(100)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := splitList(tt.sep, tt.s)
+			checkResult(t, strings.Join(list, "|"), err, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func TestJoin(t *testing.T) {
+	tests := []struct {
+		name    string
+		sep     string
+		list    any
+		want    string
+		wantErr error
+	}{
+		{name: "joins", sep: ", ", list: []string{"a", "b"}, want: "a, b"},
+		{name: "converts to strings", sep: "-", list: []any{1, true, "x"}, want: "1-true-x"},
+		{name: "empty list", sep: "-", list: []any{}, want: ""},
+		{name: "up to the output limit", sep: strings.Repeat("-", 98), list: []string{"a", "b"}, want: "a" + strings.Repeat("-", 98) + "b"},
+		{name: "beyond the output limit", sep: strings.Repeat("-", 99), list: []string{"a", "b"}, wantErr: ErrOutputTooLarge},
+		{name: "not a list", sep: "-", list: 1, wantErr: errAny},
+	}
+
+	join := joinList(100)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := join(tt.sep, tt.list)
+			checkResult(t, got, err, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// errAny matches any error in checkResult
+var errAny = errors.New("any error")
+
+// checkResult checks the result of a function against the wanted result or error
+func checkResult(t *testing.T, got string, err error, want string, wantErr error) {
+	t.Helper()
+	switch {
+	case wantErr == nil && err != nil:
+		t.Fatalf("unexpected error: %v", err)
+	case wantErr == errAny && err == nil, wantErr != nil && wantErr != errAny && !errors.Is(err, wantErr):
+		t.Fatalf("got error %v, want %v", err, wantErr)
+	case wantErr == nil && got != want:
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,415 @@
+package tmplproc
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
+	"github.com/aa1ex/paas-provider/internal/validation"
+)
+
+var (
+	// ErrMissingPartial is returned when a template calls a partial that does not exist
+	ErrMissingPartial = errors.New("template calls a partial that does not exist")
+	// ErrPartialCycle is returned when partials call each other in a cycle
+	ErrPartialCycle = errors.New("partials call each other in a cycle")
+)
+
+// ValidatePartials checks that the partials a template calls exist and do not
+// call each other in a cycle, and that a partial's name is not used by another
+// partial. The fields the called partials reference must be supplied by the
+// template's resources; if tmpl is a partial, this is checked for the stored
+// templates calling it. Problems with the template are returned as validation.Errors.
+func (p *TemplateProcessor) ValidatePartials(tmpl storage.Template) error {
+	var errs validation.Errors
+
+	available, err := p.partialsByName(tmpl)
+	if err != nil {
+		return err
+	}
+	if tmpl.Type == "partial" {
+		if other, ok := available[tmpl.Name]; ok && other.ID != tmpl.ID {
+			errs.Add("name", fmt.Sprintf("partial %q already exists", tmpl.Name))
+		}
+	}
+
+	partials, err := resolvePartials(tmpl, available)
+	if err != nil {
+		if !errors.Is(err, ErrMissingPartial) && !errors.Is(err, ErrPartialCycle) {
+			return err
+		}
+		errs.Add("raw_template", err.Error())
+	} else {
+		validatePartialFields(tmpl, partials, &errs)
+	}
+
+	// Check the templates calling the partial against its new content. Callers
+	// that do not resolve are left alone: the change does not break them further.
+	if tmpl.Type == "partial" && !errs.HasErrors() {
+		dependents, err := p.Dependents(tmpl)
+		if err != nil {
+			return err
+		}
+		for _, dependent := range dependents {
+			partials, err := resolvePartials(dependent, available)
+			if err != nil {
+				continue
+			}
+			var dependentErrs validation.Errors
+			validatePartialFields(dependent, partials, &dependentErrs)
+			for _, e := range dependentErrs {
+				errs.Add("raw_template", fmt.Sprintf("template %q %s", dependent.Name, e.Message))
+			}
+		}
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+// validatePartialFields checks the fields that the files of a template
+// reference through the partials they call. Fields referenced by the files
+// themselves are left to ValidateTemplate.
+func validatePartialFields(tmpl storage.Template, partials []storage.Template, errs *validation.Errors) {
+	if _, ok := resourceFields[tmpl.Type]; !ok || len(partials) == 0 {
+		return
+	}
+
+	parameters := make(map[string]bool, len(tmpl.Parameters))
+	for _, parameter := range tmpl.Parameters {
+		parameters[parameter.Name] = true
+	}
+
+	files := templateFiles(tmpl)
+	offset := len(files) - len(tmpl.Files) // the body comes before the files
+	for i, file := range files {
+		field := "raw_template"
+		if i >= offset {
+			field = fmt.Sprintf("files[%d].raw_template", i-offset)
+		}
+
+		own, err := Describe(file.RawTemplate)
+		if err != nil {
+			continue
+		}
+		all, err := describe([]string{file.RawTemplate}, partials)
+		if err != nil {
+			errs.Add(field, err.Error())
+			continue
+		}
+		checkFields(field, without(all.Fields, own.Fields), tmpl.Type, parameters, errs)
+	}
+}
+
+// without returns the values that are not in removed
+func without(values, removed []string) []string {
+	var kept []string
+	for _, value := range values {
+		if !contains(removed, value) {
+			kept = append(kept, value)
+		}
+	}
+	return kept
+}
+
+// Dependencies returns the partials a template calls, directly or through other partials
+func (p *TemplateProcessor) Dependencies(tmpl storage.Template) ([]storage.Template, error) {
+	available, err := p.partialsByName(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	return resolvePartials(tmpl, available)
+}
+
+// Dependents returns the templates that call a partial, directly or through
+// other partials, sorted by name
+func (p *TemplateProcessor) Dependents(partial storage.Template) ([]storage.Template, error) {
+	if partial.Type != "partial" {
+		return nil, nil
+	}
+
+	templates, _, err := p.storage.ListTemplates("", storage.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates: %w", err)
+	}
+	return DependentsIn(partial, templates), nil
+}
+
+// DependentsIn returns the templates among templates that call a partial,
+// directly or through other partials among them, sorted by name
+func DependentsIn(partial storage.Template, templates []storage.Template) []storage.Template {
+	if partial.Type != "partial" {
+		return nil
+	}
+
+	// Index the templates by the names they call. Templates that do not parse
+	// call nothing as far as dependents are concerned.
+	callers := make(map[string][]storage.Template)
+	for _, tmpl := range templates {
+		called := make(map[string]bool)
+		for _, file := range templateFiles(tmpl) {
+			calls, err := templateCalls(file.RawTemplate)
+			if err != nil {
+				continue
+			}
+			for _, call := range calls {
+				called[call] = true
+			}
+		}
+		for call := range called {
+			callers[call] = append(callers[call], tmpl)
+		}
+	}
+
+	var dependents []storage.Template
+	seen := map[string]bool{partial.ID: true}
+	queue := []string{partial.Name}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		for _, caller := range callers[name] {
+			if seen[caller.ID] {
+				continue
+			}
+			seen[caller.ID] = true
+			dependents = append(dependents, caller)
+			if caller.Type == "partial" {
+				queue = append(queue, caller.Name)
+			}
+		}
+	}
+
+	sort.Slice(dependents, func(i, j int) bool {
+		if dependents[i].Name != dependents[j].Name {
+			return dependents[i].Name < dependents[j].Name
+		}
+		return dependents[i].ID < dependents[j].ID
+	})
+	return dependents
+}
+
+// partialsByName loads the stored partials by name. If tmpl is a partial, it
+// replaces its stored version so that a change can be checked before it is stored.
+func (p *TemplateProcessor) partialsByName(tmpl storage.Template) (map[string]storage.Template, error) {
+	partials, _, err := p.storage.ListTemplates("partial", storage.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partials: %w", err)
+	}
+
+	available := make(map[string]storage.Template, len(partials))
+	for _, partial := range partials {
+		if tmpl.Type == "partial" && partial.ID == tmpl.ID {
+			continue
+		}
+		available[partial.Name] = partial
+	}
+	if tmpl.Type == "partial" {
+		if _, ok := available[tmpl.Name]; !ok {
+			available[tmpl.Name] = tmpl
+		}
+	}
+	return available, nil
+}
+
+// resolvePartials returns the partials a template calls, directly or through
+// other partials, in the order they are first called
+func resolvePartials(tmpl storage.Template, available map[string]storage.Template) ([]storage.Template, error) {
+	r := &partialResolver{
+		available: available,
+		resolved:  make(map[string]bool),
+	}
+	if tmpl.Type == "partial" {
+		r.path = []string{tmpl.Name}
+	}
+	for _, file := range templateFiles(tmpl) {
+		if err := r.visit(file.RawTemplate); err != nil {
+			return nil, err
+		}
+	}
+	return r.partials, nil
+}
+
+// partialResolver walks the partials called by a template depth first
+type partialResolver struct {
+	available map[string]storage.Template
+	resolved  map[string]bool
+	path      []string // partials being resolved, to detect cycles
+	partials  []storage.Template
+}
+
+func (r *partialResolver) visit(rawTemplate string) error {
+	calls, err := templateCalls(rawTemplate)
+	if err != nil {
+		return err
+	}
+
+	for _, call := range calls {
+		for i, name := range r.path {
+			if name == call {
+				return fmt.Errorf("%w: %s", ErrPartialCycle, strings.Join(append(r.path[i:], call), " -> "))
+			}
+		}
+		if r.resolved[call] {
+			continue
+		}
+
+		partial, ok := r.available[call]
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrMissingPartial, call)
+		}
+		r.path = append(r.path, call)
+		if err := r.visit(partial.RawTemplate); err != nil {
+			return err
+		}
+		r.path = r.path[:len(r.path)-1]
+		r.resolved[call] = true
+		r.partials = append(r.partials, partial)
+	}
+	return nil
+}
+
+// templateCalls returns the names of the templates called by a template body,
+// with {{ template "name" }} or {{ include "name" }}, that the body does not
+// define itself
+func templateCalls(rawTemplate string) ([]string, error) {
+	tree := parse.New("template")
+	tree.Mode = parse.SkipFuncCheck
+	trees := make(map[string]*parse.Tree)
+	if _, err := tree.Parse(rawTemplate, "", "", trees); err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	calls := make(map[string]bool)
+	for _, t := range trees {
+		walkCalls(t.Root, func(name string) {
+			if _, ok := trees[name]; !ok {
+				calls[name] = true
+			}
+		})
+	}
+	return sortedKeys(calls), nil
+}
+
+// walkCalls reports the names of the templates called below a node
+func walkCalls(node parse.Node, call func(name string)) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			walkCalls(child, call)
+		}
+	case *parse.ActionNode:
+		walkCalls(n.Pipe, call)
+	case *parse.IfNode:
+		walkBranchCalls(&n.BranchNode, call)
+	case *parse.RangeNode:
+		walkBranchCalls(&n.BranchNode, call)
+	case *parse.WithNode:
+		walkBranchCalls(&n.BranchNode, call)
+	case *parse.TemplateNode:
+		call(n.Name)
+		walkCalls(n.Pipe, call)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			if name, ok := includeName(cmd); ok {
+				call(name)
+			}
+			for _, arg := range cmd.Args {
+				walkCalls(arg, call)
+			}
+		}
+	}
+}
+
+func walkBranchCalls(n *parse.BranchNode, call func(name string)) {
+	walkCalls(n.Pipe, call)
+	walkCalls(n.List, call)
+	walkCalls(n.ElseList, call)
+}
+
+// dynamicInclude reports whether include is called below a node with a name
+// that is not a string constant. Such calls cannot be resolved to partials
+// before rendering.
+func dynamicInclude(node parse.Node) bool {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return false
+		}
+		for _, child := range n.Nodes {
+			if dynamicInclude(child) {
+				return true
+			}
+		}
+	case *parse.ActionNode:
+		return dynamicInclude(n.Pipe)
+	case *parse.IfNode:
+		return dynamicInclude(n.Pipe) || dynamicInclude(n.List) || dynamicInclude(n.ElseList)
+	case *parse.RangeNode:
+		return dynamicInclude(n.Pipe) || dynamicInclude(n.List) || dynamicInclude(n.ElseList)
+	case *parse.WithNode:
+		return dynamicInclude(n.Pipe) || dynamicInclude(n.List) || dynamicInclude(n.ElseList)
+	case *parse.TemplateNode:
+		return dynamicInclude(n.Pipe)
+	case *parse.PipeNode:
+		if n == nil {
+			return false
+		}
+		for _, cmd := range n.Cmds {
+			if ident, ok := cmd.Args[0].(*parse.IdentifierNode); ok && ident.Ident == "include" {
+				if _, ok := includeName(cmd); !ok {
+					return true
+				}
+			}
+			for _, arg := range cmd.Args {
+				if dynamicInclude(arg) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// includeName returns the template name of an include call with a constant name
+func includeName(cmd *parse.CommandNode) (string, bool) {
+	if len(cmd.Args) < 2 {
+		return "", false
+	}
+	if ident, ok := cmd.Args[0].(*parse.IdentifierNode); !ok || ident.Ident != "include" {
+		return "", false
+	}
+	name, ok := cmd.Args[1].(*parse.StringNode)
+	if !ok {
+		return "", false
+	}
+	return name.Text, true
+}
+
+// parseTemplateSet parses a template file together with the partials it calls.
+// include and the deadline checks added by checkRanges are bound to each
+// render by execute.
+func parseTemplateSet(rawTemplate string, partials []storage.Template) (*template.Template, error) {
+	parsed, err := parseTemplate(rawTemplate)
+	if err != nil {
+		return nil, err
+	}
+	for _, partial := range partials {
+		if _, err := parsed.New(partial.Name).Parse(partial.RawTemplate); err != nil {
+			return nil, fmt.Errorf("failed to parse partial %q: %w", partial.Name, err)
+		}
+	}
+	checkRanges(parsed)
+	return parsed, nil
+}
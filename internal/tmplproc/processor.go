@@ -1,92 +1,199 @@
 package tmplproc
 
 import (
-	"bytes"
+	"context"
 	"fmt"
-	"text/template"
 
 	"github.com/aa1ex/paas-provider/internal/storage"
+	"github.com/aa1ex/paas-provider/internal/validation"
 )
 
 // TemplateProcessor is responsible for processing templates
 type TemplateProcessor struct {
-	storage *storage.Storage
+	storage storage.Storage
+	cache   *templateCache
+	limits  Limits
 }
 
-// NewTemplateProcessor creates a new template processor
-func NewTemplateProcessor(storage *storage.Storage) *TemplateProcessor {
+// NewTemplateProcessor creates a new template processor that renders templates within the given limits
+func NewTemplateProcessor(storage storage.Storage, limits Limits) *TemplateProcessor {
+	limits = limits.withDefaults()
 	return &TemplateProcessor{
 		storage: storage,
+		cache:   newTemplateCache(limits.MaxCachedTemplates),
+		limits:  limits,
 	}
 }
 
-// ProcessVirtualMachineTemplate processes a template for a virtual machine
-func (p *TemplateProcessor) ProcessVirtualMachineTemplate(vm storage.VirtualMachine) (string, error) {
+// Result is the output of processing a template
+type Result struct {
+	Artifacts storage.Artifacts // rendered content by artifact name
+	Revision  int64             // revision of the template that was rendered
+}
+
+// ProcessVirtualMachineTemplate processes a template for a virtual machine.
+// Parameters that do not match the template schema are reported as validation.Errors,
+// and templates exceeding the render limits fail with ErrRenderTimeout,
+// ErrOutputTooLarge or ErrNestingTooDeep.
+func (p *TemplateProcessor) ProcessVirtualMachineTemplate(ctx context.Context, vm storage.VirtualMachine) (Result, error) {
+	return p.ProcessVirtualMachineTemplateRevision(ctx, vm, 0)
+}
+
+// ProcessVirtualMachineTemplateRevision processes a revision of the template of a
+// virtual machine. Revision zero is the current revision.
+func (p *TemplateProcessor) ProcessVirtualMachineTemplateRevision(ctx context.Context, vm storage.VirtualMachine, revision int64) (Result, error) {
 	// Get the template
-	tmpl, err := p.storage.GetTemplate(vm.TemplateID)
+	tmpl, err := p.getTemplate(vm.TemplateID, revision)
 	if err != nil {
-		return "", fmt.Errorf("failed to get template: %w", err)
+		return Result{}, err
 	}
 
 	// Check if the template is for VMs
 	if tmpl.Type != "vm" {
-		return "", fmt.Errorf("template is not for virtual machines")
+		return Result{}, fmt.Errorf("template is not for virtual machines")
+	}
+
+	// Check the parameters against the template schema
+	parameters, errs := validation.ResolveParameters(tmpl.Parameters, vm.Parameters)
+	if errs.HasErrors() {
+		return Result{}, errs
 	}
 
 	// Create a template data map
 	data := map[string]interface{}{
-		"Name":   vm.Name,
-		"CPU":    vm.CPU,
-		"Memory": vm.Memory,
-		"OS":     vm.OS,
+		"Name":       vm.Name,
+		"CPU":        vm.CPU,
+		"Memory":     vm.Memory,
+		"OS":         vm.OS,
+		"Parameters": parameters,
 	}
 
 	// Process the template
-	return p.processTemplate(tmpl.RawTemplate, data)
+	artifacts, err := p.processTemplate(ctx, tmpl, data)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{Artifacts: artifacts, Revision: tmpl.Revision}, nil
+}
+
+// ProcessKubernetesClusterTemplate processes a template for a Kubernetes cluster.
+// Parameters that do not match the template schema are reported as validation.Errors,
+// and templates exceeding the render limits fail with ErrRenderTimeout,
+// ErrOutputTooLarge or ErrNestingTooDeep.
+func (p *TemplateProcessor) ProcessKubernetesClusterTemplate(ctx context.Context, cluster storage.KubernetesCluster) (Result, error) {
+	return p.ProcessKubernetesClusterTemplateRevision(ctx, cluster, 0)
 }
 
-// ProcessKubernetesClusterTemplate processes a template for a Kubernetes cluster
-func (p *TemplateProcessor) ProcessKubernetesClusterTemplate(cluster storage.KubernetesCluster) (string, error) {
+// ProcessKubernetesClusterTemplateRevision processes a revision of the template of a
+// Kubernetes cluster. Revision zero is the current revision.
+func (p *TemplateProcessor) ProcessKubernetesClusterTemplateRevision(ctx context.Context, cluster storage.KubernetesCluster, revision int64) (Result, error) {
 	// Get the template
-	tmpl, err := p.storage.GetTemplate(cluster.TemplateID)
+	tmpl, err := p.getTemplate(cluster.TemplateID, revision)
 	if err != nil {
-		return "", fmt.Errorf("failed to get template: %w", err)
+		return Result{}, err
 	}
 
 	// Check if the template is for Kubernetes clusters
 	if tmpl.Type != "kubernetes" {
-		return "", fmt.Errorf("template is not for Kubernetes clusters")
+		return Result{}, fmt.Errorf("template is not for Kubernetes clusters")
+	}
+
+	// Check the parameters against the template schema
+	parameters, errs := validation.ResolveParameters(tmpl.Parameters, cluster.Parameters)
+	if errs.HasErrors() {
+		return Result{}, errs
 	}
 
 	// Create a template data map
 	data := map[string]interface{}{
-		"Name":      cluster.Name,
-		"Region":    cluster.Region,
-		"NodeCount": cluster.NodeCount,
-		"Version":   cluster.Version,
+		"Name":       cluster.Name,
+		"Region":     cluster.Region,
+		"NodeCount":  cluster.NodeCount,
+		"Version":    cluster.Version,
+		"Parameters": parameters,
 	}
 
 	// Process the template
-	return p.processTemplate(tmpl.RawTemplate, data)
+	artifacts, err := p.processTemplate(ctx, tmpl, data)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{Artifacts: artifacts, Revision: tmpl.Revision}, nil
+}
+
+// getTemplate gets a template at the given revision, or at its current revision if zero
+func (p *TemplateProcessor) getTemplate(id string, revision int64) (storage.Template, error) {
+	if revision == 0 {
+		tmpl, err := p.storage.GetTemplate(id)
+		if err != nil {
+			return storage.Template{}, fmt.Errorf("failed to get template: %w", err)
+		}
+		return tmpl, nil
+	}
+
+	tmplRevision, err := p.storage.GetTemplateRevision(id, revision)
+	if err != nil {
+		return storage.Template{}, fmt.Errorf("failed to get template revision %d: %w", revision, err)
+	}
+	return tmplRevision.Template(), nil
+}
+
+// Invalidate drops the cached parse results of a template, and of the
+// templates calling it if it is a partial. Call it when a template is updated
+// or deleted.
+func (p *TemplateProcessor) Invalidate(templateID string) {
+	p.cache.invalidate(templateID)
 }
 
-// processTemplate processes a template with the given data
-func (p *TemplateProcessor) processTemplate(rawTemplate string, data map[string]interface{}) (string, error) {
-	// Parse the template
-	tmpl, err := template.New("template").Parse(rawTemplate)
+// processTemplate renders every artifact of a template with the given data
+func (p *TemplateProcessor) processTemplate(ctx context.Context, tmpl storage.Template, data map[string]interface{}) (storage.Artifacts, error) {
+	// Get the parsed files, loading the partials they call and parsing them on first use
+	parsed, err := p.cache.get(tmpl, p.Dependencies)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse template: %w", err)
+		return nil, err
 	}
 
-	// Execute the template
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, data); err != nil {
-		return "", fmt.Errorf("failed to execute template: %w", err)
+	// Execute the files within the limits, which apply to all artifacts together
+	ctx, cancel := context.WithTimeout(ctx, p.limits.Timeout)
+	defer cancel()
+
+	artifacts := make(storage.Artifacts, len(parsed))
+	remaining := p.limits.MaxOutputBytes
+	for _, file := range templateFiles(tmpl) {
+		rendered, err := p.execute(ctx, parsed[file.Name].template, data, remaining)
+		if err != nil {
+			return nil, fmt.Errorf("artifact %q: %w", file.Name, err)
+		}
+		remaining -= len(rendered)
+
+		// Check that the output parses in its format
+		format := file.OutputFormat
+		if format == "" {
+			format = tmpl.OutputFormat
+		}
+		rendered, err = checkOutput(format, rendered, tmpl.NormalizeOutput)
+		if err != nil {
+			return nil, fmt.Errorf("artifact %q: %w", file.Name, err)
+		}
+		if err := checkSchema(file.Name, format, rendered, parsed[file.Name].schema); err != nil {
+			return nil, err
+		}
+		artifacts[file.Name] = rendered
 	}
 
-	// Print the result to stdout
-	fmt.Println("Rendered template:")
-	fmt.Println(buf.String())
+	return artifacts, nil
+}
 
-	return buf.String(), nil
+// templateFiles returns the files of a template: the template body as the main
+// artifact, unless it is empty and the template has other files, and the other files
+func templateFiles(tmpl storage.Template) []storage.TemplateFile {
+	files := make([]storage.TemplateFile, 0, len(tmpl.Files)+1)
+	if tmpl.RawTemplate != "" || len(tmpl.Files) == 0 {
+		files = append(files, storage.TemplateFile{
+			Name:         storage.MainArtifact,
+			RawTemplate:  tmpl.RawTemplate,
+			OutputSchema: tmpl.OutputSchema,
+		})
+	}
+	return append(files, tmpl.Files...)
 }
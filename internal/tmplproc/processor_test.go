@@ -0,0 +1,126 @@
+package tmplproc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
+	"github.com/aa1ex/paas-provider/internal/tmplproc"
+)
+
+// benchmarkTemplate is a template of typical size, calling a partial
+const benchmarkTemplate = `name: {{ .Name }}
+cpu: {{ .CPU }}
+memory: {{ .Memory }}
+os: {{ .OS | quote }}
+disks:
+{{- range $i, $size := list 10 20 40 }}
+  - name: {{ printf "disk-%d" $i }}
+    size: {{ $size }}
+{{- end }}
+labels:
+{{- include "labels" . | nindent 2 }}
+`
+
+func BenchmarkProcess(b *testing.B) {
+	s := storage.NewMemoryStorage()
+	templates := []storage.Template{
+		{ID: "labels", Name: "labels", Type: "partial", RawTemplate: "app: {{ .Name }}\nos: {{ .OS }}"},
+		{ID: "vm", Name: "vm", Type: "vm", RawTemplate: benchmarkTemplate, OutputFormat: "yaml"},
+	}
+	for _, template := range templates {
+		if _, err := s.CreateTemplate(template); err != nil {
+			b.Fatalf("CreateTemplate(%q): %v", template.ID, err)
+		}
+	}
+	vm := storage.VirtualMachine{Name: "web", CPU: 2, Memory: 4096, OS: "ubuntu", TemplateID: "vm"}
+
+	b.Run("Cached", func(b *testing.B) {
+		p := tmplproc.NewTemplateProcessor(s, tmplproc.DefaultLimits)
+		for i := 0; i < b.N; i++ {
+			if _, err := p.ProcessVirtualMachineTemplate(context.Background(), vm); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Uncached", func(b *testing.B) {
+		p := tmplproc.NewTemplateProcessor(s, tmplproc.DefaultLimits)
+		for i := 0; i < b.N; i++ {
+			p.Invalidate(vm.TemplateID)
+			if _, err := p.ProcessVirtualMachineTemplate(context.Background(), vm); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// countingStorage counts the partials listings of a storage, which only
+// happen when a template is parsed
+type countingStorage struct {
+	storage.Storage
+	listings int
+}
+
+func (s *countingStorage) ListTemplates(templateType string, opts storage.ListOptions) ([]storage.Template, string, error) {
+	if templateType == "partial" {
+		s.listings++
+	}
+	return s.Storage.ListTemplates(templateType, opts)
+}
+
+func TestProcessCache(t *testing.T) {
+	s := &countingStorage{Storage: storage.NewMemoryStorage()}
+	templates := []storage.Template{
+		{ID: "labels", Name: "labels", Type: "partial", RawTemplate: "app={{ .Name }}"},
+		{ID: "a", Name: "a", Type: "vm", RawTemplate: `a {{ include "labels" . }}`},
+		{ID: "b", Name: "b", Type: "vm", RawTemplate: "b {{ .Name }}"},
+		{ID: "c", Name: "c", Type: "vm", RawTemplate: "c {{ .Name }}"},
+	}
+	for _, template := range templates {
+		if _, err := s.CreateTemplate(template); err != nil {
+			t.Fatalf("CreateTemplate(%q): %v", template.ID, err)
+		}
+	}
+	p := tmplproc.NewTemplateProcessor(s, tmplproc.Limits{MaxCachedTemplates: 2})
+
+	// render renders a template and checks whether it was parsed
+	render := func(templateID, want string, wantParsed bool) {
+		t.Helper()
+		before := s.listings
+		result, err := p.ProcessVirtualMachineTemplate(context.Background(), storage.VirtualMachine{Name: "web", TemplateID: templateID})
+		if err != nil {
+			t.Fatalf("ProcessVirtualMachineTemplate(%s): %v", templateID, err)
+		}
+		if got := result.Artifacts[storage.MainArtifact]; got != want {
+			t.Errorf("%s rendered %q, want %q", templateID, got, want)
+		}
+		if parsed := s.listings > before; parsed != wantParsed {
+			t.Errorf("%s parsed = %v, want %v", templateID, parsed, wantParsed)
+		}
+	}
+
+	render("a", "a app=web", true)
+	render("a", "a app=web", false)
+
+	// Changing a partial drops the templates that call it
+	partial, err := s.GetTemplate("labels")
+	if err != nil {
+		t.Fatalf("GetTemplate: %v", err)
+	}
+	partial.RawTemplate = "name={{ .Name }}"
+	if _, err := s.UpdateTemplate(partial); err != nil {
+		t.Fatalf("UpdateTemplate: %v", err)
+	}
+	p.Invalidate(partial.ID)
+	render("a", "a name=web", true)
+	render("b", "b web", true)
+	p.Invalidate("labels")
+	render("b", "b web", false)
+
+	// The least recently used template is evicted beyond the cache size
+	render("a", "a name=web", true)
+	render("c", "c web", true)
+	render("a", "a name=web", false)
+	render("b", "b web", true)
+}
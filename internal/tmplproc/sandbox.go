@@ -0,0 +1,292 @@
+package tmplproc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"text/template"
+	"text/template/parse"
+	"time"
+)
+
+var (
+	// ErrRenderTimeout is returned when rendering does not finish before the deadline
+	ErrRenderTimeout = errors.New("template rendering timed out")
+	// ErrOutputTooLarge is returned when a template produces more output than allowed
+	ErrOutputTooLarge = errors.New("template output is too large")
+	// ErrNestingTooDeep is returned when a template nests blocks, template calls and includes too deeply
+	ErrNestingTooDeep = errors.New("template nesting is too deep")
+)
+
+// Limits bounds the resources a template may use while rendering, and the
+// number of parsed templates kept in memory between renders.
+// Zero fields take the value from DefaultLimits.
+type Limits struct {
+	Timeout            time.Duration // maximum rendering time, on top of the request deadline
+	MaxOutputBytes     int           // maximum size of the rendered output
+	MaxDepth           int           // maximum nesting of if, range and with blocks, template calls and includes
+	MaxCachedTemplates int           // maximum number of parsed template revisions kept for later renders
+}
+
+// DefaultLimits are the limits used when none are configured
+var DefaultLimits = Limits{
+	Timeout:            5 * time.Second,
+	MaxOutputBytes:     1 << 20,
+	MaxDepth:           32,
+	MaxCachedTemplates: 256,
+}
+
+// withDefaults fills zero limits from DefaultLimits
+func (l Limits) withDefaults() Limits {
+	if l.Timeout <= 0 {
+		l.Timeout = DefaultLimits.Timeout
+	}
+	if l.MaxOutputBytes <= 0 {
+		l.MaxOutputBytes = DefaultLimits.MaxOutputBytes
+	}
+	if l.MaxDepth <= 0 {
+		l.MaxDepth = DefaultLimits.MaxDepth
+	}
+	if l.MaxCachedTemplates <= 0 {
+		l.MaxCachedTemplates = DefaultLimits.MaxCachedTemplates
+	}
+	return l
+}
+
+// execute renders a parsed template until ctx is done, writing at most maxBytes.
+// The template runs on a clone of its set with include and the deadline checks
+// bound to ctx, so it stops at its next write, include or range iteration once
+// ctx is done. include also counts its own nesting, which the static check
+// cannot see for names computed while rendering, and the functions that build
+// strings are bounded by the output limit. The template runs in its own
+// goroutine so that the caller is not kept waiting for a single slow function call.
+func (p *TemplateProcessor) execute(ctx context.Context, tmpl *template.Template, data map[string]interface{}, maxBytes int) (string, error) {
+	if depth := nestingDepth(tmpl, p.limits.MaxDepth); depth > p.limits.MaxDepth {
+		return "", fmt.Errorf("%w: more than %d levels", ErrNestingTooDeep, p.limits.MaxDepth)
+	}
+
+	tmpl, err := tmpl.Clone()
+	if err != nil {
+		return "", fmt.Errorf("failed to execute template: %w", err)
+	}
+	includeDepth := 0
+	tmpl.Funcs(template.FuncMap{
+		checkDeadlineFunc: func() (string, error) {
+			if ctx.Err() != nil {
+				return "", contextError(ctx)
+			}
+			return "", nil
+		},
+		"include": func(name string, data any) (string, error) {
+			// Each include executes its template afresh, so text/template does
+			// not count it towards its own depth limit
+			if ctx.Err() != nil {
+				return "", contextError(ctx)
+			}
+			if includeDepth >= p.limits.MaxDepth {
+				return "", ErrNestingTooDeep
+			}
+			includeDepth++
+			defer func() { includeDepth-- }()
+
+			w := &limitedWriter{ctx: ctx, max: p.limits.MaxOutputBytes}
+			if err := tmpl.ExecuteTemplate(w, name, data); err != nil {
+				return "", err
+			}
+			return w.buf.String(), nil
+		},
+	})
+	tmpl.Funcs(sizedFunctions(p.limits.MaxOutputBytes))
+
+	w := &limitedWriter{ctx: ctx, max: maxBytes}
+	done := make(chan error, 1)
+	go func() {
+		done <- tmpl.Execute(w, data)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			if ctx.Err() != nil {
+				return "", contextError(ctx)
+			}
+			if errors.Is(err, ErrOutputTooLarge) {
+				return "", fmt.Errorf("%w: more than %d bytes", ErrOutputTooLarge, p.limits.MaxOutputBytes)
+			}
+			if errors.Is(err, ErrNestingTooDeep) {
+				return "", fmt.Errorf("%w: more than %d levels", ErrNestingTooDeep, p.limits.MaxDepth)
+			}
+			return "", fmt.Errorf("failed to execute template: %w", err)
+		}
+		return w.buf.String(), nil
+	case <-ctx.Done():
+		return "", contextError(ctx)
+	}
+}
+
+// checkDeadlineFunc is the function checkRanges calls at the start of each
+// range iteration. text/template has no other hook into a loop that neither
+// writes nor calls functions, such as {{range 100000000000}}{{end}}.
+const checkDeadlineFunc = "checkDeadline"
+
+// checkRanges makes every range loop in a template set call checkDeadlineFunc
+// at the start of each iteration
+func checkRanges(tmpl *template.Template) {
+	for _, t := range tmpl.Templates() {
+		if t.Tree != nil {
+			checkRangesIn(t.Tree.Root)
+		}
+	}
+}
+
+// checkRangesIn adds the deadline check to the range loops below a node
+func checkRangesIn(node parse.Node) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			checkRangesIn(child)
+		}
+	case *parse.IfNode:
+		checkRangesIn(n.List)
+		checkRangesIn(n.ElseList)
+	case *parse.WithNode:
+		checkRangesIn(n.List)
+		checkRangesIn(n.ElseList)
+	case *parse.RangeNode:
+		checkRangesIn(n.List)
+		checkRangesIn(n.ElseList)
+		check := &parse.ActionNode{
+			NodeType: parse.NodeAction,
+			Pos:      n.Pos,
+			Line:     n.Line,
+			Pipe: &parse.PipeNode{
+				NodeType: parse.NodePipe,
+				Pos:      n.Pos,
+				Line:     n.Line,
+				Cmds: []*parse.CommandNode{{
+					NodeType: parse.NodeCommand,
+					Pos:      n.Pos,
+					Args:     []parse.Node{parse.NewIdentifier(checkDeadlineFunc).SetPos(n.Pos)},
+				}},
+			},
+		}
+		n.List.Nodes = append([]parse.Node{check}, n.List.Nodes...)
+	}
+}
+
+// contextError describes why rendering was stopped by the context
+func contextError(ctx context.Context) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return ErrRenderTimeout
+	}
+	return fmt.Errorf("template rendering was cancelled: %w", ctx.Err())
+}
+
+// limitedWriter collects template output up to a maximum size and
+// fails writes once its context is done
+type limitedWriter struct {
+	ctx context.Context
+	max int
+	buf bytes.Buffer
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if w.ctx.Err() != nil {
+		return 0, contextError(w.ctx)
+	}
+	if w.buf.Len()+len(p) > w.max {
+		return 0, ErrOutputTooLarge
+	}
+	return w.buf.Write(p)
+}
+
+// nestingDepth returns the deepest nesting of if, range and with blocks,
+// template calls and includes in a template, following the calls into the
+// templates of its set. Recursive calls are treated as unbounded, and the walk
+// stops as soon as the depth exceeds limit, which is then returned as limit+1.
+func nestingDepth(tmpl *template.Template, limit int) int {
+	d := &depthCounter{
+		tmpl:     tmpl,
+		limit:    limit,
+		depths:   make(map[string]int),
+		visiting: make(map[string]bool),
+	}
+	return d.template(tmpl.Name())
+}
+
+// depthCounter memoizes the nesting depth of each named template
+type depthCounter struct {
+	tmpl     *template.Template
+	limit    int
+	depths   map[string]int
+	visiting map[string]bool
+}
+
+// template returns the nesting depth of a named template
+func (d *depthCounter) template(name string) int {
+	if depth, ok := d.depths[name]; ok {
+		return depth
+	}
+	if d.visiting[name] {
+		return d.limit + 1
+	}
+
+	t := d.tmpl.Lookup(name)
+	if t == nil || t.Tree == nil {
+		return 0
+	}
+	d.visiting[name] = true
+	depth := d.node(t.Tree.Root)
+	delete(d.visiting, name)
+	d.depths[name] = depth
+	return depth
+}
+
+// node returns the nesting depth below a node
+func (d *depthCounter) node(node parse.Node) int {
+	var depth int
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return 0
+		}
+		for _, child := range n.Nodes {
+			depth = max(depth, d.node(child))
+			if depth > d.limit {
+				break
+			}
+		}
+	case *parse.ActionNode:
+		depth = d.node(n.Pipe)
+	case *parse.IfNode:
+		depth = d.branch(&n.BranchNode)
+	case *parse.RangeNode:
+		depth = d.branch(&n.BranchNode)
+	case *parse.WithNode:
+		depth = d.branch(&n.BranchNode)
+	case *parse.TemplateNode:
+		depth = max(1+d.template(n.Name), d.node(n.Pipe))
+	case *parse.PipeNode:
+		if n == nil {
+			return 0
+		}
+		for _, cmd := range n.Cmds {
+			if name, ok := includeName(cmd); ok {
+				depth = max(depth, 1+d.template(name))
+			}
+			for _, arg := range cmd.Args {
+				depth = max(depth, d.node(arg))
+			}
+		}
+	}
+	return min(depth, d.limit+1)
+}
+
+// branch returns the nesting depth of an if, range or with block
+func (d *depthCounter) branch(n *parse.BranchNode) int {
+	return max(d.node(n.Pipe), 1+max(d.node(n.List), d.node(n.ElseList)))
+}
@@ -0,0 +1,168 @@
+package tmplproc_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
+	"github.com/aa1ex/paas-provider/internal/tmplproc"
+)
+
+// render stores a virtual machine template with the given body and partials
+// and renders it for a virtual machine
+func render(t *testing.T, limits tmplproc.Limits, rawTemplate string, partials ...storage.Template) (storage.Artifacts, error) {
+	t.Helper()
+	s := storage.NewMemoryStorage()
+	for _, partial := range partials {
+		partial.Type = "partial"
+		if _, err := s.CreateTemplate(partial); err != nil {
+			t.Fatalf("CreateTemplate(%q): %v", partial.ID, err)
+		}
+	}
+	if _, err := s.CreateTemplate(storage.Template{ID: "vm", Name: "vm", Type: "vm", RawTemplate: rawTemplate, OutputFormat: "plain"}); err != nil {
+		t.Fatalf("CreateTemplate: %v", err)
+	}
+
+	result, err := tmplproc.NewTemplateProcessor(s, limits).ProcessVirtualMachineTemplate(context.Background(), storage.VirtualMachine{
+		Name:       "web",
+		TemplateID: "vm",
+	})
+	return result.Artifacts, err
+}
+
+func TestLimits(t *testing.T) {
+	tests := []struct {
+		name        string
+		limits      tmplproc.Limits
+		rawTemplate string
+		partials    []storage.Template
+		want        error
+	}{
+		{
+			name:        "range without output runs into the timeout",
+			limits:      tmplproc.Limits{Timeout: 50 * time.Millisecond},
+			rawTemplate: "{{range 100000000000}}{{end}}",
+			want:        tmplproc.ErrRenderTimeout,
+		},
+		{
+			name:        "nested ranges run into the timeout",
+			limits:      tmplproc.Limits{Timeout: 50 * time.Millisecond},
+			rawTemplate: "{{range 100000}}{{range 100000}}{{end}}{{end}}",
+			want:        tmplproc.ErrRenderTimeout,
+		},
+		{
+			name:        "range in a partial runs into the timeout",
+			limits:      tmplproc.Limits{Timeout: 50 * time.Millisecond},
+			rawTemplate: `{{include "loop" .}}`,
+			partials:    []storage.Template{{ID: "loop", Name: "loop", RawTemplate: "{{range 100000000000}}{{end}}"}},
+			want:        tmplproc.ErrRenderTimeout,
+		},
+		{
+			name:        "output beyond the limit",
+			limits:      tmplproc.Limits{MaxOutputBytes: 100},
+			rawTemplate: "{{range 101}}x{{end}}",
+			want:        tmplproc.ErrOutputTooLarge,
+		},
+		{
+			name:        "include output beyond the limit",
+			limits:      tmplproc.Limits{MaxOutputBytes: 100},
+			rawTemplate: `{{$x := include "big" .}}`,
+			partials:    []storage.Template{{ID: "big", Name: "big", RawTemplate: "{{range 101}}x{{end}}"}},
+			want:        tmplproc.ErrOutputTooLarge,
+		},
+		{
+			name:        "repeat beyond the limit",
+			limits:      tmplproc.Limits{MaxOutputBytes: 100},
+			rawTemplate: `{{$x := repeat 51 "ab"}}`,
+			want:        tmplproc.ErrOutputTooLarge,
+		},
+		{
+			name:        "indent beyond the limit",
+			limits:      tmplproc.Limits{MaxOutputBytes: 100},
+			rawTemplate: `{{$x := indent 1000000000 "a"}}`,
+			want:        tmplproc.ErrOutputTooLarge,
+		},
+		{
+			name:        "replace beyond the limit",
+			rawTemplate: `{{$x := replace "" (repeat 1000 "yyyyyyyyyy") (repeat 10000 "x")}}ok`,
+			want:        tmplproc.ErrOutputTooLarge,
+		},
+		{
+			name:        "nested replace beyond the limit",
+			limits:      tmplproc.Limits{MaxOutputBytes: 100},
+			rawTemplate: `{{$x := replace "a" "aa" (replace "a" "aa" (replace "a" "aa" (repeat 20 "a")))}}`,
+			want:        tmplproc.ErrOutputTooLarge,
+		},
+		{
+			name:        "join beyond the limit",
+			limits:      tmplproc.Limits{MaxOutputBytes: 100},
+			rawTemplate: `{{$x := join (repeat 60 "-") (splitList "," "a,b,c")}}`,
+			want:        tmplproc.ErrOutputTooLarge,
+		},
+		{
+			name:        "splitList beyond the limit",
+			limits:      tmplproc.Limits{MaxOutputBytes: 100},
+			rawTemplate: `{{$x := splitList "" (repeat 101 "a")}}`,
+			want:        tmplproc.ErrOutputTooLarge,
+		},
+		{
+			name:        "include with a computed name recursing",
+			limits:      tmplproc.Limits{Timeout: 200 * time.Millisecond},
+			rawTemplate: `{{define "x"}}{{include (print "x") .}}{{end}}{{include (print "x") .}}`,
+			want:        tmplproc.ErrNestingTooDeep,
+		},
+		{
+			name:        "nesting beyond the limit",
+			limits:      tmplproc.Limits{MaxDepth: 2},
+			rawTemplate: "{{if true}}{{if true}}{{if true}}x{{end}}{{end}}{{end}}",
+			want:        tmplproc.ErrNestingTooDeep,
+		},
+	}
+
+	sentinels := []error{tmplproc.ErrRenderTimeout, tmplproc.ErrOutputTooLarge, tmplproc.ErrNestingTooDeep}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := render(t, tt.limits, tt.rawTemplate, tt.partials...)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("got error %v, want %v", err, tt.want)
+			}
+			for _, other := range sentinels {
+				if other != tt.want && errors.Is(err, other) {
+					t.Errorf("error %v also matches %v", err, other)
+				}
+			}
+		})
+	}
+}
+
+func TestLimitsAllowRendersWithin(t *testing.T) {
+	artifacts, err := render(t, tmplproc.Limits{Timeout: time.Second, MaxOutputBytes: 100, MaxDepth: 3},
+		`{{range 3}}{{if true}}{{include "name" $}}{{end}}{{end}}`,
+		storage.Template{ID: "name", Name: "name", RawTemplate: "{{.Name}} "})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if got, want := artifacts[storage.MainArtifact], "web web web"; strings.TrimSpace(got) != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestTimeoutStopsRendering(t *testing.T) {
+	before := runtime.NumGoroutine()
+	if _, err := render(t, tmplproc.Limits{Timeout: 50 * time.Millisecond}, "{{range 100000000000}}{{end}}"); !errors.Is(err, tmplproc.ErrRenderTimeout) {
+		t.Fatalf("got error %v, want %v", err, tmplproc.ErrRenderTimeout)
+	}
+
+	// The render goroutine must end instead of looping in the background
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d goroutines still running after the timeout, %d before rendering", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
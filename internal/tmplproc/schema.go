@@ -0,0 +1,183 @@
+package tmplproc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"gopkg.in/yaml.v3"
+
+	"github.com/aa1ex/paas-provider/internal/validation"
+)
+
+// schemaURL is the URL output schemas are compiled under. Schemas cannot load
+// other documents, so references must stay within the schema.
+const schemaURL = "mem:///output-schema.json"
+
+// compileSchema compiles an output schema written as JSON or YAML. An empty
+// schema compiles to nil.
+func compileSchema(rawSchema string) (*jsonschema.Schema, error) {
+	if strings.TrimSpace(rawSchema) == "" {
+		return nil, nil
+	}
+
+	var value any
+	if err := yaml.Unmarshal([]byte(rawSchema), &value); err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+	data, err := json.Marshal(toJSONValue(value))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("schema cannot load %q", url)
+	}
+	if err := compiler.AddResource(schemaURL, bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	schema, err := compiler.Compile(schemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	return schema, nil
+}
+
+// checkSchema validates rendered output against a schema. The output is a
+// JSON value or a stream of YAML documents, each validated on its own.
+// Violations are returned as validation.Errors with fields of the form
+// "<artifact>#<JSON pointer>", or "<artifact>[<document>]#<JSON pointer>"
+// for output with several YAML documents.
+func checkSchema(artifact, format, rendered string, schema *jsonschema.Schema) error {
+	if schema == nil {
+		return nil
+	}
+
+	documents, err := outputDocuments(format, rendered)
+	if err != nil {
+		return err
+	}
+
+	var errs validation.Errors
+	for i, document := range documents {
+		prefix := artifact
+		if len(documents) > 1 {
+			prefix = fmt.Sprintf("%s[%d]", artifact, i)
+		}
+
+		err := schema.Validate(document)
+		var validationErr *jsonschema.ValidationError
+		if !errors.As(err, &validationErr) {
+			if err != nil {
+				return fmt.Errorf("failed to validate output: %w", err)
+			}
+			continue
+		}
+		for _, violation := range schemaViolations(validationErr) {
+			errs.Add(prefix+"#"+violation.InstanceLocation, violation.Message)
+		}
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+// outputDocuments decodes rendered output into JSON values
+func outputDocuments(format, rendered string) ([]any, error) {
+	switch format {
+	case FormatJSON:
+		var document any
+		dec := json.NewDecoder(strings.NewReader(rendered))
+		dec.UseNumber()
+		if err := dec.Decode(&document); err != nil {
+			return nil, fmt.Errorf("failed to decode output: %w", err)
+		}
+		return []any{document}, nil
+	case FormatCloudConfig:
+		_, body, _ := strings.Cut(rendered, "\n")
+		rendered = body
+	case FormatYAML:
+	default:
+		return nil, fmt.Errorf("output format %q cannot be validated against a schema", format)
+	}
+
+	var documents []any
+	dec := yaml.NewDecoder(strings.NewReader(rendered))
+	for {
+		var document any
+		err := dec.Decode(&document)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode output: %w", err)
+		}
+		// Skip empty documents, e.g. after a trailing "---"
+		if document != nil {
+			documents = append(documents, toJSONValue(document))
+		}
+	}
+	if len(documents) == 0 {
+		return []any{nil}, nil
+	}
+	return documents, nil
+}
+
+// schemaViolations returns the innermost causes of a validation error, sorted
+// by location, which are the violations a template author has to fix
+func schemaViolations(err *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	var violations []*jsonschema.ValidationError
+	var walk func(*jsonschema.ValidationError)
+	walk = func(err *jsonschema.ValidationError) {
+		if len(err.Causes) == 0 {
+			violations = append(violations, err)
+			return
+		}
+		for _, cause := range err.Causes {
+			walk(cause)
+		}
+	}
+	walk(err)
+
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].InstanceLocation < violations[j].InstanceLocation
+	})
+	return violations
+}
+
+// toJSONValue converts a decoded YAML value to the types of decoded JSON,
+// turning non-string keys and timestamps into strings
+func toJSONValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = toJSONValue(item)
+		}
+		return v
+	case map[any]any:
+		converted := make(map[string]any, len(v))
+		for key, item := range v {
+			converted[fmt.Sprint(key)] = toJSONValue(item)
+		}
+		return converted
+	case []any:
+		for i, item := range v {
+			v[i] = toJSONValue(item)
+		}
+		return v
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case nil, bool, string, int, int64, uint64, float64:
+		return v
+	}
+	return fmt.Sprint(value)
+}
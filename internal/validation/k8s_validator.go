@@ -4,6 +4,9 @@ import (
 	v1 "github.com/aa1ex/paas-provider/pkg/api/grpc/kubernetes_cluster/v1"
 )
 
+// KubernetesClusterUpdateMaskFields lists the Kubernetes cluster fields that can be used in an update mask
+var KubernetesClusterUpdateMaskFields = []string{"name", "region", "node_count", "version", "template_id", "parameters"}
+
 // ValidateCreateKubernetesClusterRequest validates a CreateKubernetesClusterRequest
 func ValidateCreateKubernetesClusterRequest(req *v1.CreateKubernetesClusterRequest) Errors {
 	var errors Errors
@@ -34,13 +37,27 @@ func ValidateUpdateKubernetesClusterRequest(req *v1.UpdateKubernetesClusterReque
 	}
 
 	cluster := req.KubernetesCluster
+	paths := req.GetUpdateMask().GetPaths()
 	ValidateRequired("id", cluster.Id, &errors)
-	ValidateRequired("name", cluster.Name, &errors)
-	ValidateRequired("region", cluster.Region, &errors)
-	ValidateMinInt("node_count", cluster.NodeCount, 1, &errors)
-	ValidateMaxInt("node_count", cluster.NodeCount, 100, &errors)
-	ValidateRequired("version", cluster.Version, &errors)
-	ValidateRequired("template_id", cluster.TemplateId, &errors)
+	ValidateFieldMask(paths, KubernetesClusterUpdateMaskFields, &errors)
+
+	// Only the fields covered by the update mask are validated
+	if InFieldMask(paths, "name") {
+		ValidateRequired("name", cluster.Name, &errors)
+	}
+	if InFieldMask(paths, "region") {
+		ValidateRequired("region", cluster.Region, &errors)
+	}
+	if InFieldMask(paths, "node_count") {
+		ValidateMinInt("node_count", cluster.NodeCount, 1, &errors)
+		ValidateMaxInt("node_count", cluster.NodeCount, 100, &errors)
+	}
+	if InFieldMask(paths, "version") {
+		ValidateRequired("version", cluster.Version, &errors)
+	}
+	if InFieldMask(paths, "template_id") {
+		ValidateRequired("template_id", cluster.TemplateId, &errors)
+	}
 
 	return errors
 }
@@ -59,6 +76,20 @@ func ValidateGetKubernetesClusterRequest(req *v1.GetKubernetesClusterRequest) Er
 	return errors
 }
 
+// ValidateListKubernetesClustersRequest validates a ListKubernetesClustersRequest
+func ValidateListKubernetesClustersRequest(req *v1.ListKubernetesClustersRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidatePageSize(req.PageSize, &errors)
+
+	return errors
+}
+
 // ValidateDeleteKubernetesClusterRequest validates a DeleteKubernetesClusterRequest
 func ValidateDeleteKubernetesClusterRequest(req *v1.DeleteKubernetesClusterRequest) Errors {
 	var errors Errors
@@ -93,3 +124,35 @@ func ValidateGetKubernetesClusterKubeconfigRequest(req *v1.GetKubernetesClusterK
 	return errors
 }
 */
+
+// ValidateKubernetesClusterGetRenderedArtifactRequest validates a GetRenderedArtifactRequest for a Kubernetes cluster
+func ValidateKubernetesClusterGetRenderedArtifactRequest(req *v1.GetRenderedArtifactRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("id", req.Id, &errors)
+	ValidateRequired("name", req.Name, &errors)
+
+	return errors
+}
+
+// ValidateKubernetesClusterExportRenderedArtifactsRequest validates an ExportRenderedArtifactsRequest for a Kubernetes cluster
+func ValidateKubernetesClusterExportRenderedArtifactsRequest(req *v1.ExportRenderedArtifactsRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("id", req.Id, &errors)
+	if _, ok := v1.ExportRenderedArtifactsRequest_Format_name[int32(req.Format)]; !ok {
+		errors.Add("format", "is not a known archive format")
+	}
+
+	return errors
+}
@@ -0,0 +1,74 @@
+package validation
+
+import (
+	"fmt"
+	"time"
+
+	v1 "github.com/aa1ex/paas-provider/pkg/api/grpc/operations/v1"
+)
+
+// MaxOperationWaitTimeout is the longest and default timeout of WaitOperation
+const MaxOperationWaitTimeout = 60 * time.Second
+
+// ValidateGetOperationRequest validates a GetOperationRequest
+func ValidateGetOperationRequest(req *v1.GetOperationRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("id", req.Id, &errors)
+
+	return errors
+}
+
+// ValidateListOperationsRequest validates a ListOperationsRequest
+func ValidateListOperationsRequest(req *v1.ListOperationsRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidatePageSize(req.PageSize, &errors)
+
+	return errors
+}
+
+// ValidateCancelOperationRequest validates a CancelOperationRequest
+func ValidateCancelOperationRequest(req *v1.CancelOperationRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("id", req.Id, &errors)
+
+	return errors
+}
+
+// ValidateWaitOperationRequest validates a WaitOperationRequest
+func ValidateWaitOperationRequest(req *v1.WaitOperationRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("id", req.Id, &errors)
+	if req.Timeout != nil {
+		if err := req.Timeout.CheckValid(); err != nil {
+			errors.Add("timeout", err.Error())
+		} else if timeout := req.Timeout.AsDuration(); timeout < 0 || timeout > MaxOperationWaitTimeout {
+			errors.Add("timeout", fmt.Sprintf("must be between 0 and %s", MaxOperationWaitTimeout))
+		}
+	}
+
+	return errors
+}
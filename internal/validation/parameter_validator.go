@@ -0,0 +1,184 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
+	v1 "github.com/aa1ex/paas-provider/pkg/api/grpc/template/v1"
+)
+
+// parameterNamePattern matches names that can be used as {{ .Parameters.<name> }}
+var parameterNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ValidateParameterSchema validates the parameter declarations of a template
+func ValidateParameterSchema(parameters []*v1.Parameter, errors *Errors) {
+	seen := make(map[string]bool, len(parameters))
+	for i, parameter := range parameters {
+		field := fmt.Sprintf("parameters[%d]", i)
+
+		if !parameterNamePattern.MatchString(parameter.Name) {
+			errors.Add(field+".name", "must start with a letter or underscore and contain only letters, digits and underscores")
+		} else if seen[parameter.Name] {
+			errors.Add(field+".name", fmt.Sprintf("duplicate parameter %q", parameter.Name))
+		}
+		seen[parameter.Name] = true
+
+		parameterType := parameterTypeName(parameter.Type)
+		if parameterType == "" {
+			errors.Add(field+".type", "must be specified")
+			continue
+		}
+		if parameterType == "boolean" && (parameter.Min != nil || parameter.Max != nil) {
+			errors.Add(field, "min and max are not supported for boolean parameters")
+		}
+		if parameter.Min != nil && parameter.Max != nil && *parameter.Min > *parameter.Max {
+			errors.Add(field+".min", "must not be greater than max")
+		}
+
+		// Allowed values and the default must themselves be valid values
+		for _, value := range parameter.AllowedValues {
+			if _, err := parameterValue(parameterType, nil, parameter.Min, parameter.Max, value); err != nil {
+				errors.Add(field+".allowed_values", err.Error())
+			}
+		}
+		if parameter.DefaultValue != "" {
+			if _, err := parameterValue(parameterType, parameter.AllowedValues, parameter.Min, parameter.Max, parameter.DefaultValue); err != nil {
+				errors.Add(field+".default_value", err.Error())
+			}
+		}
+	}
+}
+
+// ResolveParameters checks the parameter values of a resource against the schema
+// of its template and converts them to the declared types. Parameters that are
+// not set take their default value, or the zero value of their type.
+func ResolveParameters(schema []storage.Parameter, values map[string]string) (map[string]any, Errors) {
+	var errors Errors
+
+	declared := make(map[string]bool, len(schema))
+	resolved := make(map[string]any, len(schema))
+	for _, parameter := range schema {
+		declared[parameter.Name] = true
+		field := "parameters." + parameter.Name
+
+		value, ok := values[parameter.Name]
+		if !ok || value == "" {
+			if parameter.DefaultValue == "" {
+				if parameter.Required {
+					errors.Add(field, "is required")
+					continue
+				}
+				resolved[parameter.Name] = zeroParameterValue(parameter.Type)
+				continue
+			}
+			value = parameter.DefaultValue
+		}
+
+		typed, err := parameterValue(parameter.Type, parameter.AllowedValues, parameter.Min, parameter.Max, value)
+		if err != nil {
+			errors.Add(field, err.Error())
+			continue
+		}
+		resolved[parameter.Name] = typed
+	}
+
+	var undeclared []string
+	for name := range values {
+		if !declared[name] {
+			undeclared = append(undeclared, name)
+		}
+	}
+	sort.Strings(undeclared)
+	for _, name := range undeclared {
+		errors.Add("parameters."+name, "is not declared by the template")
+	}
+
+	return resolved, errors
+}
+
+// parameterValue converts a value to the parameter type and checks it against the constraints
+func parameterValue(parameterType string, allowedValues []string, minV, maxV *float64, value string) (any, error) {
+	if len(allowedValues) > 0 {
+		allowed := false
+		for _, allowedValue := range allowedValues {
+			if value == allowedValue {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return nil, fmt.Errorf("%q is not one of the allowed values", value)
+		}
+	}
+
+	var typed any
+	var size float64
+	switch parameterType {
+	case "string":
+		typed, size = value, float64(utf8.RuneCountInString(value))
+	case "integer":
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", value)
+		}
+		typed, size = i, float64(i)
+	case "number":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", value)
+		}
+		typed, size = f, f
+	case "boolean":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", value)
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf("unknown parameter type %q", parameterType)
+	}
+
+	unit := ""
+	if parameterType == "string" {
+		unit = " characters"
+	}
+	if minV != nil && size < *minV {
+		return nil, fmt.Errorf("must be at least %g%s", *minV, unit)
+	}
+	if maxV != nil && size > *maxV {
+		return nil, fmt.Errorf("must be at most %g%s", *maxV, unit)
+	}
+	return typed, nil
+}
+
+// zeroParameterValue returns the zero value of a parameter type
+func zeroParameterValue(parameterType string) any {
+	switch parameterType {
+	case "integer":
+		return int64(0)
+	case "number":
+		return float64(0)
+	case "boolean":
+		return false
+	}
+	return ""
+}
+
+// parameterTypeName returns the storage name of a parameter type
+func parameterTypeName(parameterType v1.Parameter_Type) string {
+	switch parameterType {
+	case v1.Parameter_TYPE_STRING:
+		return "string"
+	case v1.Parameter_TYPE_INTEGER:
+		return "integer"
+	case v1.Parameter_TYPE_NUMBER:
+		return "number"
+	case v1.Parameter_TYPE_BOOLEAN:
+		return "boolean"
+	}
+	return ""
+}
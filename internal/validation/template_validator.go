@@ -1,9 +1,19 @@
 package validation
 
 import (
+	"fmt"
+	"regexp"
+
+	"github.com/aa1ex/paas-provider/internal/storage"
 	v1 "github.com/aa1ex/paas-provider/pkg/api/grpc/template/v1"
 )
 
+// TemplateUpdateMaskFields lists the template fields that can be used in an update mask
+var TemplateUpdateMaskFields = []string{"name", "type", "raw_template", "parameters", "files", "output_format", "normalize_output", "output_schema", "description"}
+
+// templateFileNamePattern matches artifact names that are safe as archive entries
+var templateFileNamePattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
 // ValidateCreateTemplateRequest validates a CreateTemplateRequest
 func ValidateCreateTemplateRequest(req *v1.CreateTemplateRequest) Errors {
 	var errors Errors
@@ -15,13 +25,19 @@ func ValidateCreateTemplateRequest(req *v1.CreateTemplateRequest) Errors {
 
 	template := req.Template
 	ValidateRequired("name", template.Name, &errors)
-	ValidateRequired("raw_template", template.RawTemplate, &errors)
+	if len(template.Files) == 0 {
+		ValidateRequired("raw_template", template.RawTemplate, &errors)
+	}
 
 	// Validate template type
 	if template.Type == v1.Template_TYPE_UNSPECIFIED {
 		errors.Add("type", "must be specified")
 	}
 
+	ValidateParameterSchema(template.Parameters, &errors)
+	ValidateTemplateFiles(template.Files, &errors)
+	ValidateOutputFormat("output_format", template.OutputFormat, &errors)
+
 	return errors
 }
 
@@ -35,14 +51,31 @@ func ValidateUpdateTemplateRequest(req *v1.UpdateTemplateRequest) Errors {
 	}
 
 	template := req.Template
+	paths := req.GetUpdateMask().GetPaths()
 	ValidateRequired("id", template.Id, &errors)
-	ValidateRequired("name", template.Name, &errors)
-	ValidateRequired("raw_template", template.RawTemplate, &errors)
+	ValidateFieldMask(paths, TemplateUpdateMaskFields, &errors)
+
+	// Only the fields covered by the update mask are validated
+	if InFieldMask(paths, "name") {
+		ValidateRequired("name", template.Name, &errors)
+	}
+	if InFieldMask(paths, "raw_template") && InFieldMask(paths, "files") && len(template.Files) == 0 {
+		ValidateRequired("raw_template", template.RawTemplate, &errors)
+	}
 
 	// Validate template type
-	if template.Type == v1.Template_TYPE_UNSPECIFIED {
+	if InFieldMask(paths, "type") && template.Type == v1.Template_TYPE_UNSPECIFIED {
 		errors.Add("type", "must be specified")
 	}
+	if InFieldMask(paths, "parameters") {
+		ValidateParameterSchema(template.Parameters, &errors)
+	}
+	if InFieldMask(paths, "files") {
+		ValidateTemplateFiles(template.Files, &errors)
+	}
+	if InFieldMask(paths, "output_format") {
+		ValidateOutputFormat("output_format", template.OutputFormat, &errors)
+	}
 
 	return errors
 }
@@ -70,7 +103,8 @@ func ValidateListTemplatesRequest(req *v1.ListTemplatesRequest) Errors {
 		return errors
 	}
 
-	// No specific validation for ListTemplatesRequest as the type field is optional
+	// The type field is optional
+	ValidatePageSize(req.PageSize, &errors)
 
 	return errors
 }
@@ -88,3 +122,176 @@ func ValidateDeleteTemplateRequest(req *v1.DeleteTemplateRequest) Errors {
 
 	return errors
 }
+
+// ValidateListTemplateRevisionsRequest validates a ListTemplateRevisionsRequest
+func ValidateListTemplateRevisionsRequest(req *v1.ListTemplateRevisionsRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("template_id", req.TemplateId, &errors)
+
+	return errors
+}
+
+// ValidateGetTemplateRevisionRequest validates a GetTemplateRevisionRequest
+func ValidateGetTemplateRevisionRequest(req *v1.GetTemplateRevisionRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("template_id", req.TemplateId, &errors)
+	ValidateMinInt64("revision", req.Revision, 1, &errors)
+
+	return errors
+}
+
+// ValidateRollbackTemplateRequest validates a RollbackTemplateRequest
+func ValidateRollbackTemplateRequest(req *v1.RollbackTemplateRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("template_id", req.TemplateId, &errors)
+	ValidateMinInt64("revision", req.Revision, 1, &errors)
+
+	return errors
+}
+
+// ValidateDescribeTemplateRequest validates a DescribeTemplateRequest
+func ValidateDescribeTemplateRequest(req *v1.DescribeTemplateRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	switch source := req.Source.(type) {
+	case *v1.DescribeTemplateRequest_Id:
+		ValidateRequired("id", source.Id, &errors)
+	case *v1.DescribeTemplateRequest_RawTemplate:
+		ValidateRequired("raw_template", source.RawTemplate, &errors)
+	default:
+		errors.Add("source", "either id or raw_template is required")
+	}
+
+	return errors
+}
+
+// ValidateRenderTemplateRequest validates a RenderTemplateRequest
+func ValidateRenderTemplateRequest(req *v1.RenderTemplateRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateMinInt64("revision", req.Revision, 0, &errors)
+
+	switch resource := req.Resource.(type) {
+	case *v1.RenderTemplateRequest_VirtualMachineId:
+		ValidateRequired("virtual_machine_id", resource.VirtualMachineId, &errors)
+	case *v1.RenderTemplateRequest_KubernetesClusterId:
+		ValidateRequired("kubernetes_cluster_id", resource.KubernetesClusterId, &errors)
+	case *v1.RenderTemplateRequest_VirtualMachine:
+		if req.TemplateId == "" && resource.VirtualMachine.GetTemplateId() == "" {
+			errors.Add("template_id", "is required")
+		}
+	case *v1.RenderTemplateRequest_KubernetesCluster:
+		if req.TemplateId == "" && resource.KubernetesCluster.GetTemplateId() == "" {
+			errors.Add("template_id", "is required")
+		}
+	default:
+		errors.Add("resource", "is required")
+	}
+
+	return errors
+}
+
+// ValidateGetTemplateDependenciesRequest validates a GetTemplateDependenciesRequest
+func ValidateGetTemplateDependenciesRequest(req *v1.GetTemplateDependenciesRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("id", req.Id, &errors)
+
+	return errors
+}
+
+// ValidateRerenderResourcesRequest validates a RerenderResourcesRequest
+func ValidateRerenderResourcesRequest(req *v1.RerenderResourcesRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("template_id", req.TemplateId, &errors)
+
+	return errors
+}
+
+// ValidateGetDriftRequest validates a GetDriftRequest
+func ValidateGetDriftRequest(req *v1.GetDriftRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	switch resource := req.Resource.(type) {
+	case *v1.GetDriftRequest_VirtualMachineId:
+		ValidateRequired("virtual_machine_id", resource.VirtualMachineId, &errors)
+	case *v1.GetDriftRequest_KubernetesClusterId:
+		ValidateRequired("kubernetes_cluster_id", resource.KubernetesClusterId, &errors)
+	}
+
+	return errors
+}
+
+// ValidateTemplateFiles validates the additional files of a template
+func ValidateTemplateFiles(files []*v1.TemplateFile, errors *Errors) {
+	seen := make(map[string]bool, len(files))
+	for i, file := range files {
+		field := fmt.Sprintf("files[%d]", i)
+
+		switch {
+		case !templateFileNamePattern.MatchString(file.Name):
+			errors.Add(field+".name", "must contain only letters, digits, '.', '_' and '-'")
+		case file.Name == "." || file.Name == "..":
+			errors.Add(field+".name", "must not be a relative path")
+		case file.Name == storage.MainArtifact:
+			errors.Add(field+".name", fmt.Sprintf("%q is reserved for the template body", storage.MainArtifact))
+		case seen[file.Name]:
+			errors.Add(field+".name", fmt.Sprintf("duplicate file %q", file.Name))
+		}
+		seen[file.Name] = true
+
+		ValidateRequired(field+".raw_template", file.RawTemplate, errors)
+		ValidateOutputFormat(field+".output_format", file.OutputFormat, errors)
+	}
+}
+
+// ValidateOutputFormat validates that an output format is a known value
+func ValidateOutputFormat(field string, format v1.OutputFormat, errors *Errors) {
+	if _, ok := v1.OutputFormat_name[int32(format)]; !ok {
+		errors.Add(field, "is not a known output format")
+	}
+}
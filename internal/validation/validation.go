@@ -64,6 +64,13 @@ func ValidateMaxInt(field string, value, maxV int32, errors *Errors) {
 	}
 }
 
+// ValidateMinInt64 validates that an int64 field is at least min
+func ValidateMinInt64(field string, value, minV int64, errors *Errors) {
+	if value < minV {
+		errors.Add(field, fmt.Sprintf("must be at least %d", minV))
+	}
+}
+
 // ValidateOneOf validates that a string field is one of the allowed values
 func ValidateOneOf(field, value string, allowedValues []string, errors *Errors) {
 	for _, allowedValue := range allowedValues {
@@ -73,3 +80,33 @@ func ValidateOneOf(field, value string, allowedValues []string, errors *Errors)
 	}
 	errors.Add(field, fmt.Sprintf("must be one of: %s", strings.Join(allowedValues, ", ")))
 }
+
+// MaxPageSize is the largest page size accepted by List requests
+const MaxPageSize = 1000
+
+// ValidatePageSize validates the page size of a List request
+func ValidatePageSize(pageSize int32, errors *Errors) {
+	ValidateMinInt("page_size", pageSize, 0, errors)
+	ValidateMaxInt("page_size", pageSize, MaxPageSize, errors)
+}
+
+// ValidateFieldMask validates that every update mask path is one of the allowed fields
+func ValidateFieldMask(paths, allowedFields []string, errors *Errors) {
+	for _, path := range paths {
+		ValidateOneOf("update_mask", path, allowedFields, errors)
+	}
+}
+
+// InFieldMask reports whether a field is covered by the update mask.
+// An empty mask covers every field.
+func InFieldMask(paths []string, field string) bool {
+	if len(paths) == 0 {
+		return true
+	}
+	for _, path := range paths {
+		if path == field {
+			return true
+		}
+	}
+	return false
+}
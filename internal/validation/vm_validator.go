@@ -4,6 +4,9 @@ import (
 	v1 "github.com/aa1ex/paas-provider/pkg/api/grpc/virtual_machine/v1"
 )
 
+// VirtualMachineUpdateMaskFields lists the virtual machine fields that can be used in an update mask
+var VirtualMachineUpdateMaskFields = []string{"name", "cpu", "memory", "os", "template_id", "parameters"}
+
 // ValidateCreateVirtualMachineRequest validates a CreateVirtualMachineRequest
 func ValidateCreateVirtualMachineRequest(req *v1.CreateVirtualMachineRequest) Errors {
 	var errors Errors
@@ -35,14 +38,28 @@ func ValidateUpdateVirtualMachineRequest(req *v1.UpdateVirtualMachineRequest) Er
 	}
 
 	vm := req.VirtualMachine
+	paths := req.GetUpdateMask().GetPaths()
 	ValidateRequired("id", vm.Id, &errors)
-	ValidateRequired("name", vm.Name, &errors)
-	ValidateMinInt("cpu", vm.Cpu, 1, &errors)
-	ValidateMaxInt("cpu", vm.Cpu, 32, &errors)
-	ValidateMinInt("memory", vm.Memory, 512, &errors)
-	ValidateMaxInt("memory", vm.Memory, 65536, &errors)
-	ValidateRequired("os", vm.Os, &errors)
-	ValidateRequired("template_id", vm.TemplateId, &errors)
+	ValidateFieldMask(paths, VirtualMachineUpdateMaskFields, &errors)
+
+	// Only the fields covered by the update mask are validated
+	if InFieldMask(paths, "name") {
+		ValidateRequired("name", vm.Name, &errors)
+	}
+	if InFieldMask(paths, "cpu") {
+		ValidateMinInt("cpu", vm.Cpu, 1, &errors)
+		ValidateMaxInt("cpu", vm.Cpu, 32, &errors)
+	}
+	if InFieldMask(paths, "memory") {
+		ValidateMinInt("memory", vm.Memory, 512, &errors)
+		ValidateMaxInt("memory", vm.Memory, 65536, &errors)
+	}
+	if InFieldMask(paths, "os") {
+		ValidateRequired("os", vm.Os, &errors)
+	}
+	if InFieldMask(paths, "template_id") {
+		ValidateRequired("template_id", vm.TemplateId, &errors)
+	}
 
 	return errors
 }
@@ -61,6 +78,20 @@ func ValidateGetVirtualMachineRequest(req *v1.GetVirtualMachineRequest) Errors {
 	return errors
 }
 
+// ValidateListVirtualMachinesRequest validates a ListVirtualMachinesRequest
+func ValidateListVirtualMachinesRequest(req *v1.ListVirtualMachinesRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidatePageSize(req.PageSize, &errors)
+
+	return errors
+}
+
 // ValidateDeleteVirtualMachineRequest validates a DeleteVirtualMachineRequest
 func ValidateDeleteVirtualMachineRequest(req *v1.DeleteVirtualMachineRequest) Errors {
 	var errors Errors
@@ -74,3 +105,149 @@ func ValidateDeleteVirtualMachineRequest(req *v1.DeleteVirtualMachineRequest) Er
 
 	return errors
 }
+
+// ValidateVirtualMachineGetRenderedArtifactRequest validates a GetRenderedArtifactRequest for a virtual machine
+func ValidateVirtualMachineGetRenderedArtifactRequest(req *v1.GetRenderedArtifactRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("id", req.Id, &errors)
+	ValidateRequired("name", req.Name, &errors)
+
+	return errors
+}
+
+// ValidateVirtualMachineExportRenderedArtifactsRequest validates an ExportRenderedArtifactsRequest for a virtual machine
+func ValidateVirtualMachineExportRenderedArtifactsRequest(req *v1.ExportRenderedArtifactsRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("id", req.Id, &errors)
+	if _, ok := v1.ExportRenderedArtifactsRequest_Format_name[int32(req.Format)]; !ok {
+		errors.Add("format", "is not a known archive format")
+	}
+
+	return errors
+}
+
+// ValidateStartVirtualMachineRequest validates a StartVirtualMachineRequest
+func ValidateStartVirtualMachineRequest(req *v1.StartVirtualMachineRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("id", req.Id, &errors)
+
+	return errors
+}
+
+// ValidateStopVirtualMachineRequest validates a StopVirtualMachineRequest
+func ValidateStopVirtualMachineRequest(req *v1.StopVirtualMachineRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("id", req.Id, &errors)
+
+	return errors
+}
+
+// ValidateRestartVirtualMachineRequest validates a RestartVirtualMachineRequest
+func ValidateRestartVirtualMachineRequest(req *v1.RestartVirtualMachineRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("id", req.Id, &errors)
+
+	return errors
+}
+
+// ValidateSuspendVirtualMachineRequest validates a SuspendVirtualMachineRequest
+func ValidateSuspendVirtualMachineRequest(req *v1.SuspendVirtualMachineRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("id", req.Id, &errors)
+
+	return errors
+}
+
+// ValidateCreateVirtualMachineSnapshotRequest validates a CreateVirtualMachineSnapshotRequest
+func ValidateCreateVirtualMachineSnapshotRequest(req *v1.CreateVirtualMachineSnapshotRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("virtual_machine_id", req.VirtualMachineId, &errors)
+	ValidateRequired("name", req.Name, &errors)
+
+	return errors
+}
+
+// ValidateListVirtualMachineSnapshotsRequest validates a ListVirtualMachineSnapshotsRequest
+func ValidateListVirtualMachineSnapshotsRequest(req *v1.ListVirtualMachineSnapshotsRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("virtual_machine_id", req.VirtualMachineId, &errors)
+	ValidatePageSize(req.PageSize, &errors)
+
+	return errors
+}
+
+// ValidateRestoreVirtualMachineSnapshotRequest validates a RestoreVirtualMachineSnapshotRequest
+func ValidateRestoreVirtualMachineSnapshotRequest(req *v1.RestoreVirtualMachineSnapshotRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("id", req.Id, &errors)
+
+	return errors
+}
+
+// ValidateDeleteVirtualMachineSnapshotRequest validates a DeleteVirtualMachineSnapshotRequest
+func ValidateDeleteVirtualMachineSnapshotRequest(req *v1.DeleteVirtualMachineSnapshotRequest) Errors {
+	var errors Errors
+
+	if req == nil {
+		errors.Add("request", "is required")
+		return errors
+	}
+
+	ValidateRequired("id", req.Id, &errors)
+
+	return errors
+}
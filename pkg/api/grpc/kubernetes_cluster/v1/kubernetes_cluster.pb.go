@@ -9,6 +9,8 @@ package kubernetes_clusterv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,18 +23,213 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type KubernetesCluster_DriftStatus int32
+
+const (
+	KubernetesCluster_DRIFT_STATUS_UNSPECIFIED KubernetesCluster_DriftStatus = 0
+	// rendered_artifacts match what the current template revision produces
+	KubernetesCluster_DRIFT_STATUS_IN_SYNC KubernetesCluster_DriftStatus = 1
+	// The current template revision renders different artifacts
+	KubernetesCluster_DRIFT_STATUS_DRIFTED KubernetesCluster_DriftStatus = 2
+	// The current template revision fails to render for the resource
+	KubernetesCluster_DRIFT_STATUS_RENDER_FAILED KubernetesCluster_DriftStatus = 3
+)
+
+// Enum value maps for KubernetesCluster_DriftStatus.
+var (
+	KubernetesCluster_DriftStatus_name = map[int32]string{
+		0: "DRIFT_STATUS_UNSPECIFIED",
+		1: "DRIFT_STATUS_IN_SYNC",
+		2: "DRIFT_STATUS_DRIFTED",
+		3: "DRIFT_STATUS_RENDER_FAILED",
+	}
+	KubernetesCluster_DriftStatus_value = map[string]int32{
+		"DRIFT_STATUS_UNSPECIFIED":   0,
+		"DRIFT_STATUS_IN_SYNC":       1,
+		"DRIFT_STATUS_DRIFTED":       2,
+		"DRIFT_STATUS_RENDER_FAILED": 3,
+	}
+)
+
+func (x KubernetesCluster_DriftStatus) Enum() *KubernetesCluster_DriftStatus {
+	p := new(KubernetesCluster_DriftStatus)
+	*p = x
+	return p
+}
+
+func (x KubernetesCluster_DriftStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KubernetesCluster_DriftStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_enumTypes[0].Descriptor()
+}
+
+func (KubernetesCluster_DriftStatus) Type() protoreflect.EnumType {
+	return &file_kubernetes_cluster_v1_kubernetes_cluster_proto_enumTypes[0]
+}
+
+func (x KubernetesCluster_DriftStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KubernetesCluster_DriftStatus.Descriptor instead.
+func (KubernetesCluster_DriftStatus) EnumDescriptor() ([]byte, []int) {
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type KubernetesCluster_Status int32
+
+const (
+	KubernetesCluster_STATUS_UNSPECIFIED  KubernetesCluster_Status = 0
+	KubernetesCluster_STATUS_PENDING      KubernetesCluster_Status = 1
+	KubernetesCluster_STATUS_PROVISIONING KubernetesCluster_Status = 2
+	KubernetesCluster_STATUS_RUNNING      KubernetesCluster_Status = 3
+	KubernetesCluster_STATUS_STOPPING     KubernetesCluster_Status = 4
+	KubernetesCluster_STATUS_STOPPED      KubernetesCluster_Status = 5
+	KubernetesCluster_STATUS_UPDATING     KubernetesCluster_Status = 6
+	KubernetesCluster_STATUS_DELETING     KubernetesCluster_Status = 7
+	KubernetesCluster_STATUS_FAILED       KubernetesCluster_Status = 8
+)
+
+// Enum value maps for KubernetesCluster_Status.
+var (
+	KubernetesCluster_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_PENDING",
+		2: "STATUS_PROVISIONING",
+		3: "STATUS_RUNNING",
+		4: "STATUS_STOPPING",
+		5: "STATUS_STOPPED",
+		6: "STATUS_UPDATING",
+		7: "STATUS_DELETING",
+		8: "STATUS_FAILED",
+	}
+	KubernetesCluster_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED":  0,
+		"STATUS_PENDING":      1,
+		"STATUS_PROVISIONING": 2,
+		"STATUS_RUNNING":      3,
+		"STATUS_STOPPING":     4,
+		"STATUS_STOPPED":      5,
+		"STATUS_UPDATING":     6,
+		"STATUS_DELETING":     7,
+		"STATUS_FAILED":       8,
+	}
+)
+
+func (x KubernetesCluster_Status) Enum() *KubernetesCluster_Status {
+	p := new(KubernetesCluster_Status)
+	*p = x
+	return p
+}
+
+func (x KubernetesCluster_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KubernetesCluster_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_enumTypes[1].Descriptor()
+}
+
+func (KubernetesCluster_Status) Type() protoreflect.EnumType {
+	return &file_kubernetes_cluster_v1_kubernetes_cluster_proto_enumTypes[1]
+}
+
+func (x KubernetesCluster_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KubernetesCluster_Status.Descriptor instead.
+func (KubernetesCluster_Status) EnumDescriptor() ([]byte, []int) {
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{0, 1}
+}
+
+type ExportRenderedArtifactsRequest_Format int32
+
+const (
+	ExportRenderedArtifactsRequest_FORMAT_UNSPECIFIED ExportRenderedArtifactsRequest_Format = 0 // same as FORMAT_TAR_GZ
+	ExportRenderedArtifactsRequest_FORMAT_TAR_GZ      ExportRenderedArtifactsRequest_Format = 1
+	ExportRenderedArtifactsRequest_FORMAT_ZIP         ExportRenderedArtifactsRequest_Format = 2
+)
+
+// Enum value maps for ExportRenderedArtifactsRequest_Format.
+var (
+	ExportRenderedArtifactsRequest_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "FORMAT_TAR_GZ",
+		2: "FORMAT_ZIP",
+	}
+	ExportRenderedArtifactsRequest_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"FORMAT_TAR_GZ":      1,
+		"FORMAT_ZIP":         2,
+	}
+)
+
+func (x ExportRenderedArtifactsRequest_Format) Enum() *ExportRenderedArtifactsRequest_Format {
+	p := new(ExportRenderedArtifactsRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ExportRenderedArtifactsRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportRenderedArtifactsRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_enumTypes[2].Descriptor()
+}
+
+func (ExportRenderedArtifactsRequest_Format) Type() protoreflect.EnumType {
+	return &file_kubernetes_cluster_v1_kubernetes_cluster_proto_enumTypes[2]
+}
+
+func (x ExportRenderedArtifactsRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportRenderedArtifactsRequest_Format.Descriptor instead.
+func (ExportRenderedArtifactsRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{16, 0}
+}
+
 // KubernetesCluster represents a Kubernetes cluster configuration
 type KubernetesCluster struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Region           string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
-	NodeCount        int32                  `protobuf:"varint,4,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
-	Version          string                 `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
-	TemplateId       string                 `protobuf:"bytes,6,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
-	RenderedTemplate string                 `protobuf:"bytes,7,opt,name=rendered_template,json=renderedTemplate,proto3" json:"rendered_template,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Region     string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	NodeCount  int32                  `protobuf:"varint,4,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	Version    string                 `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	TemplateId string                 `protobuf:"bytes,6,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Incremented by the server on every change. Send it back on update or
+	// delete to reject the call if the resource was modified in the meantime.
+	ResourceVersion int64 `protobuf:"varint,8,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	// Revision of the template rendered_artifacts were produced from
+	TemplateRevision int64 `protobuf:"varint,9,opt,name=template_revision,json=templateRevision,proto3" json:"template_revision,omitempty"`
+	// Values for the parameters declared by the template
+	Parameters map[string]string `protobuf:"bytes,10,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Content rendered from the template by artifact name: "main" for the
+	// template body, and the name of each additional file of the template
+	RenderedArtifacts map[string]string `protobuf:"bytes,11,rep,name=rendered_artifacts,json=renderedArtifacts,proto3" json:"rendered_artifacts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Set by the server whenever the resource is rendered and by the periodic
+	// drift check. Filter on it as drift_status, e.g. `drift_status = "drifted"`.
+	DriftStatus KubernetesCluster_DriftStatus `protobuf:"varint,12,opt,name=drift_status,json=driftStatus,proto3,enum=kubernetes_cluster.v1.KubernetesCluster_DriftStatus" json:"drift_status,omitempty"`
+	// When drift_status last changed
+	DriftStatusTime *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=drift_status_time,json=driftStatusTime,proto3" json:"drift_status_time,omitempty"`
+	// Lifecycle status, set by the server. Filter on it as status, e.g.
+	// `status = "running"`.
+	Status KubernetesCluster_Status `protobuf:"varint,14,opt,name=status,proto3,enum=kubernetes_cluster.v1.KubernetesCluster_Status" json:"status,omitempty"`
+	// Conditions that explain the status, such as "Ready"
+	Conditions []*Condition           `protobuf:"bytes,15,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Name of the provisioner driver that acts on the resource, chosen by the
+	// server on creation
+	Provisioner   string `protobuf:"bytes,18,opt,name=provisioner,proto3" json:"provisioner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *KubernetesCluster) Reset() {
@@ -107,24 +304,184 @@ func (x *KubernetesCluster) GetTemplateId() string {
 	return ""
 }
 
-func (x *KubernetesCluster) GetRenderedTemplate() string {
+func (x *KubernetesCluster) GetResourceVersion() int64 {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return 0
+}
+
+func (x *KubernetesCluster) GetTemplateRevision() int64 {
+	if x != nil {
+		return x.TemplateRevision
+	}
+	return 0
+}
+
+func (x *KubernetesCluster) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *KubernetesCluster) GetRenderedArtifacts() map[string]string {
+	if x != nil {
+		return x.RenderedArtifacts
+	}
+	return nil
+}
+
+func (x *KubernetesCluster) GetDriftStatus() KubernetesCluster_DriftStatus {
+	if x != nil {
+		return x.DriftStatus
+	}
+	return KubernetesCluster_DRIFT_STATUS_UNSPECIFIED
+}
+
+func (x *KubernetesCluster) GetDriftStatusTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DriftStatusTime
+	}
+	return nil
+}
+
+func (x *KubernetesCluster) GetStatus() KubernetesCluster_Status {
+	if x != nil {
+		return x.Status
+	}
+	return KubernetesCluster_STATUS_UNSPECIFIED
+}
+
+func (x *KubernetesCluster) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *KubernetesCluster) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *KubernetesCluster) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *KubernetesCluster) GetProvisioner() string {
+	if x != nil {
+		return x.Provisioner
+	}
+	return ""
+}
+
+// Condition is an aspect of the state of a resource
+type Condition struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Type   string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	// CamelCase cause of the last change, e.g. "Provisioned"
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// When status last changed
+	LastTransitionTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_transition_time,json=lastTransitionTime,proto3" json:"last_transition_time,omitempty"`
+	// When reason or message last changed
+	LastUpdateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Condition) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Condition) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *Condition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Condition) GetMessage() string {
 	if x != nil {
-		return x.RenderedTemplate
+		return x.Message
 	}
 	return ""
 }
 
+func (x *Condition) GetLastTransitionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTransitionTime
+	}
+	return nil
+}
+
+func (x *Condition) GetLastUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdateTime
+	}
+	return nil
+}
+
 // Request and response messages for KubernetesCluster service
 type CreateKubernetesClusterRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	KubernetesCluster *KubernetesCluster     `protobuf:"bytes,1,opt,name=kubernetes_cluster,json=kubernetesCluster,proto3" json:"kubernetes_cluster,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Only validate the request and render the template, without storing the resource
+	ValidateOnly  bool `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateKubernetesClusterRequest) Reset() {
 	*x = CreateKubernetesClusterRequest{}
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[1]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -136,7 +493,7 @@ func (x *CreateKubernetesClusterRequest) String() string {
 func (*CreateKubernetesClusterRequest) ProtoMessage() {}
 
 func (x *CreateKubernetesClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[1]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -149,7 +506,7 @@ func (x *CreateKubernetesClusterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKubernetesClusterRequest.ProtoReflect.Descriptor instead.
 func (*CreateKubernetesClusterRequest) Descriptor() ([]byte, []int) {
-	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{1}
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateKubernetesClusterRequest) GetKubernetesCluster() *KubernetesCluster {
@@ -159,16 +516,28 @@ func (x *CreateKubernetesClusterRequest) GetKubernetesCluster() *KubernetesClust
 	return nil
 }
 
+func (x *CreateKubernetesClusterRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
 type CreateKubernetesClusterResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	KubernetesCluster *KubernetesCluster     `protobuf:"bytes,1,opt,name=kubernetes_cluster,json=kubernetesCluster,proto3" json:"kubernetes_cluster,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource while the change is applied, or as it would be stored for
+	// validate_only requests
+	KubernetesCluster *KubernetesCluster `protobuf:"bytes,1,opt,name=kubernetes_cluster,json=kubernetesCluster,proto3" json:"kubernetes_cluster,omitempty"`
+	// Operation applying the change, see operations.v1.OperationService. Empty
+	// for validate_only requests.
+	OperationId   string `protobuf:"bytes,2,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateKubernetesClusterResponse) Reset() {
 	*x = CreateKubernetesClusterResponse{}
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[2]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -180,7 +549,7 @@ func (x *CreateKubernetesClusterResponse) String() string {
 func (*CreateKubernetesClusterResponse) ProtoMessage() {}
 
 func (x *CreateKubernetesClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[2]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -193,7 +562,7 @@ func (x *CreateKubernetesClusterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKubernetesClusterResponse.ProtoReflect.Descriptor instead.
 func (*CreateKubernetesClusterResponse) Descriptor() ([]byte, []int) {
-	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{2}
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateKubernetesClusterResponse) GetKubernetesCluster() *KubernetesCluster {
@@ -203,6 +572,13 @@ func (x *CreateKubernetesClusterResponse) GetKubernetesCluster() *KubernetesClus
 	return nil
 }
 
+func (x *CreateKubernetesClusterResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
 type GetKubernetesClusterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -212,7 +588,7 @@ type GetKubernetesClusterRequest struct {
 
 func (x *GetKubernetesClusterRequest) Reset() {
 	*x = GetKubernetesClusterRequest{}
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[3]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -224,7 +600,7 @@ func (x *GetKubernetesClusterRequest) String() string {
 func (*GetKubernetesClusterRequest) ProtoMessage() {}
 
 func (x *GetKubernetesClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[3]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -237,7 +613,7 @@ func (x *GetKubernetesClusterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKubernetesClusterRequest.ProtoReflect.Descriptor instead.
 func (*GetKubernetesClusterRequest) Descriptor() ([]byte, []int) {
-	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{3}
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetKubernetesClusterRequest) GetId() string {
@@ -256,7 +632,7 @@ type GetKubernetesClusterResponse struct {
 
 func (x *GetKubernetesClusterResponse) Reset() {
 	*x = GetKubernetesClusterResponse{}
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[4]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -268,7 +644,7 @@ func (x *GetKubernetesClusterResponse) String() string {
 func (*GetKubernetesClusterResponse) ProtoMessage() {}
 
 func (x *GetKubernetesClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[4]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -281,7 +657,7 @@ func (x *GetKubernetesClusterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKubernetesClusterResponse.ProtoReflect.Descriptor instead.
 func (*GetKubernetesClusterResponse) Descriptor() ([]byte, []int) {
-	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{4}
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetKubernetesClusterResponse) GetKubernetesCluster() *KubernetesCluster {
@@ -292,14 +668,22 @@ func (x *GetKubernetesClusterResponse) GetKubernetesCluster() *KubernetesCluster
 }
 
 type ListKubernetesClustersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of results to return. Zero returns all results.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token returned as next_page_token by a previous call
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Filter expression, e.g. `region = "eu-1" AND node_count > 3`
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma separated list of fields with optional "asc"/"desc", e.g. `node_count desc, name`
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListKubernetesClustersRequest) Reset() {
 	*x = ListKubernetesClustersRequest{}
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[5]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -311,7 +695,7 @@ func (x *ListKubernetesClustersRequest) String() string {
 func (*ListKubernetesClustersRequest) ProtoMessage() {}
 
 func (x *ListKubernetesClustersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[5]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,19 +708,49 @@ func (x *ListKubernetesClustersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKubernetesClustersRequest.ProtoReflect.Descriptor instead.
 func (*ListKubernetesClustersRequest) Descriptor() ([]byte, []int) {
-	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{5}
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListKubernetesClustersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListKubernetesClustersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListKubernetesClustersRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListKubernetesClustersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
 }
 
 type ListKubernetesClustersResponse struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	KubernetesClusters []*KubernetesCluster   `protobuf:"bytes,1,rep,name=kubernetes_clusters,json=kubernetesClusters,proto3" json:"kubernetes_clusters,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Token for the next page, empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListKubernetesClustersResponse) Reset() {
 	*x = ListKubernetesClustersResponse{}
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[6]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -348,7 +762,7 @@ func (x *ListKubernetesClustersResponse) String() string {
 func (*ListKubernetesClustersResponse) ProtoMessage() {}
 
 func (x *ListKubernetesClustersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[6]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -361,7 +775,7 @@ func (x *ListKubernetesClustersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKubernetesClustersResponse.ProtoReflect.Descriptor instead.
 func (*ListKubernetesClustersResponse) Descriptor() ([]byte, []int) {
-	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{6}
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListKubernetesClustersResponse) GetKubernetesClusters() []*KubernetesCluster {
@@ -371,16 +785,27 @@ func (x *ListKubernetesClustersResponse) GetKubernetesClusters() []*KubernetesCl
 	return nil
 }
 
+func (x *ListKubernetesClustersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type UpdateKubernetesClusterRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	KubernetesCluster *KubernetesCluster     `protobuf:"bytes,1,opt,name=kubernetes_cluster,json=kubernetesCluster,proto3" json:"kubernetes_cluster,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Fields to update. If empty, all fields are replaced.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Only validate the request and render the template, without storing the resource
+	ValidateOnly  bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateKubernetesClusterRequest) Reset() {
 	*x = UpdateKubernetesClusterRequest{}
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[7]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -392,7 +817,7 @@ func (x *UpdateKubernetesClusterRequest) String() string {
 func (*UpdateKubernetesClusterRequest) ProtoMessage() {}
 
 func (x *UpdateKubernetesClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[7]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -405,7 +830,7 @@ func (x *UpdateKubernetesClusterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKubernetesClusterRequest.ProtoReflect.Descriptor instead.
 func (*UpdateKubernetesClusterRequest) Descriptor() ([]byte, []int) {
-	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{7}
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateKubernetesClusterRequest) GetKubernetesCluster() *KubernetesCluster {
@@ -415,16 +840,35 @@ func (x *UpdateKubernetesClusterRequest) GetKubernetesCluster() *KubernetesClust
 	return nil
 }
 
+func (x *UpdateKubernetesClusterRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateKubernetesClusterRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
 type UpdateKubernetesClusterResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	KubernetesCluster *KubernetesCluster     `protobuf:"bytes,1,opt,name=kubernetes_cluster,json=kubernetesCluster,proto3" json:"kubernetes_cluster,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource while the change is applied, or as it would be stored for
+	// validate_only requests
+	KubernetesCluster *KubernetesCluster `protobuf:"bytes,1,opt,name=kubernetes_cluster,json=kubernetesCluster,proto3" json:"kubernetes_cluster,omitempty"`
+	// Operation applying the change, see operations.v1.OperationService. Empty
+	// for validate_only requests.
+	OperationId   string `protobuf:"bytes,2,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateKubernetesClusterResponse) Reset() {
 	*x = UpdateKubernetesClusterResponse{}
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[8]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -436,7 +880,7 @@ func (x *UpdateKubernetesClusterResponse) String() string {
 func (*UpdateKubernetesClusterResponse) ProtoMessage() {}
 
 func (x *UpdateKubernetesClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[8]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,7 +893,7 @@ func (x *UpdateKubernetesClusterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKubernetesClusterResponse.ProtoReflect.Descriptor instead.
 func (*UpdateKubernetesClusterResponse) Descriptor() ([]byte, []int) {
-	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{8}
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateKubernetesClusterResponse) GetKubernetesCluster() *KubernetesCluster {
@@ -459,16 +903,25 @@ func (x *UpdateKubernetesClusterResponse) GetKubernetesCluster() *KubernetesClus
 	return nil
 }
 
+func (x *UpdateKubernetesClusterResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
 type DeleteKubernetesClusterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// If set, the delete is rejected unless it matches the stored version
+	ResourceVersion int64 `protobuf:"varint,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DeleteKubernetesClusterRequest) Reset() {
 	*x = DeleteKubernetesClusterRequest{}
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[9]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -480,7 +933,7 @@ func (x *DeleteKubernetesClusterRequest) String() string {
 func (*DeleteKubernetesClusterRequest) ProtoMessage() {}
 
 func (x *DeleteKubernetesClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[9]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +946,7 @@ func (x *DeleteKubernetesClusterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKubernetesClusterRequest.ProtoReflect.Descriptor instead.
 func (*DeleteKubernetesClusterRequest) Descriptor() ([]byte, []int) {
-	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{9}
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteKubernetesClusterRequest) GetId() string {
@@ -503,16 +956,26 @@ func (x *DeleteKubernetesClusterRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteKubernetesClusterRequest) GetResourceVersion() int64 {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return 0
+}
+
 type DeleteKubernetesClusterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// True once the deletion started
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Operation deleting the resource, see operations.v1.OperationService
+	OperationId   string `protobuf:"bytes,2,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteKubernetesClusterResponse) Reset() {
 	*x = DeleteKubernetesClusterResponse{}
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[10]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -524,7 +987,7 @@ func (x *DeleteKubernetesClusterResponse) String() string {
 func (*DeleteKubernetesClusterResponse) ProtoMessage() {}
 
 func (x *DeleteKubernetesClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[10]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +1000,7 @@ func (x *DeleteKubernetesClusterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKubernetesClusterResponse.ProtoReflect.Descriptor instead.
 func (*DeleteKubernetesClusterResponse) Descriptor() ([]byte, []int) {
-	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{10}
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteKubernetesClusterResponse) GetSuccess() bool {
@@ -547,6 +1010,13 @@ func (x *DeleteKubernetesClusterResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *DeleteKubernetesClusterResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
 type GetKubernetesClusterKubeconfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -556,7 +1026,7 @@ type GetKubernetesClusterKubeconfigRequest struct {
 
 func (x *GetKubernetesClusterKubeconfigRequest) Reset() {
 	*x = GetKubernetesClusterKubeconfigRequest{}
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[11]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -568,7 +1038,7 @@ func (x *GetKubernetesClusterKubeconfigRequest) String() string {
 func (*GetKubernetesClusterKubeconfigRequest) ProtoMessage() {}
 
 func (x *GetKubernetesClusterKubeconfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[11]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,7 +1051,7 @@ func (x *GetKubernetesClusterKubeconfigRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use GetKubernetesClusterKubeconfigRequest.ProtoReflect.Descriptor instead.
 func (*GetKubernetesClusterKubeconfigRequest) Descriptor() ([]byte, []int) {
-	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{11}
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetKubernetesClusterKubeconfigRequest) GetId() string {
@@ -592,15 +1062,16 @@ func (x *GetKubernetesClusterKubeconfigRequest) GetId() string {
 }
 
 type GetKubernetesClusterKubeconfigResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Kubeconfig    string                 `protobuf:"bytes,1,opt,name=kubeconfig,proto3" json:"kubeconfig,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The "kubeconfig" artifact, or the "main" artifact if the template has no such file
+	Kubeconfig    string `protobuf:"bytes,1,opt,name=kubeconfig,proto3" json:"kubeconfig,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetKubernetesClusterKubeconfigResponse) Reset() {
 	*x = GetKubernetesClusterKubeconfigResponse{}
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[12]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -612,7 +1083,7 @@ func (x *GetKubernetesClusterKubeconfigResponse) String() string {
 func (*GetKubernetesClusterKubeconfigResponse) ProtoMessage() {}
 
 func (x *GetKubernetesClusterKubeconfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[12]
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,7 +1096,7 @@ func (x *GetKubernetesClusterKubeconfigResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GetKubernetesClusterKubeconfigResponse.ProtoReflect.Descriptor instead.
 func (*GetKubernetesClusterKubeconfigResponse) Descriptor() ([]byte, []int) {
-	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{12}
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetKubernetesClusterKubeconfigResponse) GetKubeconfig() string {
@@ -635,6 +1106,225 @@ func (x *GetKubernetesClusterKubeconfigResponse) GetKubeconfig() string {
 	return ""
 }
 
+type GetRenderedArtifactRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the artifact, e.g. "main" or "user-data"
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRenderedArtifactRequest) Reset() {
+	*x = GetRenderedArtifactRequest{}
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRenderedArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRenderedArtifactRequest) ProtoMessage() {}
+
+func (x *GetRenderedArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRenderedArtifactRequest.ProtoReflect.Descriptor instead.
+func (*GetRenderedArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetRenderedArtifactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetRenderedArtifactRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetRenderedArtifactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRenderedArtifactResponse) Reset() {
+	*x = GetRenderedArtifactResponse{}
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRenderedArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRenderedArtifactResponse) ProtoMessage() {}
+
+func (x *GetRenderedArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRenderedArtifactResponse.ProtoReflect.Descriptor instead.
+func (*GetRenderedArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetRenderedArtifactResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetRenderedArtifactResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// ExportRenderedArtifactsRequest packs all artifacts of a Kubernetes cluster into an archive
+type ExportRenderedArtifactsRequest struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Id            string                                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Format        ExportRenderedArtifactsRequest_Format `protobuf:"varint,2,opt,name=format,proto3,enum=kubernetes_cluster.v1.ExportRenderedArtifactsRequest_Format" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRenderedArtifactsRequest) Reset() {
+	*x = ExportRenderedArtifactsRequest{}
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRenderedArtifactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRenderedArtifactsRequest) ProtoMessage() {}
+
+func (x *ExportRenderedArtifactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRenderedArtifactsRequest.ProtoReflect.Descriptor instead.
+func (*ExportRenderedArtifactsRequest) Descriptor() ([]byte, []int) {
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExportRenderedArtifactsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportRenderedArtifactsRequest) GetFormat() ExportRenderedArtifactsRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return ExportRenderedArtifactsRequest_FORMAT_UNSPECIFIED
+}
+
+type ExportRenderedArtifactsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Suggested file name of the archive, e.g. "web.tar.gz"
+	FileName      string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Archive       []byte `protobuf:"bytes,3,opt,name=archive,proto3" json:"archive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRenderedArtifactsResponse) Reset() {
+	*x = ExportRenderedArtifactsResponse{}
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRenderedArtifactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRenderedArtifactsResponse) ProtoMessage() {}
+
+func (x *ExportRenderedArtifactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRenderedArtifactsResponse.ProtoReflect.Descriptor instead.
+func (*ExportRenderedArtifactsResponse) Descriptor() ([]byte, []int) {
+	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExportRenderedArtifactsResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportRenderedArtifactsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportRenderedArtifactsResponse) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
 var File_kubernetes_cluster_v1_kubernetes_cluster_proto protoreflect.FileDescriptor
 
 var file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDesc = string([]byte{
@@ -642,28 +1332,125 @@ var file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDesc = string([]byte{
 	0x73, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
 	0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x15, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x22, 0xd6, 0x01, 0x0a, 0x11, 0x4b, 0x75, 0x62, 0x65,
-	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e,
-	0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x5f,
-	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x22, 0x79, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e,
-	0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x57, 0x0a, 0x12, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
-	0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d,
+	0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfc, 0x0a, 0x0a, 0x11, 0x4b,
+	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x58,
+	0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x6e, 0x0a, 0x12, 0x72, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x57, 0x0a, 0x0c, 0x64, 0x72, 0x69, 0x66,
+	0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34,
 	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73,
 	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
-	0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e,
-	0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x7a, 0x0a, 0x1f, 0x43,
+	0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x72, 0x69, 0x66, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x64, 0x72, 0x69, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x46, 0x0a, 0x11, 0x64, 0x72, 0x69, 0x66, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x64, 0x72, 0x69, 0x66, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
+	0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x72, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x72,
+	0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x44, 0x0a, 0x16, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7f, 0x0a, 0x0b, 0x44, 0x72, 0x69, 0x66, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x52, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x52, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x49, 0x4e, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
+	0x44, 0x52, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x52, 0x49,
+	0x46, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x52, 0x49, 0x46, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x22, 0xc7, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x17, 0x0a,
+	0x13, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f,
+	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12,
+	0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45,
+	0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x11, 0x0a,
+	0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x08,
+	0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x11, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64,
+	0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0xfd, 0x01, 0x0a, 0x09, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x12, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x1e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x12,
+	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x9d, 0x01, 0x0a, 0x1f, 0x43,
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43,
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57,
 	0x0a, 0x12, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75,
@@ -671,35 +1458,53 @@ var file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDesc = string([]byte{
 	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
 	0x76, 0x31, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x52, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x2d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4b, 0x75,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x77, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x12, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x75,
 	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x77, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62,
-	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x12, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e,
-	0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72,
-	0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x11, 0x6b, 0x75,
-	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22,
-	0x1f, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
-	0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x7b, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
-	0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x59, 0x0a, 0x13, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
-	0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
-	0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x12, 0x6b, 0x75, 0x62, 0x65, 0x72,
-	0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x79, 0x0a,
-	0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
-	0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x57, 0x0a, 0x12, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75,
-	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
-	0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x7a, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61,
+	0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x22, 0x8e, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x79, 0x22, 0xa3, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x13, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
+	0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x12, 0x6b,
+	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xdb, 0x01, 0x0a, 0x1e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x12,
+	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x73, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f,
+	0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x9d, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61,
 	0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73,
 	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x12, 0x6b,
 	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
@@ -707,93 +1512,146 @@ var file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDesc = string([]byte{
 	0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
 	0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
 	0x72, 0x52, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x22, 0x30, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x5b, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5e, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x75,
 	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x22, 0x37, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e,
-	0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x75, 0x62, 0x65, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x26,
-	0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x32, 0xe4, 0x06, 0x0a, 0x18, 0x4b, 0x75, 0x62, 0x65, 0x72,
-	0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x75,
-	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
-	0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x75,
-	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
-	0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x32, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
-	0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6b, 0x75, 0x62,
-	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x85, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
-	0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x34, 0x2e, 0x6b, 0x75, 0x62,
-	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
-	0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x75, 0x62,
-	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x12, 0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
-	0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6b, 0x75, 0x62,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x75, 0x62, 0x65,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x48, 0x0a,
+	0x26, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x75, 0x62,
+	0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x40, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4b, 0x0a, 0x1b, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xcb, 0x01, 0x0a, 0x1e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x54, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64,
+	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22,
+	0x43, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x5f,
+	0x47, 0x5a, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x5a,
+	0x49, 0x50, 0x10, 0x02, 0x22, 0x7b, 0x0a, 0x1f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x32, 0xed, 0x08, 0x0a, 0x18, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x88,
+	0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
+	0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x35, 0x2e, 0x6b, 0x75, 0x62,
 	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
-	0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x75, 0x62,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
+	0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x32, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x34, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6b, 0x75,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x75, 0x62,
 	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x35,
 	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x75, 0x62,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x75, 0x62,
 	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
-	0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9d, 0x01,
-	0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x3c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65,
-	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x75, 0x62,
-	0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d,
-	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e,
-	0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x75, 0x62, 0x65, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xfc, 0x01,
-	0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
-	0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x16, 0x4b, 0x75, 0x62,
-	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x56, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x61, 0x61, 0x31, 0x65, 0x78, 0x2f, 0x70, 0x61, 0x61, 0x73, 0x2d, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72,
-	0x70, 0x63, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
-	0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x4b, 0x58, 0x58, 0xaa, 0x02, 0x14, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x14, 0x4b, 0x75, 0x62,
-	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x20, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
-	0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01,
+	0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b,
+	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12,
+	0x31, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x73, 0x12, 0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64,
+	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x9d, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
+	0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x75,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4b,
+	0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0xfc, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42,
+	0x16, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x56, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x61, 0x31, 0x65, 0x78, 0x2f, 0x70, 0x61, 0x61, 0x73,
+	0x2d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x4b, 0x58, 0x58, 0xaa, 0x02, 0x14, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x14, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -808,46 +1666,75 @@ func file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescGZIP() []byte {
 	return file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDescData
 }
 
-var file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_kubernetes_cluster_v1_kubernetes_cluster_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_kubernetes_cluster_v1_kubernetes_cluster_proto_goTypes = []any{
-	(*KubernetesCluster)(nil),                      // 0: kubernetes_cluster.v1.KubernetesCluster
-	(*CreateKubernetesClusterRequest)(nil),         // 1: kubernetes_cluster.v1.CreateKubernetesClusterRequest
-	(*CreateKubernetesClusterResponse)(nil),        // 2: kubernetes_cluster.v1.CreateKubernetesClusterResponse
-	(*GetKubernetesClusterRequest)(nil),            // 3: kubernetes_cluster.v1.GetKubernetesClusterRequest
-	(*GetKubernetesClusterResponse)(nil),           // 4: kubernetes_cluster.v1.GetKubernetesClusterResponse
-	(*ListKubernetesClustersRequest)(nil),          // 5: kubernetes_cluster.v1.ListKubernetesClustersRequest
-	(*ListKubernetesClustersResponse)(nil),         // 6: kubernetes_cluster.v1.ListKubernetesClustersResponse
-	(*UpdateKubernetesClusterRequest)(nil),         // 7: kubernetes_cluster.v1.UpdateKubernetesClusterRequest
-	(*UpdateKubernetesClusterResponse)(nil),        // 8: kubernetes_cluster.v1.UpdateKubernetesClusterResponse
-	(*DeleteKubernetesClusterRequest)(nil),         // 9: kubernetes_cluster.v1.DeleteKubernetesClusterRequest
-	(*DeleteKubernetesClusterResponse)(nil),        // 10: kubernetes_cluster.v1.DeleteKubernetesClusterResponse
-	(*GetKubernetesClusterKubeconfigRequest)(nil),  // 11: kubernetes_cluster.v1.GetKubernetesClusterKubeconfigRequest
-	(*GetKubernetesClusterKubeconfigResponse)(nil), // 12: kubernetes_cluster.v1.GetKubernetesClusterKubeconfigResponse
+	(KubernetesCluster_DriftStatus)(0),             // 0: kubernetes_cluster.v1.KubernetesCluster.DriftStatus
+	(KubernetesCluster_Status)(0),                  // 1: kubernetes_cluster.v1.KubernetesCluster.Status
+	(ExportRenderedArtifactsRequest_Format)(0),     // 2: kubernetes_cluster.v1.ExportRenderedArtifactsRequest.Format
+	(*KubernetesCluster)(nil),                      // 3: kubernetes_cluster.v1.KubernetesCluster
+	(*Condition)(nil),                              // 4: kubernetes_cluster.v1.Condition
+	(*CreateKubernetesClusterRequest)(nil),         // 5: kubernetes_cluster.v1.CreateKubernetesClusterRequest
+	(*CreateKubernetesClusterResponse)(nil),        // 6: kubernetes_cluster.v1.CreateKubernetesClusterResponse
+	(*GetKubernetesClusterRequest)(nil),            // 7: kubernetes_cluster.v1.GetKubernetesClusterRequest
+	(*GetKubernetesClusterResponse)(nil),           // 8: kubernetes_cluster.v1.GetKubernetesClusterResponse
+	(*ListKubernetesClustersRequest)(nil),          // 9: kubernetes_cluster.v1.ListKubernetesClustersRequest
+	(*ListKubernetesClustersResponse)(nil),         // 10: kubernetes_cluster.v1.ListKubernetesClustersResponse
+	(*UpdateKubernetesClusterRequest)(nil),         // 11: kubernetes_cluster.v1.UpdateKubernetesClusterRequest
+	(*UpdateKubernetesClusterResponse)(nil),        // 12: kubernetes_cluster.v1.UpdateKubernetesClusterResponse
+	(*DeleteKubernetesClusterRequest)(nil),         // 13: kubernetes_cluster.v1.DeleteKubernetesClusterRequest
+	(*DeleteKubernetesClusterResponse)(nil),        // 14: kubernetes_cluster.v1.DeleteKubernetesClusterResponse
+	(*GetKubernetesClusterKubeconfigRequest)(nil),  // 15: kubernetes_cluster.v1.GetKubernetesClusterKubeconfigRequest
+	(*GetKubernetesClusterKubeconfigResponse)(nil), // 16: kubernetes_cluster.v1.GetKubernetesClusterKubeconfigResponse
+	(*GetRenderedArtifactRequest)(nil),             // 17: kubernetes_cluster.v1.GetRenderedArtifactRequest
+	(*GetRenderedArtifactResponse)(nil),            // 18: kubernetes_cluster.v1.GetRenderedArtifactResponse
+	(*ExportRenderedArtifactsRequest)(nil),         // 19: kubernetes_cluster.v1.ExportRenderedArtifactsRequest
+	(*ExportRenderedArtifactsResponse)(nil),        // 20: kubernetes_cluster.v1.ExportRenderedArtifactsResponse
+	nil,                                            // 21: kubernetes_cluster.v1.KubernetesCluster.ParametersEntry
+	nil,                                            // 22: kubernetes_cluster.v1.KubernetesCluster.RenderedArtifactsEntry
+	(*timestamppb.Timestamp)(nil),                  // 23: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                  // 24: google.protobuf.FieldMask
 }
 var file_kubernetes_cluster_v1_kubernetes_cluster_proto_depIdxs = []int32{
-	0,  // 0: kubernetes_cluster.v1.CreateKubernetesClusterRequest.kubernetes_cluster:type_name -> kubernetes_cluster.v1.KubernetesCluster
-	0,  // 1: kubernetes_cluster.v1.CreateKubernetesClusterResponse.kubernetes_cluster:type_name -> kubernetes_cluster.v1.KubernetesCluster
-	0,  // 2: kubernetes_cluster.v1.GetKubernetesClusterResponse.kubernetes_cluster:type_name -> kubernetes_cluster.v1.KubernetesCluster
-	0,  // 3: kubernetes_cluster.v1.ListKubernetesClustersResponse.kubernetes_clusters:type_name -> kubernetes_cluster.v1.KubernetesCluster
-	0,  // 4: kubernetes_cluster.v1.UpdateKubernetesClusterRequest.kubernetes_cluster:type_name -> kubernetes_cluster.v1.KubernetesCluster
-	0,  // 5: kubernetes_cluster.v1.UpdateKubernetesClusterResponse.kubernetes_cluster:type_name -> kubernetes_cluster.v1.KubernetesCluster
-	1,  // 6: kubernetes_cluster.v1.KubernetesClusterService.CreateKubernetesCluster:input_type -> kubernetes_cluster.v1.CreateKubernetesClusterRequest
-	3,  // 7: kubernetes_cluster.v1.KubernetesClusterService.GetKubernetesCluster:input_type -> kubernetes_cluster.v1.GetKubernetesClusterRequest
-	5,  // 8: kubernetes_cluster.v1.KubernetesClusterService.ListKubernetesClusters:input_type -> kubernetes_cluster.v1.ListKubernetesClustersRequest
-	7,  // 9: kubernetes_cluster.v1.KubernetesClusterService.UpdateKubernetesCluster:input_type -> kubernetes_cluster.v1.UpdateKubernetesClusterRequest
-	9,  // 10: kubernetes_cluster.v1.KubernetesClusterService.DeleteKubernetesCluster:input_type -> kubernetes_cluster.v1.DeleteKubernetesClusterRequest
-	11, // 11: kubernetes_cluster.v1.KubernetesClusterService.GetKubernetesClusterKubeconfig:input_type -> kubernetes_cluster.v1.GetKubernetesClusterKubeconfigRequest
-	2,  // 12: kubernetes_cluster.v1.KubernetesClusterService.CreateKubernetesCluster:output_type -> kubernetes_cluster.v1.CreateKubernetesClusterResponse
-	4,  // 13: kubernetes_cluster.v1.KubernetesClusterService.GetKubernetesCluster:output_type -> kubernetes_cluster.v1.GetKubernetesClusterResponse
-	6,  // 14: kubernetes_cluster.v1.KubernetesClusterService.ListKubernetesClusters:output_type -> kubernetes_cluster.v1.ListKubernetesClustersResponse
-	8,  // 15: kubernetes_cluster.v1.KubernetesClusterService.UpdateKubernetesCluster:output_type -> kubernetes_cluster.v1.UpdateKubernetesClusterResponse
-	10, // 16: kubernetes_cluster.v1.KubernetesClusterService.DeleteKubernetesCluster:output_type -> kubernetes_cluster.v1.DeleteKubernetesClusterResponse
-	12, // 17: kubernetes_cluster.v1.KubernetesClusterService.GetKubernetesClusterKubeconfig:output_type -> kubernetes_cluster.v1.GetKubernetesClusterKubeconfigResponse
-	12, // [12:18] is the sub-list for method output_type
-	6,  // [6:12] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	21, // 0: kubernetes_cluster.v1.KubernetesCluster.parameters:type_name -> kubernetes_cluster.v1.KubernetesCluster.ParametersEntry
+	22, // 1: kubernetes_cluster.v1.KubernetesCluster.rendered_artifacts:type_name -> kubernetes_cluster.v1.KubernetesCluster.RenderedArtifactsEntry
+	0,  // 2: kubernetes_cluster.v1.KubernetesCluster.drift_status:type_name -> kubernetes_cluster.v1.KubernetesCluster.DriftStatus
+	23, // 3: kubernetes_cluster.v1.KubernetesCluster.drift_status_time:type_name -> google.protobuf.Timestamp
+	1,  // 4: kubernetes_cluster.v1.KubernetesCluster.status:type_name -> kubernetes_cluster.v1.KubernetesCluster.Status
+	4,  // 5: kubernetes_cluster.v1.KubernetesCluster.conditions:type_name -> kubernetes_cluster.v1.Condition
+	23, // 6: kubernetes_cluster.v1.KubernetesCluster.create_time:type_name -> google.protobuf.Timestamp
+	23, // 7: kubernetes_cluster.v1.KubernetesCluster.update_time:type_name -> google.protobuf.Timestamp
+	23, // 8: kubernetes_cluster.v1.Condition.last_transition_time:type_name -> google.protobuf.Timestamp
+	23, // 9: kubernetes_cluster.v1.Condition.last_update_time:type_name -> google.protobuf.Timestamp
+	3,  // 10: kubernetes_cluster.v1.CreateKubernetesClusterRequest.kubernetes_cluster:type_name -> kubernetes_cluster.v1.KubernetesCluster
+	3,  // 11: kubernetes_cluster.v1.CreateKubernetesClusterResponse.kubernetes_cluster:type_name -> kubernetes_cluster.v1.KubernetesCluster
+	3,  // 12: kubernetes_cluster.v1.GetKubernetesClusterResponse.kubernetes_cluster:type_name -> kubernetes_cluster.v1.KubernetesCluster
+	3,  // 13: kubernetes_cluster.v1.ListKubernetesClustersResponse.kubernetes_clusters:type_name -> kubernetes_cluster.v1.KubernetesCluster
+	3,  // 14: kubernetes_cluster.v1.UpdateKubernetesClusterRequest.kubernetes_cluster:type_name -> kubernetes_cluster.v1.KubernetesCluster
+	24, // 15: kubernetes_cluster.v1.UpdateKubernetesClusterRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 16: kubernetes_cluster.v1.UpdateKubernetesClusterResponse.kubernetes_cluster:type_name -> kubernetes_cluster.v1.KubernetesCluster
+	2,  // 17: kubernetes_cluster.v1.ExportRenderedArtifactsRequest.format:type_name -> kubernetes_cluster.v1.ExportRenderedArtifactsRequest.Format
+	5,  // 18: kubernetes_cluster.v1.KubernetesClusterService.CreateKubernetesCluster:input_type -> kubernetes_cluster.v1.CreateKubernetesClusterRequest
+	7,  // 19: kubernetes_cluster.v1.KubernetesClusterService.GetKubernetesCluster:input_type -> kubernetes_cluster.v1.GetKubernetesClusterRequest
+	9,  // 20: kubernetes_cluster.v1.KubernetesClusterService.ListKubernetesClusters:input_type -> kubernetes_cluster.v1.ListKubernetesClustersRequest
+	11, // 21: kubernetes_cluster.v1.KubernetesClusterService.UpdateKubernetesCluster:input_type -> kubernetes_cluster.v1.UpdateKubernetesClusterRequest
+	13, // 22: kubernetes_cluster.v1.KubernetesClusterService.DeleteKubernetesCluster:input_type -> kubernetes_cluster.v1.DeleteKubernetesClusterRequest
+	17, // 23: kubernetes_cluster.v1.KubernetesClusterService.GetRenderedArtifact:input_type -> kubernetes_cluster.v1.GetRenderedArtifactRequest
+	19, // 24: kubernetes_cluster.v1.KubernetesClusterService.ExportRenderedArtifacts:input_type -> kubernetes_cluster.v1.ExportRenderedArtifactsRequest
+	15, // 25: kubernetes_cluster.v1.KubernetesClusterService.GetKubernetesClusterKubeconfig:input_type -> kubernetes_cluster.v1.GetKubernetesClusterKubeconfigRequest
+	6,  // 26: kubernetes_cluster.v1.KubernetesClusterService.CreateKubernetesCluster:output_type -> kubernetes_cluster.v1.CreateKubernetesClusterResponse
+	8,  // 27: kubernetes_cluster.v1.KubernetesClusterService.GetKubernetesCluster:output_type -> kubernetes_cluster.v1.GetKubernetesClusterResponse
+	10, // 28: kubernetes_cluster.v1.KubernetesClusterService.ListKubernetesClusters:output_type -> kubernetes_cluster.v1.ListKubernetesClustersResponse
+	12, // 29: kubernetes_cluster.v1.KubernetesClusterService.UpdateKubernetesCluster:output_type -> kubernetes_cluster.v1.UpdateKubernetesClusterResponse
+	14, // 30: kubernetes_cluster.v1.KubernetesClusterService.DeleteKubernetesCluster:output_type -> kubernetes_cluster.v1.DeleteKubernetesClusterResponse
+	18, // 31: kubernetes_cluster.v1.KubernetesClusterService.GetRenderedArtifact:output_type -> kubernetes_cluster.v1.GetRenderedArtifactResponse
+	20, // 32: kubernetes_cluster.v1.KubernetesClusterService.ExportRenderedArtifacts:output_type -> kubernetes_cluster.v1.ExportRenderedArtifactsResponse
+	16, // 33: kubernetes_cluster.v1.KubernetesClusterService.GetKubernetesClusterKubeconfig:output_type -> kubernetes_cluster.v1.GetKubernetesClusterKubeconfigResponse
+	26, // [26:34] is the sub-list for method output_type
+	18, // [18:26] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_kubernetes_cluster_v1_kubernetes_cluster_proto_init() }
@@ -860,13 +1747,14 @@ func file_kubernetes_cluster_v1_kubernetes_cluster_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDesc), len(file_kubernetes_cluster_v1_kubernetes_cluster_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      3,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_kubernetes_cluster_v1_kubernetes_cluster_proto_goTypes,
 		DependencyIndexes: file_kubernetes_cluster_v1_kubernetes_cluster_proto_depIdxs,
+		EnumInfos:         file_kubernetes_cluster_v1_kubernetes_cluster_proto_enumTypes,
 		MessageInfos:      file_kubernetes_cluster_v1_kubernetes_cluster_proto_msgTypes,
 	}.Build()
 	File_kubernetes_cluster_v1_kubernetes_cluster_proto = out.File
@@ -48,6 +48,12 @@ const (
 	// KubernetesClusterServiceDeleteKubernetesClusterProcedure is the fully-qualified name of the
 	// KubernetesClusterService's DeleteKubernetesCluster RPC.
 	KubernetesClusterServiceDeleteKubernetesClusterProcedure = "/kubernetes_cluster.v1.KubernetesClusterService/DeleteKubernetesCluster"
+	// KubernetesClusterServiceGetRenderedArtifactProcedure is the fully-qualified name of the
+	// KubernetesClusterService's GetRenderedArtifact RPC.
+	KubernetesClusterServiceGetRenderedArtifactProcedure = "/kubernetes_cluster.v1.KubernetesClusterService/GetRenderedArtifact"
+	// KubernetesClusterServiceExportRenderedArtifactsProcedure is the fully-qualified name of the
+	// KubernetesClusterService's ExportRenderedArtifacts RPC.
+	KubernetesClusterServiceExportRenderedArtifactsProcedure = "/kubernetes_cluster.v1.KubernetesClusterService/ExportRenderedArtifacts"
 	// KubernetesClusterServiceGetKubernetesClusterKubeconfigProcedure is the fully-qualified name of
 	// the KubernetesClusterService's GetKubernetesClusterKubeconfig RPC.
 	KubernetesClusterServiceGetKubernetesClusterKubeconfigProcedure = "/kubernetes_cluster.v1.KubernetesClusterService/GetKubernetesClusterKubeconfig"
@@ -61,6 +67,8 @@ type KubernetesClusterServiceClient interface {
 	ListKubernetesClusters(context.Context, *connect.Request[v1.ListKubernetesClustersRequest]) (*connect.Response[v1.ListKubernetesClustersResponse], error)
 	UpdateKubernetesCluster(context.Context, *connect.Request[v1.UpdateKubernetesClusterRequest]) (*connect.Response[v1.UpdateKubernetesClusterResponse], error)
 	DeleteKubernetesCluster(context.Context, *connect.Request[v1.DeleteKubernetesClusterRequest]) (*connect.Response[v1.DeleteKubernetesClusterResponse], error)
+	GetRenderedArtifact(context.Context, *connect.Request[v1.GetRenderedArtifactRequest]) (*connect.Response[v1.GetRenderedArtifactResponse], error)
+	ExportRenderedArtifacts(context.Context, *connect.Request[v1.ExportRenderedArtifactsRequest]) (*connect.Response[v1.ExportRenderedArtifactsResponse], error)
 	GetKubernetesClusterKubeconfig(context.Context, *connect.Request[v1.GetKubernetesClusterKubeconfigRequest]) (*connect.Response[v1.GetKubernetesClusterKubeconfigResponse], error)
 }
 
@@ -106,6 +114,18 @@ func NewKubernetesClusterServiceClient(httpClient connect.HTTPClient, baseURL st
 			connect.WithSchema(kubernetesClusterServiceMethods.ByName("DeleteKubernetesCluster")),
 			connect.WithClientOptions(opts...),
 		),
+		getRenderedArtifact: connect.NewClient[v1.GetRenderedArtifactRequest, v1.GetRenderedArtifactResponse](
+			httpClient,
+			baseURL+KubernetesClusterServiceGetRenderedArtifactProcedure,
+			connect.WithSchema(kubernetesClusterServiceMethods.ByName("GetRenderedArtifact")),
+			connect.WithClientOptions(opts...),
+		),
+		exportRenderedArtifacts: connect.NewClient[v1.ExportRenderedArtifactsRequest, v1.ExportRenderedArtifactsResponse](
+			httpClient,
+			baseURL+KubernetesClusterServiceExportRenderedArtifactsProcedure,
+			connect.WithSchema(kubernetesClusterServiceMethods.ByName("ExportRenderedArtifacts")),
+			connect.WithClientOptions(opts...),
+		),
 		getKubernetesClusterKubeconfig: connect.NewClient[v1.GetKubernetesClusterKubeconfigRequest, v1.GetKubernetesClusterKubeconfigResponse](
 			httpClient,
 			baseURL+KubernetesClusterServiceGetKubernetesClusterKubeconfigProcedure,
@@ -122,6 +142,8 @@ type kubernetesClusterServiceClient struct {
 	listKubernetesClusters         *connect.Client[v1.ListKubernetesClustersRequest, v1.ListKubernetesClustersResponse]
 	updateKubernetesCluster        *connect.Client[v1.UpdateKubernetesClusterRequest, v1.UpdateKubernetesClusterResponse]
 	deleteKubernetesCluster        *connect.Client[v1.DeleteKubernetesClusterRequest, v1.DeleteKubernetesClusterResponse]
+	getRenderedArtifact            *connect.Client[v1.GetRenderedArtifactRequest, v1.GetRenderedArtifactResponse]
+	exportRenderedArtifacts        *connect.Client[v1.ExportRenderedArtifactsRequest, v1.ExportRenderedArtifactsResponse]
 	getKubernetesClusterKubeconfig *connect.Client[v1.GetKubernetesClusterKubeconfigRequest, v1.GetKubernetesClusterKubeconfigResponse]
 }
 
@@ -154,6 +176,17 @@ func (c *kubernetesClusterServiceClient) DeleteKubernetesCluster(ctx context.Con
 	return c.deleteKubernetesCluster.CallUnary(ctx, req)
 }
 
+// GetRenderedArtifact calls kubernetes_cluster.v1.KubernetesClusterService.GetRenderedArtifact.
+func (c *kubernetesClusterServiceClient) GetRenderedArtifact(ctx context.Context, req *connect.Request[v1.GetRenderedArtifactRequest]) (*connect.Response[v1.GetRenderedArtifactResponse], error) {
+	return c.getRenderedArtifact.CallUnary(ctx, req)
+}
+
+// ExportRenderedArtifacts calls
+// kubernetes_cluster.v1.KubernetesClusterService.ExportRenderedArtifacts.
+func (c *kubernetesClusterServiceClient) ExportRenderedArtifacts(ctx context.Context, req *connect.Request[v1.ExportRenderedArtifactsRequest]) (*connect.Response[v1.ExportRenderedArtifactsResponse], error) {
+	return c.exportRenderedArtifacts.CallUnary(ctx, req)
+}
+
 // GetKubernetesClusterKubeconfig calls
 // kubernetes_cluster.v1.KubernetesClusterService.GetKubernetesClusterKubeconfig.
 func (c *kubernetesClusterServiceClient) GetKubernetesClusterKubeconfig(ctx context.Context, req *connect.Request[v1.GetKubernetesClusterKubeconfigRequest]) (*connect.Response[v1.GetKubernetesClusterKubeconfigResponse], error) {
@@ -168,6 +201,8 @@ type KubernetesClusterServiceHandler interface {
 	ListKubernetesClusters(context.Context, *connect.Request[v1.ListKubernetesClustersRequest]) (*connect.Response[v1.ListKubernetesClustersResponse], error)
 	UpdateKubernetesCluster(context.Context, *connect.Request[v1.UpdateKubernetesClusterRequest]) (*connect.Response[v1.UpdateKubernetesClusterResponse], error)
 	DeleteKubernetesCluster(context.Context, *connect.Request[v1.DeleteKubernetesClusterRequest]) (*connect.Response[v1.DeleteKubernetesClusterResponse], error)
+	GetRenderedArtifact(context.Context, *connect.Request[v1.GetRenderedArtifactRequest]) (*connect.Response[v1.GetRenderedArtifactResponse], error)
+	ExportRenderedArtifacts(context.Context, *connect.Request[v1.ExportRenderedArtifactsRequest]) (*connect.Response[v1.ExportRenderedArtifactsResponse], error)
 	GetKubernetesClusterKubeconfig(context.Context, *connect.Request[v1.GetKubernetesClusterKubeconfigRequest]) (*connect.Response[v1.GetKubernetesClusterKubeconfigResponse], error)
 }
 
@@ -208,6 +243,18 @@ func NewKubernetesClusterServiceHandler(svc KubernetesClusterServiceHandler, opt
 		connect.WithSchema(kubernetesClusterServiceMethods.ByName("DeleteKubernetesCluster")),
 		connect.WithHandlerOptions(opts...),
 	)
+	kubernetesClusterServiceGetRenderedArtifactHandler := connect.NewUnaryHandler(
+		KubernetesClusterServiceGetRenderedArtifactProcedure,
+		svc.GetRenderedArtifact,
+		connect.WithSchema(kubernetesClusterServiceMethods.ByName("GetRenderedArtifact")),
+		connect.WithHandlerOptions(opts...),
+	)
+	kubernetesClusterServiceExportRenderedArtifactsHandler := connect.NewUnaryHandler(
+		KubernetesClusterServiceExportRenderedArtifactsProcedure,
+		svc.ExportRenderedArtifacts,
+		connect.WithSchema(kubernetesClusterServiceMethods.ByName("ExportRenderedArtifacts")),
+		connect.WithHandlerOptions(opts...),
+	)
 	kubernetesClusterServiceGetKubernetesClusterKubeconfigHandler := connect.NewUnaryHandler(
 		KubernetesClusterServiceGetKubernetesClusterKubeconfigProcedure,
 		svc.GetKubernetesClusterKubeconfig,
@@ -226,6 +273,10 @@ func NewKubernetesClusterServiceHandler(svc KubernetesClusterServiceHandler, opt
 			kubernetesClusterServiceUpdateKubernetesClusterHandler.ServeHTTP(w, r)
 		case KubernetesClusterServiceDeleteKubernetesClusterProcedure:
 			kubernetesClusterServiceDeleteKubernetesClusterHandler.ServeHTTP(w, r)
+		case KubernetesClusterServiceGetRenderedArtifactProcedure:
+			kubernetesClusterServiceGetRenderedArtifactHandler.ServeHTTP(w, r)
+		case KubernetesClusterServiceExportRenderedArtifactsProcedure:
+			kubernetesClusterServiceExportRenderedArtifactsHandler.ServeHTTP(w, r)
 		case KubernetesClusterServiceGetKubernetesClusterKubeconfigProcedure:
 			kubernetesClusterServiceGetKubernetesClusterKubeconfigHandler.ServeHTTP(w, r)
 		default:
@@ -257,6 +308,14 @@ func (UnimplementedKubernetesClusterServiceHandler) DeleteKubernetesCluster(cont
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kubernetes_cluster.v1.KubernetesClusterService.DeleteKubernetesCluster is not implemented"))
 }
 
+func (UnimplementedKubernetesClusterServiceHandler) GetRenderedArtifact(context.Context, *connect.Request[v1.GetRenderedArtifactRequest]) (*connect.Response[v1.GetRenderedArtifactResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kubernetes_cluster.v1.KubernetesClusterService.GetRenderedArtifact is not implemented"))
+}
+
+func (UnimplementedKubernetesClusterServiceHandler) ExportRenderedArtifacts(context.Context, *connect.Request[v1.ExportRenderedArtifactsRequest]) (*connect.Response[v1.ExportRenderedArtifactsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kubernetes_cluster.v1.KubernetesClusterService.ExportRenderedArtifacts is not implemented"))
+}
+
 func (UnimplementedKubernetesClusterServiceHandler) GetKubernetesClusterKubeconfig(context.Context, *connect.Request[v1.GetKubernetesClusterKubeconfigRequest]) (*connect.Response[v1.GetKubernetesClusterKubeconfigResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kubernetes_cluster.v1.KubernetesClusterService.GetKubernetesClusterKubeconfig is not implemented"))
 }
@@ -0,0 +1,196 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: operations/v1/operations.proto
+
+package operationsv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/aa1ex/paas-provider/pkg/api/grpc/operations/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// OperationServiceName is the fully-qualified name of the OperationService service.
+	OperationServiceName = "operations.v1.OperationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// OperationServiceGetOperationProcedure is the fully-qualified name of the OperationService's
+	// GetOperation RPC.
+	OperationServiceGetOperationProcedure = "/operations.v1.OperationService/GetOperation"
+	// OperationServiceListOperationsProcedure is the fully-qualified name of the OperationService's
+	// ListOperations RPC.
+	OperationServiceListOperationsProcedure = "/operations.v1.OperationService/ListOperations"
+	// OperationServiceCancelOperationProcedure is the fully-qualified name of the OperationService's
+	// CancelOperation RPC.
+	OperationServiceCancelOperationProcedure = "/operations.v1.OperationService/CancelOperation"
+	// OperationServiceWaitOperationProcedure is the fully-qualified name of the OperationService's
+	// WaitOperation RPC.
+	OperationServiceWaitOperationProcedure = "/operations.v1.OperationService/WaitOperation"
+)
+
+// OperationServiceClient is a client for the operations.v1.OperationService service.
+type OperationServiceClient interface {
+	GetOperation(context.Context, *connect.Request[v1.GetOperationRequest]) (*connect.Response[v1.GetOperationResponse], error)
+	ListOperations(context.Context, *connect.Request[v1.ListOperationsRequest]) (*connect.Response[v1.ListOperationsResponse], error)
+	CancelOperation(context.Context, *connect.Request[v1.CancelOperationRequest]) (*connect.Response[v1.CancelOperationResponse], error)
+	WaitOperation(context.Context, *connect.Request[v1.WaitOperationRequest]) (*connect.Response[v1.WaitOperationResponse], error)
+}
+
+// NewOperationServiceClient constructs a client for the operations.v1.OperationService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewOperationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) OperationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	operationServiceMethods := v1.File_operations_v1_operations_proto.Services().ByName("OperationService").Methods()
+	return &operationServiceClient{
+		getOperation: connect.NewClient[v1.GetOperationRequest, v1.GetOperationResponse](
+			httpClient,
+			baseURL+OperationServiceGetOperationProcedure,
+			connect.WithSchema(operationServiceMethods.ByName("GetOperation")),
+			connect.WithClientOptions(opts...),
+		),
+		listOperations: connect.NewClient[v1.ListOperationsRequest, v1.ListOperationsResponse](
+			httpClient,
+			baseURL+OperationServiceListOperationsProcedure,
+			connect.WithSchema(operationServiceMethods.ByName("ListOperations")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelOperation: connect.NewClient[v1.CancelOperationRequest, v1.CancelOperationResponse](
+			httpClient,
+			baseURL+OperationServiceCancelOperationProcedure,
+			connect.WithSchema(operationServiceMethods.ByName("CancelOperation")),
+			connect.WithClientOptions(opts...),
+		),
+		waitOperation: connect.NewClient[v1.WaitOperationRequest, v1.WaitOperationResponse](
+			httpClient,
+			baseURL+OperationServiceWaitOperationProcedure,
+			connect.WithSchema(operationServiceMethods.ByName("WaitOperation")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// operationServiceClient implements OperationServiceClient.
+type operationServiceClient struct {
+	getOperation    *connect.Client[v1.GetOperationRequest, v1.GetOperationResponse]
+	listOperations  *connect.Client[v1.ListOperationsRequest, v1.ListOperationsResponse]
+	cancelOperation *connect.Client[v1.CancelOperationRequest, v1.CancelOperationResponse]
+	waitOperation   *connect.Client[v1.WaitOperationRequest, v1.WaitOperationResponse]
+}
+
+// GetOperation calls operations.v1.OperationService.GetOperation.
+func (c *operationServiceClient) GetOperation(ctx context.Context, req *connect.Request[v1.GetOperationRequest]) (*connect.Response[v1.GetOperationResponse], error) {
+	return c.getOperation.CallUnary(ctx, req)
+}
+
+// ListOperations calls operations.v1.OperationService.ListOperations.
+func (c *operationServiceClient) ListOperations(ctx context.Context, req *connect.Request[v1.ListOperationsRequest]) (*connect.Response[v1.ListOperationsResponse], error) {
+	return c.listOperations.CallUnary(ctx, req)
+}
+
+// CancelOperation calls operations.v1.OperationService.CancelOperation.
+func (c *operationServiceClient) CancelOperation(ctx context.Context, req *connect.Request[v1.CancelOperationRequest]) (*connect.Response[v1.CancelOperationResponse], error) {
+	return c.cancelOperation.CallUnary(ctx, req)
+}
+
+// WaitOperation calls operations.v1.OperationService.WaitOperation.
+func (c *operationServiceClient) WaitOperation(ctx context.Context, req *connect.Request[v1.WaitOperationRequest]) (*connect.Response[v1.WaitOperationResponse], error) {
+	return c.waitOperation.CallUnary(ctx, req)
+}
+
+// OperationServiceHandler is an implementation of the operations.v1.OperationService service.
+type OperationServiceHandler interface {
+	GetOperation(context.Context, *connect.Request[v1.GetOperationRequest]) (*connect.Response[v1.GetOperationResponse], error)
+	ListOperations(context.Context, *connect.Request[v1.ListOperationsRequest]) (*connect.Response[v1.ListOperationsResponse], error)
+	CancelOperation(context.Context, *connect.Request[v1.CancelOperationRequest]) (*connect.Response[v1.CancelOperationResponse], error)
+	WaitOperation(context.Context, *connect.Request[v1.WaitOperationRequest]) (*connect.Response[v1.WaitOperationResponse], error)
+}
+
+// NewOperationServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewOperationServiceHandler(svc OperationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	operationServiceMethods := v1.File_operations_v1_operations_proto.Services().ByName("OperationService").Methods()
+	operationServiceGetOperationHandler := connect.NewUnaryHandler(
+		OperationServiceGetOperationProcedure,
+		svc.GetOperation,
+		connect.WithSchema(operationServiceMethods.ByName("GetOperation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	operationServiceListOperationsHandler := connect.NewUnaryHandler(
+		OperationServiceListOperationsProcedure,
+		svc.ListOperations,
+		connect.WithSchema(operationServiceMethods.ByName("ListOperations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	operationServiceCancelOperationHandler := connect.NewUnaryHandler(
+		OperationServiceCancelOperationProcedure,
+		svc.CancelOperation,
+		connect.WithSchema(operationServiceMethods.ByName("CancelOperation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	operationServiceWaitOperationHandler := connect.NewUnaryHandler(
+		OperationServiceWaitOperationProcedure,
+		svc.WaitOperation,
+		connect.WithSchema(operationServiceMethods.ByName("WaitOperation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/operations.v1.OperationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case OperationServiceGetOperationProcedure:
+			operationServiceGetOperationHandler.ServeHTTP(w, r)
+		case OperationServiceListOperationsProcedure:
+			operationServiceListOperationsHandler.ServeHTTP(w, r)
+		case OperationServiceCancelOperationProcedure:
+			operationServiceCancelOperationHandler.ServeHTTP(w, r)
+		case OperationServiceWaitOperationProcedure:
+			operationServiceWaitOperationHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedOperationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedOperationServiceHandler struct{}
+
+func (UnimplementedOperationServiceHandler) GetOperation(context.Context, *connect.Request[v1.GetOperationRequest]) (*connect.Response[v1.GetOperationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("operations.v1.OperationService.GetOperation is not implemented"))
+}
+
+func (UnimplementedOperationServiceHandler) ListOperations(context.Context, *connect.Request[v1.ListOperationsRequest]) (*connect.Response[v1.ListOperationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("operations.v1.OperationService.ListOperations is not implemented"))
+}
+
+func (UnimplementedOperationServiceHandler) CancelOperation(context.Context, *connect.Request[v1.CancelOperationRequest]) (*connect.Response[v1.CancelOperationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("operations.v1.OperationService.CancelOperation is not implemented"))
+}
+
+func (UnimplementedOperationServiceHandler) WaitOperation(context.Context, *connect.Request[v1.WaitOperationRequest]) (*connect.Response[v1.WaitOperationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("operations.v1.OperationService.WaitOperation is not implemented"))
+}
@@ -48,6 +48,33 @@ const (
 	// TemplateServiceDeleteTemplateProcedure is the fully-qualified name of the TemplateService's
 	// DeleteTemplate RPC.
 	TemplateServiceDeleteTemplateProcedure = "/template.v1.TemplateService/DeleteTemplate"
+	// TemplateServiceListTemplateRevisionsProcedure is the fully-qualified name of the
+	// TemplateService's ListTemplateRevisions RPC.
+	TemplateServiceListTemplateRevisionsProcedure = "/template.v1.TemplateService/ListTemplateRevisions"
+	// TemplateServiceGetTemplateRevisionProcedure is the fully-qualified name of the TemplateService's
+	// GetTemplateRevision RPC.
+	TemplateServiceGetTemplateRevisionProcedure = "/template.v1.TemplateService/GetTemplateRevision"
+	// TemplateServiceRollbackTemplateProcedure is the fully-qualified name of the TemplateService's
+	// RollbackTemplate RPC.
+	TemplateServiceRollbackTemplateProcedure = "/template.v1.TemplateService/RollbackTemplate"
+	// TemplateServiceDescribeTemplateProcedure is the fully-qualified name of the TemplateService's
+	// DescribeTemplate RPC.
+	TemplateServiceDescribeTemplateProcedure = "/template.v1.TemplateService/DescribeTemplate"
+	// TemplateServiceRenderTemplateProcedure is the fully-qualified name of the TemplateService's
+	// RenderTemplate RPC.
+	TemplateServiceRenderTemplateProcedure = "/template.v1.TemplateService/RenderTemplate"
+	// TemplateServiceGetTemplateDependenciesProcedure is the fully-qualified name of the
+	// TemplateService's GetTemplateDependencies RPC.
+	TemplateServiceGetTemplateDependenciesProcedure = "/template.v1.TemplateService/GetTemplateDependencies"
+	// TemplateServiceGetTemplateCatalogStatusProcedure is the fully-qualified name of the
+	// TemplateService's GetTemplateCatalogStatus RPC.
+	TemplateServiceGetTemplateCatalogStatusProcedure = "/template.v1.TemplateService/GetTemplateCatalogStatus"
+	// TemplateServiceRerenderResourcesProcedure is the fully-qualified name of the TemplateService's
+	// RerenderResources RPC.
+	TemplateServiceRerenderResourcesProcedure = "/template.v1.TemplateService/RerenderResources"
+	// TemplateServiceGetDriftProcedure is the fully-qualified name of the TemplateService's GetDrift
+	// RPC.
+	TemplateServiceGetDriftProcedure = "/template.v1.TemplateService/GetDrift"
 )
 
 // TemplateServiceClient is a client for the template.v1.TemplateService service.
@@ -57,6 +84,15 @@ type TemplateServiceClient interface {
 	ListTemplates(context.Context, *connect.Request[v1.ListTemplatesRequest]) (*connect.Response[v1.ListTemplatesResponse], error)
 	UpdateTemplate(context.Context, *connect.Request[v1.UpdateTemplateRequest]) (*connect.Response[v1.UpdateTemplateResponse], error)
 	DeleteTemplate(context.Context, *connect.Request[v1.DeleteTemplateRequest]) (*connect.Response[v1.DeleteTemplateResponse], error)
+	ListTemplateRevisions(context.Context, *connect.Request[v1.ListTemplateRevisionsRequest]) (*connect.Response[v1.ListTemplateRevisionsResponse], error)
+	GetTemplateRevision(context.Context, *connect.Request[v1.GetTemplateRevisionRequest]) (*connect.Response[v1.GetTemplateRevisionResponse], error)
+	RollbackTemplate(context.Context, *connect.Request[v1.RollbackTemplateRequest]) (*connect.Response[v1.RollbackTemplateResponse], error)
+	DescribeTemplate(context.Context, *connect.Request[v1.DescribeTemplateRequest]) (*connect.Response[v1.DescribeTemplateResponse], error)
+	RenderTemplate(context.Context, *connect.Request[v1.RenderTemplateRequest]) (*connect.Response[v1.RenderTemplateResponse], error)
+	GetTemplateDependencies(context.Context, *connect.Request[v1.GetTemplateDependenciesRequest]) (*connect.Response[v1.GetTemplateDependenciesResponse], error)
+	GetTemplateCatalogStatus(context.Context, *connect.Request[v1.GetTemplateCatalogStatusRequest]) (*connect.Response[v1.GetTemplateCatalogStatusResponse], error)
+	RerenderResources(context.Context, *connect.Request[v1.RerenderResourcesRequest]) (*connect.Response[v1.RerenderResourcesResponse], error)
+	GetDrift(context.Context, *connect.Request[v1.GetDriftRequest]) (*connect.Response[v1.GetDriftResponse], error)
 }
 
 // NewTemplateServiceClient constructs a client for the template.v1.TemplateService service. By
@@ -100,16 +136,79 @@ func NewTemplateServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(templateServiceMethods.ByName("DeleteTemplate")),
 			connect.WithClientOptions(opts...),
 		),
+		listTemplateRevisions: connect.NewClient[v1.ListTemplateRevisionsRequest, v1.ListTemplateRevisionsResponse](
+			httpClient,
+			baseURL+TemplateServiceListTemplateRevisionsProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("ListTemplateRevisions")),
+			connect.WithClientOptions(opts...),
+		),
+		getTemplateRevision: connect.NewClient[v1.GetTemplateRevisionRequest, v1.GetTemplateRevisionResponse](
+			httpClient,
+			baseURL+TemplateServiceGetTemplateRevisionProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("GetTemplateRevision")),
+			connect.WithClientOptions(opts...),
+		),
+		rollbackTemplate: connect.NewClient[v1.RollbackTemplateRequest, v1.RollbackTemplateResponse](
+			httpClient,
+			baseURL+TemplateServiceRollbackTemplateProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("RollbackTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		describeTemplate: connect.NewClient[v1.DescribeTemplateRequest, v1.DescribeTemplateResponse](
+			httpClient,
+			baseURL+TemplateServiceDescribeTemplateProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("DescribeTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		renderTemplate: connect.NewClient[v1.RenderTemplateRequest, v1.RenderTemplateResponse](
+			httpClient,
+			baseURL+TemplateServiceRenderTemplateProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("RenderTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		getTemplateDependencies: connect.NewClient[v1.GetTemplateDependenciesRequest, v1.GetTemplateDependenciesResponse](
+			httpClient,
+			baseURL+TemplateServiceGetTemplateDependenciesProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("GetTemplateDependencies")),
+			connect.WithClientOptions(opts...),
+		),
+		getTemplateCatalogStatus: connect.NewClient[v1.GetTemplateCatalogStatusRequest, v1.GetTemplateCatalogStatusResponse](
+			httpClient,
+			baseURL+TemplateServiceGetTemplateCatalogStatusProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("GetTemplateCatalogStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		rerenderResources: connect.NewClient[v1.RerenderResourcesRequest, v1.RerenderResourcesResponse](
+			httpClient,
+			baseURL+TemplateServiceRerenderResourcesProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("RerenderResources")),
+			connect.WithClientOptions(opts...),
+		),
+		getDrift: connect.NewClient[v1.GetDriftRequest, v1.GetDriftResponse](
+			httpClient,
+			baseURL+TemplateServiceGetDriftProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("GetDrift")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // templateServiceClient implements TemplateServiceClient.
 type templateServiceClient struct {
-	createTemplate *connect.Client[v1.CreateTemplateRequest, v1.CreateTemplateResponse]
-	getTemplate    *connect.Client[v1.GetTemplateRequest, v1.GetTemplateResponse]
-	listTemplates  *connect.Client[v1.ListTemplatesRequest, v1.ListTemplatesResponse]
-	updateTemplate *connect.Client[v1.UpdateTemplateRequest, v1.UpdateTemplateResponse]
-	deleteTemplate *connect.Client[v1.DeleteTemplateRequest, v1.DeleteTemplateResponse]
+	createTemplate           *connect.Client[v1.CreateTemplateRequest, v1.CreateTemplateResponse]
+	getTemplate              *connect.Client[v1.GetTemplateRequest, v1.GetTemplateResponse]
+	listTemplates            *connect.Client[v1.ListTemplatesRequest, v1.ListTemplatesResponse]
+	updateTemplate           *connect.Client[v1.UpdateTemplateRequest, v1.UpdateTemplateResponse]
+	deleteTemplate           *connect.Client[v1.DeleteTemplateRequest, v1.DeleteTemplateResponse]
+	listTemplateRevisions    *connect.Client[v1.ListTemplateRevisionsRequest, v1.ListTemplateRevisionsResponse]
+	getTemplateRevision      *connect.Client[v1.GetTemplateRevisionRequest, v1.GetTemplateRevisionResponse]
+	rollbackTemplate         *connect.Client[v1.RollbackTemplateRequest, v1.RollbackTemplateResponse]
+	describeTemplate         *connect.Client[v1.DescribeTemplateRequest, v1.DescribeTemplateResponse]
+	renderTemplate           *connect.Client[v1.RenderTemplateRequest, v1.RenderTemplateResponse]
+	getTemplateDependencies  *connect.Client[v1.GetTemplateDependenciesRequest, v1.GetTemplateDependenciesResponse]
+	getTemplateCatalogStatus *connect.Client[v1.GetTemplateCatalogStatusRequest, v1.GetTemplateCatalogStatusResponse]
+	rerenderResources        *connect.Client[v1.RerenderResourcesRequest, v1.RerenderResourcesResponse]
+	getDrift                 *connect.Client[v1.GetDriftRequest, v1.GetDriftResponse]
 }
 
 // CreateTemplate calls template.v1.TemplateService.CreateTemplate.
@@ -137,6 +236,51 @@ func (c *templateServiceClient) DeleteTemplate(ctx context.Context, req *connect
 	return c.deleteTemplate.CallUnary(ctx, req)
 }
 
+// ListTemplateRevisions calls template.v1.TemplateService.ListTemplateRevisions.
+func (c *templateServiceClient) ListTemplateRevisions(ctx context.Context, req *connect.Request[v1.ListTemplateRevisionsRequest]) (*connect.Response[v1.ListTemplateRevisionsResponse], error) {
+	return c.listTemplateRevisions.CallUnary(ctx, req)
+}
+
+// GetTemplateRevision calls template.v1.TemplateService.GetTemplateRevision.
+func (c *templateServiceClient) GetTemplateRevision(ctx context.Context, req *connect.Request[v1.GetTemplateRevisionRequest]) (*connect.Response[v1.GetTemplateRevisionResponse], error) {
+	return c.getTemplateRevision.CallUnary(ctx, req)
+}
+
+// RollbackTemplate calls template.v1.TemplateService.RollbackTemplate.
+func (c *templateServiceClient) RollbackTemplate(ctx context.Context, req *connect.Request[v1.RollbackTemplateRequest]) (*connect.Response[v1.RollbackTemplateResponse], error) {
+	return c.rollbackTemplate.CallUnary(ctx, req)
+}
+
+// DescribeTemplate calls template.v1.TemplateService.DescribeTemplate.
+func (c *templateServiceClient) DescribeTemplate(ctx context.Context, req *connect.Request[v1.DescribeTemplateRequest]) (*connect.Response[v1.DescribeTemplateResponse], error) {
+	return c.describeTemplate.CallUnary(ctx, req)
+}
+
+// RenderTemplate calls template.v1.TemplateService.RenderTemplate.
+func (c *templateServiceClient) RenderTemplate(ctx context.Context, req *connect.Request[v1.RenderTemplateRequest]) (*connect.Response[v1.RenderTemplateResponse], error) {
+	return c.renderTemplate.CallUnary(ctx, req)
+}
+
+// GetTemplateDependencies calls template.v1.TemplateService.GetTemplateDependencies.
+func (c *templateServiceClient) GetTemplateDependencies(ctx context.Context, req *connect.Request[v1.GetTemplateDependenciesRequest]) (*connect.Response[v1.GetTemplateDependenciesResponse], error) {
+	return c.getTemplateDependencies.CallUnary(ctx, req)
+}
+
+// GetTemplateCatalogStatus calls template.v1.TemplateService.GetTemplateCatalogStatus.
+func (c *templateServiceClient) GetTemplateCatalogStatus(ctx context.Context, req *connect.Request[v1.GetTemplateCatalogStatusRequest]) (*connect.Response[v1.GetTemplateCatalogStatusResponse], error) {
+	return c.getTemplateCatalogStatus.CallUnary(ctx, req)
+}
+
+// RerenderResources calls template.v1.TemplateService.RerenderResources.
+func (c *templateServiceClient) RerenderResources(ctx context.Context, req *connect.Request[v1.RerenderResourcesRequest]) (*connect.Response[v1.RerenderResourcesResponse], error) {
+	return c.rerenderResources.CallUnary(ctx, req)
+}
+
+// GetDrift calls template.v1.TemplateService.GetDrift.
+func (c *templateServiceClient) GetDrift(ctx context.Context, req *connect.Request[v1.GetDriftRequest]) (*connect.Response[v1.GetDriftResponse], error) {
+	return c.getDrift.CallUnary(ctx, req)
+}
+
 // TemplateServiceHandler is an implementation of the template.v1.TemplateService service.
 type TemplateServiceHandler interface {
 	CreateTemplate(context.Context, *connect.Request[v1.CreateTemplateRequest]) (*connect.Response[v1.CreateTemplateResponse], error)
@@ -144,6 +288,15 @@ type TemplateServiceHandler interface {
 	ListTemplates(context.Context, *connect.Request[v1.ListTemplatesRequest]) (*connect.Response[v1.ListTemplatesResponse], error)
 	UpdateTemplate(context.Context, *connect.Request[v1.UpdateTemplateRequest]) (*connect.Response[v1.UpdateTemplateResponse], error)
 	DeleteTemplate(context.Context, *connect.Request[v1.DeleteTemplateRequest]) (*connect.Response[v1.DeleteTemplateResponse], error)
+	ListTemplateRevisions(context.Context, *connect.Request[v1.ListTemplateRevisionsRequest]) (*connect.Response[v1.ListTemplateRevisionsResponse], error)
+	GetTemplateRevision(context.Context, *connect.Request[v1.GetTemplateRevisionRequest]) (*connect.Response[v1.GetTemplateRevisionResponse], error)
+	RollbackTemplate(context.Context, *connect.Request[v1.RollbackTemplateRequest]) (*connect.Response[v1.RollbackTemplateResponse], error)
+	DescribeTemplate(context.Context, *connect.Request[v1.DescribeTemplateRequest]) (*connect.Response[v1.DescribeTemplateResponse], error)
+	RenderTemplate(context.Context, *connect.Request[v1.RenderTemplateRequest]) (*connect.Response[v1.RenderTemplateResponse], error)
+	GetTemplateDependencies(context.Context, *connect.Request[v1.GetTemplateDependenciesRequest]) (*connect.Response[v1.GetTemplateDependenciesResponse], error)
+	GetTemplateCatalogStatus(context.Context, *connect.Request[v1.GetTemplateCatalogStatusRequest]) (*connect.Response[v1.GetTemplateCatalogStatusResponse], error)
+	RerenderResources(context.Context, *connect.Request[v1.RerenderResourcesRequest]) (*connect.Response[v1.RerenderResourcesResponse], error)
+	GetDrift(context.Context, *connect.Request[v1.GetDriftRequest]) (*connect.Response[v1.GetDriftResponse], error)
 }
 
 // NewTemplateServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -183,6 +336,60 @@ func NewTemplateServiceHandler(svc TemplateServiceHandler, opts ...connect.Handl
 		connect.WithSchema(templateServiceMethods.ByName("DeleteTemplate")),
 		connect.WithHandlerOptions(opts...),
 	)
+	templateServiceListTemplateRevisionsHandler := connect.NewUnaryHandler(
+		TemplateServiceListTemplateRevisionsProcedure,
+		svc.ListTemplateRevisions,
+		connect.WithSchema(templateServiceMethods.ByName("ListTemplateRevisions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	templateServiceGetTemplateRevisionHandler := connect.NewUnaryHandler(
+		TemplateServiceGetTemplateRevisionProcedure,
+		svc.GetTemplateRevision,
+		connect.WithSchema(templateServiceMethods.ByName("GetTemplateRevision")),
+		connect.WithHandlerOptions(opts...),
+	)
+	templateServiceRollbackTemplateHandler := connect.NewUnaryHandler(
+		TemplateServiceRollbackTemplateProcedure,
+		svc.RollbackTemplate,
+		connect.WithSchema(templateServiceMethods.ByName("RollbackTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	templateServiceDescribeTemplateHandler := connect.NewUnaryHandler(
+		TemplateServiceDescribeTemplateProcedure,
+		svc.DescribeTemplate,
+		connect.WithSchema(templateServiceMethods.ByName("DescribeTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	templateServiceRenderTemplateHandler := connect.NewUnaryHandler(
+		TemplateServiceRenderTemplateProcedure,
+		svc.RenderTemplate,
+		connect.WithSchema(templateServiceMethods.ByName("RenderTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	templateServiceGetTemplateDependenciesHandler := connect.NewUnaryHandler(
+		TemplateServiceGetTemplateDependenciesProcedure,
+		svc.GetTemplateDependencies,
+		connect.WithSchema(templateServiceMethods.ByName("GetTemplateDependencies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	templateServiceGetTemplateCatalogStatusHandler := connect.NewUnaryHandler(
+		TemplateServiceGetTemplateCatalogStatusProcedure,
+		svc.GetTemplateCatalogStatus,
+		connect.WithSchema(templateServiceMethods.ByName("GetTemplateCatalogStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	templateServiceRerenderResourcesHandler := connect.NewUnaryHandler(
+		TemplateServiceRerenderResourcesProcedure,
+		svc.RerenderResources,
+		connect.WithSchema(templateServiceMethods.ByName("RerenderResources")),
+		connect.WithHandlerOptions(opts...),
+	)
+	templateServiceGetDriftHandler := connect.NewUnaryHandler(
+		TemplateServiceGetDriftProcedure,
+		svc.GetDrift,
+		connect.WithSchema(templateServiceMethods.ByName("GetDrift")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/template.v1.TemplateService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TemplateServiceCreateTemplateProcedure:
@@ -195,6 +402,24 @@ func NewTemplateServiceHandler(svc TemplateServiceHandler, opts ...connect.Handl
 			templateServiceUpdateTemplateHandler.ServeHTTP(w, r)
 		case TemplateServiceDeleteTemplateProcedure:
 			templateServiceDeleteTemplateHandler.ServeHTTP(w, r)
+		case TemplateServiceListTemplateRevisionsProcedure:
+			templateServiceListTemplateRevisionsHandler.ServeHTTP(w, r)
+		case TemplateServiceGetTemplateRevisionProcedure:
+			templateServiceGetTemplateRevisionHandler.ServeHTTP(w, r)
+		case TemplateServiceRollbackTemplateProcedure:
+			templateServiceRollbackTemplateHandler.ServeHTTP(w, r)
+		case TemplateServiceDescribeTemplateProcedure:
+			templateServiceDescribeTemplateHandler.ServeHTTP(w, r)
+		case TemplateServiceRenderTemplateProcedure:
+			templateServiceRenderTemplateHandler.ServeHTTP(w, r)
+		case TemplateServiceGetTemplateDependenciesProcedure:
+			templateServiceGetTemplateDependenciesHandler.ServeHTTP(w, r)
+		case TemplateServiceGetTemplateCatalogStatusProcedure:
+			templateServiceGetTemplateCatalogStatusHandler.ServeHTTP(w, r)
+		case TemplateServiceRerenderResourcesProcedure:
+			templateServiceRerenderResourcesHandler.ServeHTTP(w, r)
+		case TemplateServiceGetDriftProcedure:
+			templateServiceGetDriftHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -223,3 +448,39 @@ func (UnimplementedTemplateServiceHandler) UpdateTemplate(context.Context, *conn
 func (UnimplementedTemplateServiceHandler) DeleteTemplate(context.Context, *connect.Request[v1.DeleteTemplateRequest]) (*connect.Response[v1.DeleteTemplateResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("template.v1.TemplateService.DeleteTemplate is not implemented"))
 }
+
+func (UnimplementedTemplateServiceHandler) ListTemplateRevisions(context.Context, *connect.Request[v1.ListTemplateRevisionsRequest]) (*connect.Response[v1.ListTemplateRevisionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("template.v1.TemplateService.ListTemplateRevisions is not implemented"))
+}
+
+func (UnimplementedTemplateServiceHandler) GetTemplateRevision(context.Context, *connect.Request[v1.GetTemplateRevisionRequest]) (*connect.Response[v1.GetTemplateRevisionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("template.v1.TemplateService.GetTemplateRevision is not implemented"))
+}
+
+func (UnimplementedTemplateServiceHandler) RollbackTemplate(context.Context, *connect.Request[v1.RollbackTemplateRequest]) (*connect.Response[v1.RollbackTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("template.v1.TemplateService.RollbackTemplate is not implemented"))
+}
+
+func (UnimplementedTemplateServiceHandler) DescribeTemplate(context.Context, *connect.Request[v1.DescribeTemplateRequest]) (*connect.Response[v1.DescribeTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("template.v1.TemplateService.DescribeTemplate is not implemented"))
+}
+
+func (UnimplementedTemplateServiceHandler) RenderTemplate(context.Context, *connect.Request[v1.RenderTemplateRequest]) (*connect.Response[v1.RenderTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("template.v1.TemplateService.RenderTemplate is not implemented"))
+}
+
+func (UnimplementedTemplateServiceHandler) GetTemplateDependencies(context.Context, *connect.Request[v1.GetTemplateDependenciesRequest]) (*connect.Response[v1.GetTemplateDependenciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("template.v1.TemplateService.GetTemplateDependencies is not implemented"))
+}
+
+func (UnimplementedTemplateServiceHandler) GetTemplateCatalogStatus(context.Context, *connect.Request[v1.GetTemplateCatalogStatusRequest]) (*connect.Response[v1.GetTemplateCatalogStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("template.v1.TemplateService.GetTemplateCatalogStatus is not implemented"))
+}
+
+func (UnimplementedTemplateServiceHandler) RerenderResources(context.Context, *connect.Request[v1.RerenderResourcesRequest]) (*connect.Response[v1.RerenderResourcesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("template.v1.TemplateService.RerenderResources is not implemented"))
+}
+
+func (UnimplementedTemplateServiceHandler) GetDrift(context.Context, *connect.Request[v1.GetDriftRequest]) (*connect.Response[v1.GetDriftResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("template.v1.TemplateService.GetDrift is not implemented"))
+}
@@ -48,6 +48,36 @@ const (
 	// VirtualMachineServiceDeleteVirtualMachineProcedure is the fully-qualified name of the
 	// VirtualMachineService's DeleteVirtualMachine RPC.
 	VirtualMachineServiceDeleteVirtualMachineProcedure = "/virtual_machine.v1.VirtualMachineService/DeleteVirtualMachine"
+	// VirtualMachineServiceGetRenderedArtifactProcedure is the fully-qualified name of the
+	// VirtualMachineService's GetRenderedArtifact RPC.
+	VirtualMachineServiceGetRenderedArtifactProcedure = "/virtual_machine.v1.VirtualMachineService/GetRenderedArtifact"
+	// VirtualMachineServiceExportRenderedArtifactsProcedure is the fully-qualified name of the
+	// VirtualMachineService's ExportRenderedArtifacts RPC.
+	VirtualMachineServiceExportRenderedArtifactsProcedure = "/virtual_machine.v1.VirtualMachineService/ExportRenderedArtifacts"
+	// VirtualMachineServiceStartVirtualMachineProcedure is the fully-qualified name of the
+	// VirtualMachineService's StartVirtualMachine RPC.
+	VirtualMachineServiceStartVirtualMachineProcedure = "/virtual_machine.v1.VirtualMachineService/StartVirtualMachine"
+	// VirtualMachineServiceStopVirtualMachineProcedure is the fully-qualified name of the
+	// VirtualMachineService's StopVirtualMachine RPC.
+	VirtualMachineServiceStopVirtualMachineProcedure = "/virtual_machine.v1.VirtualMachineService/StopVirtualMachine"
+	// VirtualMachineServiceRestartVirtualMachineProcedure is the fully-qualified name of the
+	// VirtualMachineService's RestartVirtualMachine RPC.
+	VirtualMachineServiceRestartVirtualMachineProcedure = "/virtual_machine.v1.VirtualMachineService/RestartVirtualMachine"
+	// VirtualMachineServiceSuspendVirtualMachineProcedure is the fully-qualified name of the
+	// VirtualMachineService's SuspendVirtualMachine RPC.
+	VirtualMachineServiceSuspendVirtualMachineProcedure = "/virtual_machine.v1.VirtualMachineService/SuspendVirtualMachine"
+	// VirtualMachineServiceCreateVirtualMachineSnapshotProcedure is the fully-qualified name of the
+	// VirtualMachineService's CreateVirtualMachineSnapshot RPC.
+	VirtualMachineServiceCreateVirtualMachineSnapshotProcedure = "/virtual_machine.v1.VirtualMachineService/CreateVirtualMachineSnapshot"
+	// VirtualMachineServiceListVirtualMachineSnapshotsProcedure is the fully-qualified name of the
+	// VirtualMachineService's ListVirtualMachineSnapshots RPC.
+	VirtualMachineServiceListVirtualMachineSnapshotsProcedure = "/virtual_machine.v1.VirtualMachineService/ListVirtualMachineSnapshots"
+	// VirtualMachineServiceRestoreVirtualMachineSnapshotProcedure is the fully-qualified name of the
+	// VirtualMachineService's RestoreVirtualMachineSnapshot RPC.
+	VirtualMachineServiceRestoreVirtualMachineSnapshotProcedure = "/virtual_machine.v1.VirtualMachineService/RestoreVirtualMachineSnapshot"
+	// VirtualMachineServiceDeleteVirtualMachineSnapshotProcedure is the fully-qualified name of the
+	// VirtualMachineService's DeleteVirtualMachineSnapshot RPC.
+	VirtualMachineServiceDeleteVirtualMachineSnapshotProcedure = "/virtual_machine.v1.VirtualMachineService/DeleteVirtualMachineSnapshot"
 )
 
 // VirtualMachineServiceClient is a client for the virtual_machine.v1.VirtualMachineService service.
@@ -57,6 +87,20 @@ type VirtualMachineServiceClient interface {
 	ListVirtualMachines(context.Context, *connect.Request[v1.ListVirtualMachinesRequest]) (*connect.Response[v1.ListVirtualMachinesResponse], error)
 	UpdateVirtualMachine(context.Context, *connect.Request[v1.UpdateVirtualMachineRequest]) (*connect.Response[v1.UpdateVirtualMachineResponse], error)
 	DeleteVirtualMachine(context.Context, *connect.Request[v1.DeleteVirtualMachineRequest]) (*connect.Response[v1.DeleteVirtualMachineResponse], error)
+	GetRenderedArtifact(context.Context, *connect.Request[v1.GetRenderedArtifactRequest]) (*connect.Response[v1.GetRenderedArtifactResponse], error)
+	ExportRenderedArtifacts(context.Context, *connect.Request[v1.ExportRenderedArtifactsRequest]) (*connect.Response[v1.ExportRenderedArtifactsResponse], error)
+	// Power actions. They are rejected with FAILED_PRECONDITION unless the
+	// virtual machine is in a status the action can start from.
+	StartVirtualMachine(context.Context, *connect.Request[v1.StartVirtualMachineRequest]) (*connect.Response[v1.StartVirtualMachineResponse], error)
+	StopVirtualMachine(context.Context, *connect.Request[v1.StopVirtualMachineRequest]) (*connect.Response[v1.StopVirtualMachineResponse], error)
+	RestartVirtualMachine(context.Context, *connect.Request[v1.RestartVirtualMachineRequest]) (*connect.Response[v1.RestartVirtualMachineResponse], error)
+	SuspendVirtualMachine(context.Context, *connect.Request[v1.SuspendVirtualMachineRequest]) (*connect.Response[v1.SuspendVirtualMachineResponse], error)
+	// Snapshots capture the configuration and rendered artifacts of a virtual
+	// machine, so that it can be restored to them later
+	CreateVirtualMachineSnapshot(context.Context, *connect.Request[v1.CreateVirtualMachineSnapshotRequest]) (*connect.Response[v1.CreateVirtualMachineSnapshotResponse], error)
+	ListVirtualMachineSnapshots(context.Context, *connect.Request[v1.ListVirtualMachineSnapshotsRequest]) (*connect.Response[v1.ListVirtualMachineSnapshotsResponse], error)
+	RestoreVirtualMachineSnapshot(context.Context, *connect.Request[v1.RestoreVirtualMachineSnapshotRequest]) (*connect.Response[v1.RestoreVirtualMachineSnapshotResponse], error)
+	DeleteVirtualMachineSnapshot(context.Context, *connect.Request[v1.DeleteVirtualMachineSnapshotRequest]) (*connect.Response[v1.DeleteVirtualMachineSnapshotResponse], error)
 }
 
 // NewVirtualMachineServiceClient constructs a client for the
@@ -100,16 +144,86 @@ func NewVirtualMachineServiceClient(httpClient connect.HTTPClient, baseURL strin
 			connect.WithSchema(virtualMachineServiceMethods.ByName("DeleteVirtualMachine")),
 			connect.WithClientOptions(opts...),
 		),
+		getRenderedArtifact: connect.NewClient[v1.GetRenderedArtifactRequest, v1.GetRenderedArtifactResponse](
+			httpClient,
+			baseURL+VirtualMachineServiceGetRenderedArtifactProcedure,
+			connect.WithSchema(virtualMachineServiceMethods.ByName("GetRenderedArtifact")),
+			connect.WithClientOptions(opts...),
+		),
+		exportRenderedArtifacts: connect.NewClient[v1.ExportRenderedArtifactsRequest, v1.ExportRenderedArtifactsResponse](
+			httpClient,
+			baseURL+VirtualMachineServiceExportRenderedArtifactsProcedure,
+			connect.WithSchema(virtualMachineServiceMethods.ByName("ExportRenderedArtifacts")),
+			connect.WithClientOptions(opts...),
+		),
+		startVirtualMachine: connect.NewClient[v1.StartVirtualMachineRequest, v1.StartVirtualMachineResponse](
+			httpClient,
+			baseURL+VirtualMachineServiceStartVirtualMachineProcedure,
+			connect.WithSchema(virtualMachineServiceMethods.ByName("StartVirtualMachine")),
+			connect.WithClientOptions(opts...),
+		),
+		stopVirtualMachine: connect.NewClient[v1.StopVirtualMachineRequest, v1.StopVirtualMachineResponse](
+			httpClient,
+			baseURL+VirtualMachineServiceStopVirtualMachineProcedure,
+			connect.WithSchema(virtualMachineServiceMethods.ByName("StopVirtualMachine")),
+			connect.WithClientOptions(opts...),
+		),
+		restartVirtualMachine: connect.NewClient[v1.RestartVirtualMachineRequest, v1.RestartVirtualMachineResponse](
+			httpClient,
+			baseURL+VirtualMachineServiceRestartVirtualMachineProcedure,
+			connect.WithSchema(virtualMachineServiceMethods.ByName("RestartVirtualMachine")),
+			connect.WithClientOptions(opts...),
+		),
+		suspendVirtualMachine: connect.NewClient[v1.SuspendVirtualMachineRequest, v1.SuspendVirtualMachineResponse](
+			httpClient,
+			baseURL+VirtualMachineServiceSuspendVirtualMachineProcedure,
+			connect.WithSchema(virtualMachineServiceMethods.ByName("SuspendVirtualMachine")),
+			connect.WithClientOptions(opts...),
+		),
+		createVirtualMachineSnapshot: connect.NewClient[v1.CreateVirtualMachineSnapshotRequest, v1.CreateVirtualMachineSnapshotResponse](
+			httpClient,
+			baseURL+VirtualMachineServiceCreateVirtualMachineSnapshotProcedure,
+			connect.WithSchema(virtualMachineServiceMethods.ByName("CreateVirtualMachineSnapshot")),
+			connect.WithClientOptions(opts...),
+		),
+		listVirtualMachineSnapshots: connect.NewClient[v1.ListVirtualMachineSnapshotsRequest, v1.ListVirtualMachineSnapshotsResponse](
+			httpClient,
+			baseURL+VirtualMachineServiceListVirtualMachineSnapshotsProcedure,
+			connect.WithSchema(virtualMachineServiceMethods.ByName("ListVirtualMachineSnapshots")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreVirtualMachineSnapshot: connect.NewClient[v1.RestoreVirtualMachineSnapshotRequest, v1.RestoreVirtualMachineSnapshotResponse](
+			httpClient,
+			baseURL+VirtualMachineServiceRestoreVirtualMachineSnapshotProcedure,
+			connect.WithSchema(virtualMachineServiceMethods.ByName("RestoreVirtualMachineSnapshot")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteVirtualMachineSnapshot: connect.NewClient[v1.DeleteVirtualMachineSnapshotRequest, v1.DeleteVirtualMachineSnapshotResponse](
+			httpClient,
+			baseURL+VirtualMachineServiceDeleteVirtualMachineSnapshotProcedure,
+			connect.WithSchema(virtualMachineServiceMethods.ByName("DeleteVirtualMachineSnapshot")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // virtualMachineServiceClient implements VirtualMachineServiceClient.
 type virtualMachineServiceClient struct {
-	createVirtualMachine *connect.Client[v1.CreateVirtualMachineRequest, v1.CreateVirtualMachineResponse]
-	getVirtualMachine    *connect.Client[v1.GetVirtualMachineRequest, v1.GetVirtualMachineResponse]
-	listVirtualMachines  *connect.Client[v1.ListVirtualMachinesRequest, v1.ListVirtualMachinesResponse]
-	updateVirtualMachine *connect.Client[v1.UpdateVirtualMachineRequest, v1.UpdateVirtualMachineResponse]
-	deleteVirtualMachine *connect.Client[v1.DeleteVirtualMachineRequest, v1.DeleteVirtualMachineResponse]
+	createVirtualMachine          *connect.Client[v1.CreateVirtualMachineRequest, v1.CreateVirtualMachineResponse]
+	getVirtualMachine             *connect.Client[v1.GetVirtualMachineRequest, v1.GetVirtualMachineResponse]
+	listVirtualMachines           *connect.Client[v1.ListVirtualMachinesRequest, v1.ListVirtualMachinesResponse]
+	updateVirtualMachine          *connect.Client[v1.UpdateVirtualMachineRequest, v1.UpdateVirtualMachineResponse]
+	deleteVirtualMachine          *connect.Client[v1.DeleteVirtualMachineRequest, v1.DeleteVirtualMachineResponse]
+	getRenderedArtifact           *connect.Client[v1.GetRenderedArtifactRequest, v1.GetRenderedArtifactResponse]
+	exportRenderedArtifacts       *connect.Client[v1.ExportRenderedArtifactsRequest, v1.ExportRenderedArtifactsResponse]
+	startVirtualMachine           *connect.Client[v1.StartVirtualMachineRequest, v1.StartVirtualMachineResponse]
+	stopVirtualMachine            *connect.Client[v1.StopVirtualMachineRequest, v1.StopVirtualMachineResponse]
+	restartVirtualMachine         *connect.Client[v1.RestartVirtualMachineRequest, v1.RestartVirtualMachineResponse]
+	suspendVirtualMachine         *connect.Client[v1.SuspendVirtualMachineRequest, v1.SuspendVirtualMachineResponse]
+	createVirtualMachineSnapshot  *connect.Client[v1.CreateVirtualMachineSnapshotRequest, v1.CreateVirtualMachineSnapshotResponse]
+	listVirtualMachineSnapshots   *connect.Client[v1.ListVirtualMachineSnapshotsRequest, v1.ListVirtualMachineSnapshotsResponse]
+	restoreVirtualMachineSnapshot *connect.Client[v1.RestoreVirtualMachineSnapshotRequest, v1.RestoreVirtualMachineSnapshotResponse]
+	deleteVirtualMachineSnapshot  *connect.Client[v1.DeleteVirtualMachineSnapshotRequest, v1.DeleteVirtualMachineSnapshotResponse]
 }
 
 // CreateVirtualMachine calls virtual_machine.v1.VirtualMachineService.CreateVirtualMachine.
@@ -137,6 +251,60 @@ func (c *virtualMachineServiceClient) DeleteVirtualMachine(ctx context.Context,
 	return c.deleteVirtualMachine.CallUnary(ctx, req)
 }
 
+// GetRenderedArtifact calls virtual_machine.v1.VirtualMachineService.GetRenderedArtifact.
+func (c *virtualMachineServiceClient) GetRenderedArtifact(ctx context.Context, req *connect.Request[v1.GetRenderedArtifactRequest]) (*connect.Response[v1.GetRenderedArtifactResponse], error) {
+	return c.getRenderedArtifact.CallUnary(ctx, req)
+}
+
+// ExportRenderedArtifacts calls virtual_machine.v1.VirtualMachineService.ExportRenderedArtifacts.
+func (c *virtualMachineServiceClient) ExportRenderedArtifacts(ctx context.Context, req *connect.Request[v1.ExportRenderedArtifactsRequest]) (*connect.Response[v1.ExportRenderedArtifactsResponse], error) {
+	return c.exportRenderedArtifacts.CallUnary(ctx, req)
+}
+
+// StartVirtualMachine calls virtual_machine.v1.VirtualMachineService.StartVirtualMachine.
+func (c *virtualMachineServiceClient) StartVirtualMachine(ctx context.Context, req *connect.Request[v1.StartVirtualMachineRequest]) (*connect.Response[v1.StartVirtualMachineResponse], error) {
+	return c.startVirtualMachine.CallUnary(ctx, req)
+}
+
+// StopVirtualMachine calls virtual_machine.v1.VirtualMachineService.StopVirtualMachine.
+func (c *virtualMachineServiceClient) StopVirtualMachine(ctx context.Context, req *connect.Request[v1.StopVirtualMachineRequest]) (*connect.Response[v1.StopVirtualMachineResponse], error) {
+	return c.stopVirtualMachine.CallUnary(ctx, req)
+}
+
+// RestartVirtualMachine calls virtual_machine.v1.VirtualMachineService.RestartVirtualMachine.
+func (c *virtualMachineServiceClient) RestartVirtualMachine(ctx context.Context, req *connect.Request[v1.RestartVirtualMachineRequest]) (*connect.Response[v1.RestartVirtualMachineResponse], error) {
+	return c.restartVirtualMachine.CallUnary(ctx, req)
+}
+
+// SuspendVirtualMachine calls virtual_machine.v1.VirtualMachineService.SuspendVirtualMachine.
+func (c *virtualMachineServiceClient) SuspendVirtualMachine(ctx context.Context, req *connect.Request[v1.SuspendVirtualMachineRequest]) (*connect.Response[v1.SuspendVirtualMachineResponse], error) {
+	return c.suspendVirtualMachine.CallUnary(ctx, req)
+}
+
+// CreateVirtualMachineSnapshot calls
+// virtual_machine.v1.VirtualMachineService.CreateVirtualMachineSnapshot.
+func (c *virtualMachineServiceClient) CreateVirtualMachineSnapshot(ctx context.Context, req *connect.Request[v1.CreateVirtualMachineSnapshotRequest]) (*connect.Response[v1.CreateVirtualMachineSnapshotResponse], error) {
+	return c.createVirtualMachineSnapshot.CallUnary(ctx, req)
+}
+
+// ListVirtualMachineSnapshots calls
+// virtual_machine.v1.VirtualMachineService.ListVirtualMachineSnapshots.
+func (c *virtualMachineServiceClient) ListVirtualMachineSnapshots(ctx context.Context, req *connect.Request[v1.ListVirtualMachineSnapshotsRequest]) (*connect.Response[v1.ListVirtualMachineSnapshotsResponse], error) {
+	return c.listVirtualMachineSnapshots.CallUnary(ctx, req)
+}
+
+// RestoreVirtualMachineSnapshot calls
+// virtual_machine.v1.VirtualMachineService.RestoreVirtualMachineSnapshot.
+func (c *virtualMachineServiceClient) RestoreVirtualMachineSnapshot(ctx context.Context, req *connect.Request[v1.RestoreVirtualMachineSnapshotRequest]) (*connect.Response[v1.RestoreVirtualMachineSnapshotResponse], error) {
+	return c.restoreVirtualMachineSnapshot.CallUnary(ctx, req)
+}
+
+// DeleteVirtualMachineSnapshot calls
+// virtual_machine.v1.VirtualMachineService.DeleteVirtualMachineSnapshot.
+func (c *virtualMachineServiceClient) DeleteVirtualMachineSnapshot(ctx context.Context, req *connect.Request[v1.DeleteVirtualMachineSnapshotRequest]) (*connect.Response[v1.DeleteVirtualMachineSnapshotResponse], error) {
+	return c.deleteVirtualMachineSnapshot.CallUnary(ctx, req)
+}
+
 // VirtualMachineServiceHandler is an implementation of the virtual_machine.v1.VirtualMachineService
 // service.
 type VirtualMachineServiceHandler interface {
@@ -145,6 +313,20 @@ type VirtualMachineServiceHandler interface {
 	ListVirtualMachines(context.Context, *connect.Request[v1.ListVirtualMachinesRequest]) (*connect.Response[v1.ListVirtualMachinesResponse], error)
 	UpdateVirtualMachine(context.Context, *connect.Request[v1.UpdateVirtualMachineRequest]) (*connect.Response[v1.UpdateVirtualMachineResponse], error)
 	DeleteVirtualMachine(context.Context, *connect.Request[v1.DeleteVirtualMachineRequest]) (*connect.Response[v1.DeleteVirtualMachineResponse], error)
+	GetRenderedArtifact(context.Context, *connect.Request[v1.GetRenderedArtifactRequest]) (*connect.Response[v1.GetRenderedArtifactResponse], error)
+	ExportRenderedArtifacts(context.Context, *connect.Request[v1.ExportRenderedArtifactsRequest]) (*connect.Response[v1.ExportRenderedArtifactsResponse], error)
+	// Power actions. They are rejected with FAILED_PRECONDITION unless the
+	// virtual machine is in a status the action can start from.
+	StartVirtualMachine(context.Context, *connect.Request[v1.StartVirtualMachineRequest]) (*connect.Response[v1.StartVirtualMachineResponse], error)
+	StopVirtualMachine(context.Context, *connect.Request[v1.StopVirtualMachineRequest]) (*connect.Response[v1.StopVirtualMachineResponse], error)
+	RestartVirtualMachine(context.Context, *connect.Request[v1.RestartVirtualMachineRequest]) (*connect.Response[v1.RestartVirtualMachineResponse], error)
+	SuspendVirtualMachine(context.Context, *connect.Request[v1.SuspendVirtualMachineRequest]) (*connect.Response[v1.SuspendVirtualMachineResponse], error)
+	// Snapshots capture the configuration and rendered artifacts of a virtual
+	// machine, so that it can be restored to them later
+	CreateVirtualMachineSnapshot(context.Context, *connect.Request[v1.CreateVirtualMachineSnapshotRequest]) (*connect.Response[v1.CreateVirtualMachineSnapshotResponse], error)
+	ListVirtualMachineSnapshots(context.Context, *connect.Request[v1.ListVirtualMachineSnapshotsRequest]) (*connect.Response[v1.ListVirtualMachineSnapshotsResponse], error)
+	RestoreVirtualMachineSnapshot(context.Context, *connect.Request[v1.RestoreVirtualMachineSnapshotRequest]) (*connect.Response[v1.RestoreVirtualMachineSnapshotResponse], error)
+	DeleteVirtualMachineSnapshot(context.Context, *connect.Request[v1.DeleteVirtualMachineSnapshotRequest]) (*connect.Response[v1.DeleteVirtualMachineSnapshotResponse], error)
 }
 
 // NewVirtualMachineServiceHandler builds an HTTP handler from the service implementation. It
@@ -184,6 +366,66 @@ func NewVirtualMachineServiceHandler(svc VirtualMachineServiceHandler, opts ...c
 		connect.WithSchema(virtualMachineServiceMethods.ByName("DeleteVirtualMachine")),
 		connect.WithHandlerOptions(opts...),
 	)
+	virtualMachineServiceGetRenderedArtifactHandler := connect.NewUnaryHandler(
+		VirtualMachineServiceGetRenderedArtifactProcedure,
+		svc.GetRenderedArtifact,
+		connect.WithSchema(virtualMachineServiceMethods.ByName("GetRenderedArtifact")),
+		connect.WithHandlerOptions(opts...),
+	)
+	virtualMachineServiceExportRenderedArtifactsHandler := connect.NewUnaryHandler(
+		VirtualMachineServiceExportRenderedArtifactsProcedure,
+		svc.ExportRenderedArtifacts,
+		connect.WithSchema(virtualMachineServiceMethods.ByName("ExportRenderedArtifacts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	virtualMachineServiceStartVirtualMachineHandler := connect.NewUnaryHandler(
+		VirtualMachineServiceStartVirtualMachineProcedure,
+		svc.StartVirtualMachine,
+		connect.WithSchema(virtualMachineServiceMethods.ByName("StartVirtualMachine")),
+		connect.WithHandlerOptions(opts...),
+	)
+	virtualMachineServiceStopVirtualMachineHandler := connect.NewUnaryHandler(
+		VirtualMachineServiceStopVirtualMachineProcedure,
+		svc.StopVirtualMachine,
+		connect.WithSchema(virtualMachineServiceMethods.ByName("StopVirtualMachine")),
+		connect.WithHandlerOptions(opts...),
+	)
+	virtualMachineServiceRestartVirtualMachineHandler := connect.NewUnaryHandler(
+		VirtualMachineServiceRestartVirtualMachineProcedure,
+		svc.RestartVirtualMachine,
+		connect.WithSchema(virtualMachineServiceMethods.ByName("RestartVirtualMachine")),
+		connect.WithHandlerOptions(opts...),
+	)
+	virtualMachineServiceSuspendVirtualMachineHandler := connect.NewUnaryHandler(
+		VirtualMachineServiceSuspendVirtualMachineProcedure,
+		svc.SuspendVirtualMachine,
+		connect.WithSchema(virtualMachineServiceMethods.ByName("SuspendVirtualMachine")),
+		connect.WithHandlerOptions(opts...),
+	)
+	virtualMachineServiceCreateVirtualMachineSnapshotHandler := connect.NewUnaryHandler(
+		VirtualMachineServiceCreateVirtualMachineSnapshotProcedure,
+		svc.CreateVirtualMachineSnapshot,
+		connect.WithSchema(virtualMachineServiceMethods.ByName("CreateVirtualMachineSnapshot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	virtualMachineServiceListVirtualMachineSnapshotsHandler := connect.NewUnaryHandler(
+		VirtualMachineServiceListVirtualMachineSnapshotsProcedure,
+		svc.ListVirtualMachineSnapshots,
+		connect.WithSchema(virtualMachineServiceMethods.ByName("ListVirtualMachineSnapshots")),
+		connect.WithHandlerOptions(opts...),
+	)
+	virtualMachineServiceRestoreVirtualMachineSnapshotHandler := connect.NewUnaryHandler(
+		VirtualMachineServiceRestoreVirtualMachineSnapshotProcedure,
+		svc.RestoreVirtualMachineSnapshot,
+		connect.WithSchema(virtualMachineServiceMethods.ByName("RestoreVirtualMachineSnapshot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	virtualMachineServiceDeleteVirtualMachineSnapshotHandler := connect.NewUnaryHandler(
+		VirtualMachineServiceDeleteVirtualMachineSnapshotProcedure,
+		svc.DeleteVirtualMachineSnapshot,
+		connect.WithSchema(virtualMachineServiceMethods.ByName("DeleteVirtualMachineSnapshot")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/virtual_machine.v1.VirtualMachineService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case VirtualMachineServiceCreateVirtualMachineProcedure:
@@ -196,6 +438,26 @@ func NewVirtualMachineServiceHandler(svc VirtualMachineServiceHandler, opts ...c
 			virtualMachineServiceUpdateVirtualMachineHandler.ServeHTTP(w, r)
 		case VirtualMachineServiceDeleteVirtualMachineProcedure:
 			virtualMachineServiceDeleteVirtualMachineHandler.ServeHTTP(w, r)
+		case VirtualMachineServiceGetRenderedArtifactProcedure:
+			virtualMachineServiceGetRenderedArtifactHandler.ServeHTTP(w, r)
+		case VirtualMachineServiceExportRenderedArtifactsProcedure:
+			virtualMachineServiceExportRenderedArtifactsHandler.ServeHTTP(w, r)
+		case VirtualMachineServiceStartVirtualMachineProcedure:
+			virtualMachineServiceStartVirtualMachineHandler.ServeHTTP(w, r)
+		case VirtualMachineServiceStopVirtualMachineProcedure:
+			virtualMachineServiceStopVirtualMachineHandler.ServeHTTP(w, r)
+		case VirtualMachineServiceRestartVirtualMachineProcedure:
+			virtualMachineServiceRestartVirtualMachineHandler.ServeHTTP(w, r)
+		case VirtualMachineServiceSuspendVirtualMachineProcedure:
+			virtualMachineServiceSuspendVirtualMachineHandler.ServeHTTP(w, r)
+		case VirtualMachineServiceCreateVirtualMachineSnapshotProcedure:
+			virtualMachineServiceCreateVirtualMachineSnapshotHandler.ServeHTTP(w, r)
+		case VirtualMachineServiceListVirtualMachineSnapshotsProcedure:
+			virtualMachineServiceListVirtualMachineSnapshotsHandler.ServeHTTP(w, r)
+		case VirtualMachineServiceRestoreVirtualMachineSnapshotProcedure:
+			virtualMachineServiceRestoreVirtualMachineSnapshotHandler.ServeHTTP(w, r)
+		case VirtualMachineServiceDeleteVirtualMachineSnapshotProcedure:
+			virtualMachineServiceDeleteVirtualMachineSnapshotHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -224,3 +486,43 @@ func (UnimplementedVirtualMachineServiceHandler) UpdateVirtualMachine(context.Co
 func (UnimplementedVirtualMachineServiceHandler) DeleteVirtualMachine(context.Context, *connect.Request[v1.DeleteVirtualMachineRequest]) (*connect.Response[v1.DeleteVirtualMachineResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("virtual_machine.v1.VirtualMachineService.DeleteVirtualMachine is not implemented"))
 }
+
+func (UnimplementedVirtualMachineServiceHandler) GetRenderedArtifact(context.Context, *connect.Request[v1.GetRenderedArtifactRequest]) (*connect.Response[v1.GetRenderedArtifactResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("virtual_machine.v1.VirtualMachineService.GetRenderedArtifact is not implemented"))
+}
+
+func (UnimplementedVirtualMachineServiceHandler) ExportRenderedArtifacts(context.Context, *connect.Request[v1.ExportRenderedArtifactsRequest]) (*connect.Response[v1.ExportRenderedArtifactsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("virtual_machine.v1.VirtualMachineService.ExportRenderedArtifacts is not implemented"))
+}
+
+func (UnimplementedVirtualMachineServiceHandler) StartVirtualMachine(context.Context, *connect.Request[v1.StartVirtualMachineRequest]) (*connect.Response[v1.StartVirtualMachineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("virtual_machine.v1.VirtualMachineService.StartVirtualMachine is not implemented"))
+}
+
+func (UnimplementedVirtualMachineServiceHandler) StopVirtualMachine(context.Context, *connect.Request[v1.StopVirtualMachineRequest]) (*connect.Response[v1.StopVirtualMachineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("virtual_machine.v1.VirtualMachineService.StopVirtualMachine is not implemented"))
+}
+
+func (UnimplementedVirtualMachineServiceHandler) RestartVirtualMachine(context.Context, *connect.Request[v1.RestartVirtualMachineRequest]) (*connect.Response[v1.RestartVirtualMachineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("virtual_machine.v1.VirtualMachineService.RestartVirtualMachine is not implemented"))
+}
+
+func (UnimplementedVirtualMachineServiceHandler) SuspendVirtualMachine(context.Context, *connect.Request[v1.SuspendVirtualMachineRequest]) (*connect.Response[v1.SuspendVirtualMachineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("virtual_machine.v1.VirtualMachineService.SuspendVirtualMachine is not implemented"))
+}
+
+func (UnimplementedVirtualMachineServiceHandler) CreateVirtualMachineSnapshot(context.Context, *connect.Request[v1.CreateVirtualMachineSnapshotRequest]) (*connect.Response[v1.CreateVirtualMachineSnapshotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("virtual_machine.v1.VirtualMachineService.CreateVirtualMachineSnapshot is not implemented"))
+}
+
+func (UnimplementedVirtualMachineServiceHandler) ListVirtualMachineSnapshots(context.Context, *connect.Request[v1.ListVirtualMachineSnapshotsRequest]) (*connect.Response[v1.ListVirtualMachineSnapshotsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("virtual_machine.v1.VirtualMachineService.ListVirtualMachineSnapshots is not implemented"))
+}
+
+func (UnimplementedVirtualMachineServiceHandler) RestoreVirtualMachineSnapshot(context.Context, *connect.Request[v1.RestoreVirtualMachineSnapshotRequest]) (*connect.Response[v1.RestoreVirtualMachineSnapshotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("virtual_machine.v1.VirtualMachineService.RestoreVirtualMachineSnapshot is not implemented"))
+}
+
+func (UnimplementedVirtualMachineServiceHandler) DeleteVirtualMachineSnapshot(context.Context, *connect.Request[v1.DeleteVirtualMachineSnapshotRequest]) (*connect.Response[v1.DeleteVirtualMachineSnapshotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("virtual_machine.v1.VirtualMachineService.DeleteVirtualMachineSnapshot is not implemented"))
+}